@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -14,29 +15,67 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	grpcAPI "github.com/m04kA/SMC-BookingService/internal/api/grpcapi"
+	adminGetBookingHistoryHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_get_booking_history"
+	adminGetLogLevelHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_get_log_level"
+	adminInvalidateSellerCacheHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_invalidate_seller_cache"
+	adminReassignBookingHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_reassign_booking"
+	adminRestoreBookingHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_restore_booking"
+	adminSearchBookingsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_search_bookings"
+	adminUpdateBookingStatusHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_update_booking_status"
+	adminUpdateLogLevelHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/admin_update_log_level"
 	cancelBookingHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/cancel_booking"
 	createBookingHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/create_booking"
+	exportCompanyBookingsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/export_company_bookings"
+	exportCompanyConfigHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/export_company_config"
 	getAvailableSlotsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_available_slots"
 	getBookingHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_booking"
+	getCalendarFeedHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_calendar_feed"
 	getCompanyBookingsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_company_bookings"
 	getCompanyConfigHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_company_config"
 	getUserBookingsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_user_bookings"
+	healthLiveHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/health_live"
+	healthReadyHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/health_ready"
+	importCompanyConfigHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/import_company_config"
+	issueAddressCalendarFeedHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/issue_address_calendar_feed"
+	issueUserCalendarFeedHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/issue_user_calendar_feed"
+	revokeAddressCalendarFeedHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/revoke_address_calendar_feed"
+	revokeUserCalendarFeedHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/revoke_user_calendar_feed"
+	streamAvailableSlotsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/stream_available_slots"
+	streamCompanyBookingsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/stream_company_bookings"
+	updateBookingStatusHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/update_booking_status"
 	updateCompanyConfigHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/update_company_config"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
 	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	"github.com/m04kA/SMC-BookingService/internal/api/openapi"
+	"github.com/m04kA/SMC-BookingService/internal/auth"
 	"github.com/m04kA/SMC-BookingService/internal/config"
+	bookingEvents "github.com/m04kA/SMC-BookingService/internal/infra/bookingevents"
+	bookingMetrics "github.com/m04kA/SMC-BookingService/internal/infra/bookingmetrics"
+	"github.com/m04kA/SMC-BookingService/internal/infra/health"
+	slotEvents "github.com/m04kA/SMC-BookingService/internal/infra/slotevents"
 	bookingRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/booking"
+	calendarRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/calendar"
 	configRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/config"
 	sellerServiceClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+	"github.com/m04kA/SMC-BookingService/internal/integrations/transport"
 	userServiceClient "github.com/m04kA/SMC-BookingService/internal/integrations/userservice"
+	adminService "github.com/m04kA/SMC-BookingService/internal/service/admin"
 	bookingsService "github.com/m04kA/SMC-BookingService/internal/service/bookings"
+	calendarService "github.com/m04kA/SMC-BookingService/internal/service/calendar"
 	configService "github.com/m04kA/SMC-BookingService/internal/service/config"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
 	createBookingUC "github.com/m04kA/SMC-BookingService/internal/usecase/create_booking"
 	getAvailableSlotsUC "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+	carEnrichmentWorker "github.com/m04kA/SMC-BookingService/internal/worker/car_enrichment"
+	occupancyWorker "github.com/m04kA/SMC-BookingService/internal/worker/occupancy"
 	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
 	"github.com/m04kA/SMC-BookingService/pkg/logger"
 	"github.com/m04kA/SMC-BookingService/pkg/metrics"
 	"github.com/m04kA/SMC-BookingService/pkg/simpletxmanager"
+	"github.com/m04kA/SMC-BookingService/pkg/tracing"
 	"github.com/m04kA/SMC-BookingService/pkg/txmanager"
+	"github.com/m04kA/SMC-BookingService/pkg/txretry"
 )
 
 func main() {
@@ -48,7 +87,15 @@ func main() {
 	}
 
 	// Инициализируем логгер
-	log, err := logger.New(cfg.Logs.File, cfg.Logs.Level)
+	log, err := logger.New(logger.Config{
+		Level:      cfg.Logs.Level,
+		Format:     cfg.Logs.Format,
+		File:       cfg.Logs.File,
+		MaxSizeMB:  cfg.Logs.MaxSizeMB,
+		MaxBackups: cfg.Logs.MaxBackups,
+		MaxAgeDays: cfg.Logs.MaxAgeDays,
+		Compress:   cfg.Logs.Compress,
+	})
 	if err != nil {
 		fmt.Printf("Failed to initialize logger: %v\n", err)
 		os.Exit(1)
@@ -58,6 +105,23 @@ func main() {
 	log.Info("Starting SMC-BookingService...")
 	log.Info("Configuration loaded from config.toml")
 
+	// Инициализируем трассировку (если включена)
+	shutdownTracing := tracing.ShutdownFunc(func(context.Context) error { return nil })
+	if cfg.Tracing.Enabled {
+		shutdownTracing, err = tracing.Setup(context.Background(), tracing.Config{
+			ServiceName: cfg.Metrics.ServiceName,
+			Exporter:    cfg.Tracing.Exporter,
+			Endpoint:    cfg.Tracing.Endpoint,
+			Insecure:    cfg.Tracing.Insecure,
+			FilePath:    cfg.Tracing.FilePath,
+			SampleRatio: cfg.Tracing.SampleRatio,
+		})
+		if err != nil {
+			log.Fatal("Failed to initialize tracing: %v", err)
+		}
+		log.Info("Tracing enabled (exporter=%s, sample_ratio=%.2f)", cfg.Tracing.Exporter, cfg.Tracing.SampleRatio)
+	}
+
 	// Инициализируем метрики (если включены)
 	var metricsCollector *metrics.Metrics
 	var wrappedDB *dbmetrics.DB
@@ -87,57 +151,203 @@ func main() {
 	log.Info("Successfully connected to database (host=%s, port=%d, db=%s)",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.DBName)
 
-	// Инициализируем интеграционных клиентов
+	// Инициализируем интеграционных клиентов (с повторами и circuit breaker)
+	var transportMetrics transport.Metrics
+	if metricsCollector != nil {
+		transportMetrics = metricsCollector
+	}
 	userClient := userServiceClient.NewClient(
 		cfg.UserService.URL,
-		time.Duration(cfg.UserService.Timeout)*time.Second,
+		transport.NewHTTPClient(
+			newTransportConfig("userservice", cfg.UserService),
+			transportMetrics,
+			cfg.Metrics.ServiceName,
+			log,
+		),
 		log,
 	)
-	sellerClient := sellerServiceClient.NewClient(
+	sellerHTTPClient := sellerServiceClient.NewClient(
 		cfg.SellerService.URL,
-		time.Duration(cfg.SellerService.Timeout)*time.Second,
+		transport.NewHTTPClient(
+			newTransportConfig("sellerservice", cfg.SellerService),
+			transportMetrics,
+			cfg.Metrics.ServiceName,
+			log,
+		),
 		log,
 	)
 	log.Info("Integration clients initialized (UserService=%s timeout=%ds, SellerService=%s timeout=%ds)",
 		cfg.UserService.URL, cfg.UserService.Timeout, cfg.SellerService.URL, cfg.SellerService.Timeout)
 
+	// Проверки готовности: PostgreSQL и миграции - критичные, внешние сервисы - нет
+	// (UserService и кеш SellerService позволяют работать при их недоступности)
+	healthTimeout := time.Duration(cfg.Health.Timeout) * time.Second
+	probeClient := &http.Client{Timeout: healthTimeout}
+	healthChecks := []health.Check{
+		{Name: "postgres", Critical: true, Probe: health.PostgresProbe(db)},
+		{Name: "sellerservice", Probe: health.HTTPProbe(probeClient, cfg.SellerService.URL+cfg.SellerService.HealthPath)},
+		{Name: "userservice", Probe: health.HTTPProbe(probeClient, cfg.UserService.URL+cfg.UserService.HealthPath)},
+	}
+	if version, err := health.LatestMigration(cfg.Health.MigrationsPath); err != nil {
+		log.Warn("Migrations check disabled: %v", err)
+	} else {
+		healthChecks = append(healthChecks, health.Check{Name: "migrations", Critical: true, Probe: health.MigrationsProbe(db, version)})
+		log.Info("Migrations check enabled (expected version %d)", version)
+	}
+	healthChecker := health.NewChecker(health.Config{
+		Timeout:  healthTimeout,
+		CacheTTL: time.Duration(cfg.Health.CacheTTL) * time.Second,
+	}, log, healthChecks...)
+
+	// Кеширующий клиент SellerService (если включен)
+	var sellerClient sellerServiceClient.Upstream = sellerHTTPClient
+	var sellerCache *sellerServiceClient.CachedClient
+	if cfg.SellerService.Cache.Enabled {
+		var cacheMetrics sellerServiceClient.CacheMetrics
+		if metricsCollector != nil {
+			cacheMetrics = metricsCollector
+		}
+		sellerCache = sellerServiceClient.NewCachedClient(
+			sellerHTTPClient,
+			sellerServiceClient.CacheConfig{
+				CompanyTTL:   time.Duration(cfg.SellerService.Cache.CompanyTTL) * time.Second,
+				ServiceTTL:   time.Duration(cfg.SellerService.Cache.ServiceTTL) * time.Second,
+				NegativeTTL:  time.Duration(cfg.SellerService.Cache.NegativeTTL) * time.Second,
+				MaxEntries:   cfg.SellerService.Cache.MaxEntries,
+				FetchTimeout: time.Duration(cfg.SellerService.Cache.FetchTimeout) * time.Second,
+			},
+			cacheMetrics,
+			cfg.Metrics.ServiceName,
+			log,
+		)
+		sellerClient = sellerCache
+		log.Info("SellerService cache enabled (company_ttl=%ds, service_ttl=%ds, negative_ttl=%ds)",
+			cfg.SellerService.Cache.CompanyTTL, cfg.SellerService.Cache.ServiceTTL, cfg.SellerService.Cache.NegativeTTL)
+	}
+
 	// Инициализируем репозитории и сервисы (с метриками или без)
 	var (
-		bookingRepository *bookingRepo.Repository
-		configRepository  *configRepo.Repository
+		bookingRepository  *bookingRepo.Repository
+		configRepository   *configRepo.Repository
+		calendarRepository *calendarRepo.Repository
 	)
 
 	// Интерфейс для transaction manager (используется в usecases)
 	// TODO: Точно нужно переделать эту шл
 	type TxManager interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
 		DoSerializable(ctx context.Context, fn func(ctx context.Context) error) error
 	}
 	var txMgr TxManager
 
-	if cfg.Metrics.Enabled {
-		wrappedDB = dbmetrics.WrapWithDefault(db, metricsCollector, cfg.Metrics.ServiceName, stopMetricsCh)
-		log.Info("Database metrics collection started")
+	// Повторы транзакций, прерванных PostgreSQL (конфликт сериализации, deadlock)
+	var txRetryMetrics txretry.Metrics
+	if metricsCollector != nil {
+		txRetryMetrics = metricsCollector
+	}
+	txRetrier := txretry.New(txretry.Config{
+		MaxAttempts:    cfg.Database.TxRetry.MaxAttempts,
+		InitialBackoff: time.Duration(cfg.Database.TxRetry.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.Database.TxRetry.MaxBackoffMs) * time.Millisecond,
+	}, txRetryMetrics, cfg.Metrics.ServiceName)
+
+	if cfg.Metrics.Enabled || cfg.Tracing.Enabled {
+		if cfg.Metrics.Enabled {
+			wrappedDB = dbmetrics.WrapWithDefault(db, metricsCollector, cfg.Metrics.ServiceName, stopMetricsCh)
+			log.Info("Database metrics collection started")
+		} else {
+			// Без метрик обёртка только создает спаны запросов
+			wrappedDB = dbmetrics.Wrap(db, nil, cfg.Metrics.ServiceName)
+		}
 
-		// Инициализируем репозитории с обёрткой метрик
+		// Инициализируем репозитории с обёрткой метрик и трассировки
 		bookingRepository = bookingRepo.NewRepository(wrappedDB)
 		configRepository = configRepo.NewRepository(wrappedDB)
-		txMgr = txmanager.NewTransactionManager(wrappedDB)
+		calendarRepository = calendarRepo.NewRepository(wrappedDB)
+		txMgr = txmanager.NewTransactionManager(wrappedDB, txRetrier)
 	} else {
 		// Инициализируем репозитории без метрик
 		bookingRepository = bookingRepo.NewRepository(db)
 		configRepository = configRepo.NewRepository(db)
-		txMgr = simpletxmanager.NewTransactionManager(db)
+		calendarRepository = calendarRepo.NewRepository(db)
+		txMgr = simpletxmanager.NewTransactionManager(db, txRetrier)
+	}
+
+	// События изменения бронирований (для потоков слотов и бронирований компании)
+	// Сервисы публикуют их после каждого изменения бронирований, между репликами события
+	// пересылаются через PostgreSQL LISTEN/NOTIFY
+	slotHub := slotEvents.NewHub()
+	bookingHub := bookingEvents.NewHub()
+	eventPublisher := bookingEvents.NewPublisher(log, slotHub, bookingHub)
+	var eventBridge *bookingEvents.Bridge
+	if cfg.Events.NotifyBridge && (cfg.SlotStream.Enabled || cfg.BookingStream.Enabled) {
+		var notifyDB bookingEvents.DBExecutor = db
+		if wrappedDB != nil {
+			notifyDB = wrappedDB
+		}
+		eventBridge, err = bookingEvents.NewBridge(
+			notifyDB,
+			bookingRepository,
+			cfg.Database.DSN(),
+			cfg.Events.Channel,
+			eventPublisher,
+			log,
+		)
+		if err != nil {
+			log.Fatal("Failed to create booking events bridge: %v", err)
+		}
+		eventPublisher.SetForwarder(eventBridge)
+	}
+
+	// Бизнес метрики бронирований (события этой реплики, отказы в слотах, загрузка слотов)
+	var bookingMetricsRecorder *bookingMetrics.Recorder
+	var slotMetrics createBookingUC.Metrics
+	if cfg.Metrics.Enabled {
+		bookingMetricsRecorder = bookingMetrics.NewRecorder(metricsCollector, cfg.Metrics.ServiceName, bookingMetrics.Config{
+			CompanyLabel:     cfg.Metrics.Business.CompanyLabel,
+			CompanyAllowlist: cfg.Metrics.Business.CompanyAllowlist,
+		})
+		eventPublisher.SetMetrics(bookingMetricsRecorder)
+		slotMetrics = bookingMetricsRecorder
+		log.Info("Business metrics enabled (company_label=%s)", cfg.Metrics.Business.CompanyLabel)
 	}
 
 	// Инициализируем сервисы
 	bookingSvc := bookingsService.NewService(
 		bookingRepository,
 		sellerClient,
+		eventPublisher,
 		log,
 	)
 	configSvc := configService.NewService(
 		configRepository,
+		bookingRepository,
 		sellerClient,
+		txMgr,
+		eventPublisher,
+		log,
+	)
+
+	adminSvc := adminService.NewService(
+		bookingRepository,
+		configRepository,
+		txMgr,
+		eventPublisher,
+		log,
+	)
+
+	calendarSvc := calendarService.NewService(
+		calendarRepository,
+		bookingRepository,
+		sellerClient,
+		txMgr,
+		calendarService.Config{
+			FeedURLPrefix: cfg.Calendar.PublicURL + "/api/v1/calendar/",
+			PastDays:      cfg.Calendar.PastDays,
+			FutureDays:    cfg.Calendar.FutureDays,
+			MaxEvents:     cfg.Calendar.MaxEvents,
+			TimeZone:      cfg.Calendar.TimeZone,
+		},
 		log,
 	)
 
@@ -148,6 +358,8 @@ func main() {
 		sellerClient,
 		userClient,
 		txMgr,
+		eventPublisher,
+		slotMetrics,
 		log,
 	)
 
@@ -163,17 +375,80 @@ func main() {
 	getAvailableSlots := getAvailableSlotsHandler.NewHandler(getAvailableSlotsUseCase, log)
 	getBooking := getBookingHandler.NewHandler(bookingSvc, log)
 	cancelBooking := cancelBookingHandler.NewHandler(bookingSvc, log)
+	updateBookingStatus := updateBookingStatusHandler.NewHandler(bookingSvc, log)
 	getUserBookings := getUserBookingsHandler.NewHandler(bookingSvc, log)
 	getCompanyBookings := getCompanyBookingsHandler.NewHandler(bookingSvc, log)
+	exportCompanyBookings := exportCompanyBookingsHandler.NewHandler(bookingSvc, log)
 	getCompanyConfig := getCompanyConfigHandler.NewHandler(configSvc, log)
 	updateCompanyConfig := updateCompanyConfigHandler.NewHandler(configSvc, log)
+	exportCompanyConfig := exportCompanyConfigHandler.NewHandler(configSvc, log)
+	importCompanyConfig := importCompanyConfigHandler.NewHandler(configSvc, log)
+	adminSearchBookings := adminSearchBookingsHandler.NewHandler(adminSvc, log)
+	adminUpdateBookingStatus := adminUpdateBookingStatusHandler.NewHandler(adminSvc, log)
+	adminRestoreBooking := adminRestoreBookingHandler.NewHandler(adminSvc, log)
+	adminReassignBooking := adminReassignBookingHandler.NewHandler(adminSvc, log)
+	adminGetBookingHistory := adminGetBookingHistoryHandler.NewHandler(adminSvc, log)
+	adminGetLogLevel := adminGetLogLevelHandler.NewHandler(log)
+	adminUpdateLogLevel := adminUpdateLogLevelHandler.NewHandler(log, log)
+	getCalendarFeed := getCalendarFeedHandler.NewHandler(calendarSvc, log)
+	issueUserCalendarFeed := issueUserCalendarFeedHandler.NewHandler(calendarSvc, log)
+	revokeUserCalendarFeed := revokeUserCalendarFeedHandler.NewHandler(calendarSvc, log)
+	issueAddressCalendarFeed := issueAddressCalendarFeedHandler.NewHandler(calendarSvc, log)
+	revokeAddressCalendarFeed := revokeAddressCalendarFeedHandler.NewHandler(calendarSvc, log)
+	healthLive := healthLiveHandler.NewHandler()
+	healthReady := healthReadyHandler.NewHandler(healthChecker)
+	streamAvailableSlots := streamAvailableSlotsHandler.NewHandler(
+		getAvailableSlotsUseCase,
+		slotHub,
+		streamAvailableSlotsHandler.Config{
+			HeartbeatInterval: time.Duration(cfg.SlotStream.HeartbeatInterval) * time.Second,
+			MaxDuration:       time.Duration(cfg.SlotStream.MaxDuration) * time.Second,
+			MaxConnections:    cfg.SlotStream.MaxConnections,
+		},
+		log,
+	)
+	streamCompanyBookings := streamCompanyBookingsHandler.NewHandler(
+		bookingSvc,
+		bookingHub,
+		streamCompanyBookingsHandler.Config{
+			HeartbeatInterval: time.Duration(cfg.BookingStream.HeartbeatInterval) * time.Second,
+			SendBuffer:        cfg.BookingStream.SendBuffer,
+			MaxConnections:    cfg.BookingStream.MaxConnections,
+			SnapshotLimit:     cfg.BookingStream.SnapshotLimit,
+			AllowedOrigins:    cfg.BookingStream.AllowedOrigins,
+			AccessCheck:       time.Duration(cfg.BookingStream.AccessCheck) * time.Second,
+		},
+		log,
+	)
+
+	// Инициализируем аутентификацию
+	authenticator, err := newAuthenticator(cfg.Auth)
+	if err != nil {
+		log.Fatal("Failed to initialize authentication: %v", err)
+	}
+	if cfg.Auth.DevHeaderAuth {
+		log.Warn("Header authentication (X-User-ID) is allowed - do not use in production")
+	}
+	log.Info("Authentication initialized: authenticators=%v", cfg.Auth.Authenticators)
 
 	// Настраиваем роутер
 	r := mux.NewRouter()
 
+	// Идентификатор запроса (X-Request-ID) для логов, вызовов внешних сервисов и тела ошибок
+	r.Use(middleware.RequestID())
+
+	// Спан входящего запроса (контекст трассы из traceparent)
+	if cfg.Tracing.Enabled {
+		r.Use(middleware.Tracing(cfg.Metrics.ServiceName))
+	}
+
+	// Язык ответов (до остальных middleware: их ошибки тоже переводятся)
+	r.Use(middleware.Locale(i18n.Locale(cfg.Locale.Default)))
+
 	// Добавляем metrics middleware (если метрики включены)
 	if cfg.Metrics.Enabled {
 		r.Use(middleware.MetricsMiddleware(metricsCollector, cfg.Metrics.ServiceName))
+		r.Use(middleware.Source(metrics.SourceHTTP))
 		log.Info("HTTP metrics middleware enabled")
 	}
 
@@ -183,9 +458,32 @@ func main() {
 		log.Info("Prometheus metrics endpoint exposed at %s", cfg.Metrics.Path)
 	}
 
+	// Проверки живости и готовности (публичные, без аутентификации, для балансировщика и оркестратора)
+	r.HandleFunc("/healthz", healthLive.Handle).Methods(http.MethodGet)
+	r.HandleFunc("/readyz", healthReady.Handle).Methods(http.MethodGet)
+
 	// API prefix
 	api := r.PathPrefix("/api/v1").Subrouter()
 
+	// ID компании и бронирования из пути запроса - в поля логов
+	api.Use(middleware.LogFields())
+
+	// Проверка запросов и ответов по спецификации OpenAPI
+	if cfg.OpenAPI.ValidateRequests || cfg.OpenAPI.ValidateResponses {
+		validator, err := openapi.Load(cfg.OpenAPI.SpecPath)
+		if err != nil {
+			log.Fatal("Failed to load OpenAPI spec: %v", err)
+		}
+		if cfg.OpenAPI.ValidateRequests {
+			api.Use(middleware.ValidateRequest(validator, log))
+			log.Info("OpenAPI request validation enabled: spec=%s", cfg.OpenAPI.SpecPath)
+		}
+		if cfg.OpenAPI.ValidateResponses {
+			api.Use(middleware.ValidateResponse(validator, log))
+			log.Warn("OpenAPI response validation enabled - responses not matching the spec are replaced with 500")
+		}
+	}
+
 	// ============================================================
 	// PUBLIC ROUTES (без аутентификации)
 	// ============================================================
@@ -194,16 +492,26 @@ func main() {
 	api.HandleFunc("/companies/{companyId}/addresses/{addressId}/available-slots",
 		getAvailableSlots.Handle).Methods(http.MethodGet)
 
+	// Поток изменений доступных слотов (Server-Sent Events)
+	if cfg.SlotStream.Enabled {
+		api.HandleFunc("/companies/{companyId}/addresses/{addressId}/available-slots/stream",
+			streamAvailableSlots.Handle).Methods(http.MethodGet)
+	}
+
 	// Получение конфигурации слотов компании
 	api.HandleFunc("/companies/{companyId}/config",
 		getCompanyConfig.Handle).Methods(http.MethodGet)
 
+	// Календарь бронирований по ссылке с токеном (iCalendar, только чтение)
+	api.HandleFunc("/calendar/{token:[A-Za-z0-9_-]+}.ics",
+		getCalendarFeed.Handle).Methods(http.MethodGet)
+
 	// ============================================================
-	// PROTECTED ROUTES (требуют X-User-ID header)
+	// PROTECTED ROUTES (требуют аутентификации)
 	// ============================================================
 
 	protected := api.PathPrefix("").Subrouter()
-	protected.Use(middleware.Auth)
+	protected.Use(middleware.Auth(authenticator, log))
 
 	// --- Бронирования ---
 	// Создание бронирования
@@ -215,16 +523,100 @@ func main() {
 	// Отмена бронирования
 	protected.HandleFunc("/bookings/{bookingId}/cancel", cancelBooking.Handle).Methods(http.MethodPatch)
 
+	// Изменение статуса бронирования (менеджеры и операторы компании)
+	protected.HandleFunc("/bookings/{bookingId}/status", updateBookingStatus.Handle).Methods(http.MethodPatch)
+
 	// История бронирований пользователя
 	protected.HandleFunc("/users/{userId}/bookings", getUserBookings.Handle).Methods(http.MethodGet)
 
+	// Ссылка на календарь бронирований пользователя (выпуск/перевыпуск и отзыв)
+	protected.HandleFunc("/users/{userId}/calendar-feed", issueUserCalendarFeed.Handle).Methods(http.MethodPost)
+	protected.HandleFunc("/users/{userId}/calendar-feed", revokeUserCalendarFeed.Handle).Methods(http.MethodDelete)
+
 	// --- Управление компанией (для менеджеров) ---
 	// Список бронирований компании
 	protected.HandleFunc("/companies/{companyId}/bookings", getCompanyBookings.Handle).Methods(http.MethodGet)
 
+	// Выгрузка бронирований компании (CSV или XLSX)
+	protected.HandleFunc("/companies/{companyId}/bookings/export", exportCompanyBookings.Handle).Methods(http.MethodGet)
+
+	// Поток бронирований компании в реальном времени (WebSocket)
+	if cfg.BookingStream.Enabled {
+		protected.HandleFunc("/companies/{companyId}/bookings/stream", streamCompanyBookings.Handle).Methods(http.MethodGet)
+	}
+
+	// Ссылка на календарь расписания адреса (выпуск/перевыпуск и отзыв)
+	protected.HandleFunc("/companies/{companyId}/addresses/{addressId}/calendar-feed",
+		issueAddressCalendarFeed.Handle).Methods(http.MethodPost)
+	protected.HandleFunc("/companies/{companyId}/addresses/{addressId}/calendar-feed",
+		revokeAddressCalendarFeed.Handle).Methods(http.MethodDelete)
+
 	// Обновление конфигурации компании
 	protected.HandleFunc("/companies/{companyId}/config", updateCompanyConfig.Handle).Methods(http.MethodPut)
 
+	// Массовый экспорт/импорт конфигураций компании (JSON или CSV)
+	protected.HandleFunc("/companies/{companyId}/config/export", exportCompanyConfig.Handle).Methods(http.MethodGet)
+	protected.HandleFunc("/companies/{companyId}/config/import", importCompanyConfig.Handle).Methods(http.MethodPost)
+
+	// ============================================================
+	// ADMIN ROUTES (только администраторы платформы)
+	// ============================================================
+
+	adminRouter := protected.PathPrefix("/admin").Subrouter()
+	adminRouter.Use(middleware.RequireRole(policy.RolePlatformAdmin))
+
+	// Поиск бронирований по всем компаниям
+	adminRouter.HandleFunc("/bookings", adminSearchBookings.Handle).Methods(http.MethodGet)
+
+	// Операции поддержки (каждая записывается в историю с ID администратора и причиной)
+	adminRouter.HandleFunc("/bookings/{bookingId}/status", adminUpdateBookingStatus.Handle).Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/bookings/{bookingId}/restore", adminRestoreBooking.Handle).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/bookings/{bookingId}/user", adminReassignBooking.Handle).Methods(http.MethodPatch)
+
+	// История изменений бронирования
+	adminRouter.HandleFunc("/bookings/{bookingId}/history", adminGetBookingHistory.Handle).Methods(http.MethodGet)
+
+	// Уровень логирования без перезапуска
+	adminRouter.HandleFunc("/log-level", adminGetLogLevel.Handle).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/log-level", adminUpdateLogLevel.Handle).Methods(http.MethodPut)
+
+	// Инвалидация кеша SellerService (только если кеш включен)
+	if sellerCache != nil {
+		adminInvalidateSellerCache := adminInvalidateSellerCacheHandler.NewHandler(sellerCache, log)
+		adminRouter.HandleFunc("/cache/sellerservice/invalidate", adminInvalidateSellerCache.Handle).Methods(http.MethodPost)
+	}
+
+	// Запускаем фоновые задачи
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	if cfg.CarEnrichment.Enabled {
+		carEnrichment := carEnrichmentWorker.NewWorker(
+			bookingRepository,
+			userClient,
+			time.Duration(cfg.CarEnrichment.Interval)*time.Second,
+			cfg.CarEnrichment.BatchSize,
+			log,
+		)
+		go carEnrichment.Run(workersCtx)
+	}
+
+	if eventBridge != nil {
+		go eventBridge.Run(workersCtx)
+	}
+
+	if bookingMetricsRecorder != nil {
+		occupancy := occupancyWorker.NewWorker(
+			bookingRepository,
+			configRepository,
+			sellerClient,
+			bookingMetricsRecorder,
+			time.Duration(cfg.Metrics.Business.OccupancyInterval)*time.Second,
+			log,
+		)
+		go occupancy.Run(workersCtx)
+	}
+
 	// Создаем HTTP сервер
 	addr := fmt.Sprintf(":%d", cfg.Server.HTTPPort)
 	srv := &http.Server{
@@ -235,6 +627,11 @@ func main() {
 		IdleTimeout:  time.Duration(cfg.Server.IdleTimeout) * time.Second,
 	}
 
+	// Shutdown не ждет завершения долгих соединений сам: закрываем подписки SSE и WebSocket
+	// потоков, чтобы их обработчики завершились
+	srv.RegisterOnShutdown(slotHub.Close)
+	srv.RegisterOnShutdown(bookingHub.Close)
+
 	// Graceful shutdown
 	go func() {
 		log.Info("Starting server on %s", addr)
@@ -243,6 +640,32 @@ func main() {
 		}
 	}()
 
+	// gRPC сервер для внутренних сервисов (те же use case и сервисы, что и у HTTP API)
+	var grpcSrv *grpcAPI.Server
+	if cfg.GRPC.Enabled {
+		grpcSrv = grpcAPI.NewServer(
+			createBookingUseCase,
+			getAvailableSlotsUseCase,
+			bookingSvc,
+			configSvc,
+			authenticator,
+			grpcAPI.Config{Reflection: cfg.GRPC.Reflection},
+			log,
+		)
+
+		grpcAddr := fmt.Sprintf(":%d", cfg.GRPC.Port)
+		grpcListener, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			log.Fatal("Failed to listen gRPC on %s: %v", grpcAddr, err)
+		}
+		go func() {
+			log.Info("Starting gRPC server on %s", grpcAddr)
+			if err := grpcSrv.Serve(grpcListener); err != nil {
+				log.Fatal("gRPC server failed: %v", err)
+			}
+		}()
+	}
+
 	// Ожидаем сигнал завершения
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -250,6 +673,15 @@ func main() {
 
 	log.Info("Shutting down server...")
 
+	// Сначала /readyz начинает отвечать 503, чтобы балансировщик перестал направлять запросы,
+	// затем сервер перестает принимать соединения
+	healthChecker.SetShuttingDown()
+	log.Info("Readiness set to failing, draining for %ds", cfg.Health.DrainDelay)
+	time.Sleep(time.Duration(cfg.Health.DrainDelay) * time.Second)
+
+	// Останавливаем фоновые задачи
+	stopWorkers()
+
 	// Останавливаем сбор метрик connection pool
 	if cfg.Metrics.Enabled {
 		close(stopMetricsCh)
@@ -265,6 +697,60 @@ func main() {
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Error("Server forced to shutdown: %v", err)
 	}
+	if grpcSrv != nil {
+		grpcSrv.Shutdown(shutdownCtx)
+	}
+
+	// Отправляем накопленные спаны
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Error("Failed to flush traces: %v", err)
+	}
 
 	log.Info("Server stopped gracefully")
 }
+
+// newTransportConfig формирует настройки устойчивого транспорта для внешнего сервиса
+func newTransportConfig(dependency string, ic config.IntegrationConfig) transport.Config {
+	return transport.Config{
+		Dependency:          dependency,
+		AttemptTimeout:      time.Duration(ic.Timeout) * time.Second,
+		MaxAttempts:         ic.Retry.MaxAttempts,
+		InitialBackoff:      time.Duration(ic.Retry.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:          time.Duration(ic.Retry.MaxBackoffMs) * time.Millisecond,
+		BreakerEnabled:      ic.CircuitBreaker.Enabled,
+		FailureThreshold:    ic.CircuitBreaker.FailureThreshold,
+		OpenTimeout:         time.Duration(ic.CircuitBreaker.OpenTimeout) * time.Second,
+		HalfOpenMaxRequests: ic.CircuitBreaker.HalfOpenMaxRequests,
+	}
+}
+
+// newAuthenticator создает цепочку аутентификаторов в порядке, заданном в конфигурации
+func newAuthenticator(cfg config.AuthConfig) (*auth.Chain, error) {
+	opts := auth.TokenOptions{
+		Issuer:      cfg.Issuer,
+		Audience:    cfg.Audience,
+		Leeway:      time.Duration(cfg.Leeway) * time.Second,
+		UserIDClaim: cfg.UserIDClaim,
+		RoleClaim:   cfg.RoleClaim,
+	}
+
+	authenticators := make([]auth.Authenticator, 0, len(cfg.Authenticators))
+	for _, name := range cfg.Authenticators {
+		switch name {
+		case config.AuthenticatorHeader:
+			authenticators = append(authenticators, auth.NewHeaderAuthenticator())
+		case config.AuthenticatorHMAC:
+			authenticators = append(authenticators, auth.NewHMACAuthenticator([]byte(cfg.HMAC.Secret), opts))
+		case config.AuthenticatorJWT:
+			jwtAuth, err := auth.NewJWTAuthenticator(cfg.JWT.JWKSFile, opts)
+			if err != nil {
+				return nil, err
+			}
+			authenticators = append(authenticators, jwtAuth)
+		default:
+			return nil, fmt.Errorf("unknown authenticator %q", name)
+		}
+	}
+
+	return auth.NewChain(authenticators...), nil
+}
@@ -0,0 +1,117 @@
+// Package apierror ошибки API в формате RFC 7807 (application/problem+json)
+//
+// Каждая ошибка имеет стабильный машиночитаемый код (Code), по которому клиенты различают
+// ошибки вместо разбора текста сообщения. Соответствие ошибок сервисов кодам и HTTP статусам
+// задается в handlers (errors.go), пакет не зависит от слоя сервисов.
+//
+// Сообщения переводятся на язык ответа (Content-Language, выставляется middleware.Locale)
+// при отправке ошибки: обработчики создают ошибки с исходными сообщениями.
+package apierror
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
+)
+
+// ContentType тип содержимого ответа с ошибкой (RFC 7807)
+const ContentType = "application/problem+json"
+
+// typePrefix префикс URI типа проблемы: urn:smc:problem:SLOT_NOT_AVAILABLE
+const typePrefix = "urn:smc:problem:"
+
+// Error ошибка API: HTTP статус, стабильный код, сообщение клиенту и ошибки полей
+type Error struct {
+	Status  int
+	Code    Code
+	Message string
+	Fields  []FieldError
+}
+
+// FieldError ошибка валидации поля запроса
+type FieldError struct {
+	Field   string `json:"field"`   // Имя поля как в запросе: companyId, bookingDate, car.id
+	Message string `json:"message"` // Сообщение клиенту
+}
+
+// Problem тело ответа с ошибкой (RFC 7807)
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail"`
+	Code      Code         `json:"code"`
+	Message   string       `json:"message"` // То же, что detail (совместимость с прежним форматом ошибок)
+	Errors    []FieldError `json:"errors,omitempty"`
+	RequestID string       `json:"requestId,omitempty"` // Идентификатор запроса (X-Request-ID) для обращения в поддержку
+}
+
+// New создает ошибку API
+func New(status int, code Code, message string) *Error {
+	return &Error{
+		Status:  status,
+		Code:    code,
+		Message: message,
+	}
+}
+
+// Validation создает ошибку 400 VALIDATION_FAILED с ошибками полей
+func Validation(fields ...FieldError) *Error {
+	return &Error{
+		Status:  http.StatusBadRequest,
+		Code:    CodeValidationFailed,
+		Message: defaultMessage(CodeValidationFailed),
+		Fields:  fields,
+	}
+}
+
+// Field создает ошибку валидации поля
+func Field(field, message string) FieldError {
+	return FieldError{Field: field, Message: message}
+}
+
+// Internal ошибка 500 без подробностей для клиента
+func Internal() *Error {
+	return New(http.StatusInternalServerError, CodeInternal, defaultMessage(CodeInternal))
+}
+
+// Error реализует error
+func (e *Error) Error() string {
+	return string(e.Code) + ": " + e.Message
+}
+
+// Problem возвращает тело ответа RFC 7807
+// Для ошибки с единственным полем detail совпадает с сообщением поля
+func (e *Error) Problem() Problem {
+	detail := e.Message
+	if len(e.Fields) == 1 {
+		detail = e.Fields[0].Message
+	}
+
+	return Problem{
+		Type:    typePrefix + string(e.Code),
+		Title:   http.StatusText(e.Status),
+		Status:  e.Status,
+		Detail:  detail,
+		Code:    e.Code,
+		Message: detail,
+		Errors:  e.Fields,
+	}
+}
+
+// Write отправляет ошибку как application/problem+json на языке ответа (Content-Language)
+// Идентификатор запроса берется из заголовка X-Request-ID ответа (выставляется middleware.RequestID)
+func Write(w http.ResponseWriter, e *Error) {
+	if l, ok := i18n.Parse(w.Header().Get("Content-Language")); ok {
+		e = e.Localize(l)
+	}
+
+	problem := e.Problem()
+	problem.RequestID = w.Header().Get(requestid.Header)
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(e.Status)
+	json.NewEncoder(w).Encode(problem)
+}
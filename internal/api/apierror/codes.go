@@ -0,0 +1,53 @@
+package apierror
+
+// Code стабильный машиночитаемый код ошибки
+// Коды - часть контракта API: не переименовываются при изменении текста сообщений
+type Code string
+
+// Общие ошибки запроса
+const (
+	CodeBadRequest         Code = "BAD_REQUEST"         // Некорректное тело или формат запроса
+	CodeValidationFailed   Code = "VALIDATION_FAILED"   // Некорректные значения полей (см. errors)
+	CodeUnauthenticated    Code = "UNAUTHENTICATED"     // Нет или некорректные учетные данные
+	CodeTokenExpired       Code = "TOKEN_EXPIRED"       // Истек срок действия токена
+	CodeAccessDenied       Code = "ACCESS_DENIED"       // Нет прав на операцию или ресурс
+	CodeUserIDMismatch     Code = "USER_ID_MISMATCH"    // ID пользователя в запросе не совпадает с авторизованным
+	CodeNotFound           Code = "NOT_FOUND"           // Ресурс не найден (без уточнения типа)
+	CodeConflict           Code = "CONFLICT"            // Конфликт состояния (без уточнения причины)
+	CodeServiceUnavailable Code = "SERVICE_UNAVAILABLE" // Временно недоступно (лимит подключений, deadlock в БД), повторите позже
+	CodeInternal           Code = "INTERNAL_ERROR"      // Внутренняя ошибка сервиса
+
+	CodeResponseValidationFailed Code = "RESPONSE_VALIDATION_FAILED" // Ответ не соответствует спецификации (режим проверки ответов)
+)
+
+// Ресурсы не найдены
+const (
+	CodeBookingNotFound      Code = "BOOKING_NOT_FOUND"
+	CodeCompanyNotFound      Code = "COMPANY_NOT_FOUND"
+	CodeAddressNotFound      Code = "ADDRESS_NOT_FOUND"
+	CodeServiceNotFound      Code = "SERVICE_NOT_FOUND"
+	CodeCarNotFound          Code = "CAR_NOT_FOUND"
+	CodeConfigNotFound       Code = "CONFIG_NOT_FOUND"
+	CodeCalendarFeedNotFound Code = "CALENDAR_FEED_NOT_FOUND"
+)
+
+// Правила бронирования
+const (
+	CodeSlotNotAvailable             Code = "SLOT_NOT_AVAILABLE"               // Слот занят
+	CodeCompanyClosed                Code = "COMPANY_CLOSED"                   // Компания не работает в выбранную дату
+	CodeBookingDateInPast            Code = "BOOKING_DATE_IN_PAST"             // Дата бронирования в прошлом
+	CodeBookingTooFarAhead           Code = "BOOKING_TOO_FAR_AHEAD"            // Дальше advanceBookingDays
+	CodeBookingTooEarly              Code = "BOOKING_TOO_EARLY"                // Меньше minBookingNoticeMinutes до начала слота
+	CodeInvalidTimeSlot              Code = "INVALID_TIME_SLOT"                // Время не совпадает с сеткой слотов
+	CodeServiceNotAvailableAtAddress Code = "SERVICE_NOT_AVAILABLE_AT_ADDRESS" // Услуга не оказывается на адресе
+	CodeInvalidBookingStatus         Code = "INVALID_BOOKING_STATUS"           // Недопустимый статус или переход статуса
+	CodeBookingCannotBeCancelled     Code = "BOOKING_CANNOT_BE_CANCELLED"      // Бронирование уже завершено или отменено
+	CodeBookingNotCancelled          Code = "BOOKING_NOT_CANCELLED"            // Восстановить можно только отмененное
+)
+
+// Конфигурация и календари
+const (
+	CodeConfigConflicts      Code = "CONFIG_CONFLICTS"       // Изменение конфликтует с бронированиями (нужен force)
+	CodeConfigAlreadyExists  Code = "CONFIG_ALREADY_EXISTS"  // Конфигурация уже создана
+	CodeCalendarFeedConflict Code = "CALENDAR_FEED_CONFLICT" // Ссылка на календарь перевыпускается параллельно
+)
@@ -0,0 +1,298 @@
+package apierror
+
+import "github.com/m04kA/SMC-BookingService/internal/api/i18n"
+
+// codeMessages сообщения по кодам ошибок
+// Используются, когда у конкретного сообщения ошибки нет перевода на язык ответа
+var codeMessages = map[Code]i18n.Text{
+	CodeBadRequest: {
+		i18n.RU: "некорректный запрос",
+		i18n.EN: "invalid request",
+		i18n.KK: "сұраныс жарамсыз",
+	},
+	CodeValidationFailed: {
+		i18n.RU: "некорректные параметры запроса",
+		i18n.EN: "invalid request parameters",
+		i18n.KK: "сұраныс параметрлері жарамсыз",
+	},
+	CodeUnauthenticated: {
+		i18n.RU: "требуется аутентификация",
+		i18n.EN: "authentication required",
+		i18n.KK: "аутентификация қажет",
+	},
+	CodeTokenExpired: {
+		i18n.RU: "срок действия токена истек",
+		i18n.EN: "token has expired",
+		i18n.KK: "токеннің мерзімі өтті",
+	},
+	CodeAccessDenied: {
+		i18n.RU: "доступ запрещен",
+		i18n.EN: "access denied",
+		i18n.KK: "кіруге тыйым салынған",
+	},
+	CodeUserIDMismatch: {
+		i18n.RU: "ID пользователя не совпадает с авторизованным пользователем",
+		i18n.EN: "user ID does not match the authenticated user",
+		i18n.KK: "пайдаланушы ID-і авторизацияланған пайдаланушыға сәйкес келмейді",
+	},
+	CodeNotFound: {
+		i18n.RU: "ресурс не найден",
+		i18n.EN: "resource not found",
+		i18n.KK: "ресурс табылмады",
+	},
+	CodeConflict: {
+		i18n.RU: "конфликт состояния ресурса",
+		i18n.EN: "resource state conflict",
+		i18n.KK: "ресурс күйінің қайшылығы",
+	},
+	CodeServiceUnavailable: {
+		i18n.RU: "сервис временно недоступен, повторите позже",
+		i18n.EN: "service temporarily unavailable, try again later",
+		i18n.KK: "қызмет уақытша қолжетімсіз, кейінірек қайталаңыз",
+	},
+	CodeInternal: {
+		i18n.RU: "internal server error",
+		i18n.EN: "internal server error",
+		i18n.KK: "сервердің ішкі қатесі",
+	},
+	CodeResponseValidationFailed: {
+		i18n.RU: "ответ сервиса не соответствует спецификации API",
+		i18n.EN: "service response does not match the API specification",
+		i18n.KK: "қызмет жауабы API спецификациясына сәйкес келмейді",
+	},
+
+	CodeBookingNotFound: {
+		i18n.RU: "бронирование не найдено",
+		i18n.EN: "booking not found",
+		i18n.KK: "брондау табылмады",
+	},
+	CodeCompanyNotFound: {
+		i18n.RU: "компания не найдена",
+		i18n.EN: "company not found",
+		i18n.KK: "компания табылмады",
+	},
+	CodeAddressNotFound: {
+		i18n.RU: "адрес не найден",
+		i18n.EN: "address not found",
+		i18n.KK: "мекенжай табылмады",
+	},
+	CodeServiceNotFound: {
+		i18n.RU: "услуга не найдена",
+		i18n.EN: "service not found",
+		i18n.KK: "қызмет табылмады",
+	},
+	CodeCarNotFound: {
+		i18n.RU: "автомобиль не найден",
+		i18n.EN: "car not found",
+		i18n.KK: "көлік табылмады",
+	},
+	CodeConfigNotFound: {
+		i18n.RU: "конфигурация не найдена",
+		i18n.EN: "configuration not found",
+		i18n.KK: "конфигурация табылмады",
+	},
+	CodeCalendarFeedNotFound: {
+		i18n.RU: "ссылка на календарь не найдена или отозвана",
+		i18n.EN: "calendar link not found or revoked",
+		i18n.KK: "күнтізбе сілтемесі табылмады немесе жойылды",
+	},
+
+	CodeSlotNotAvailable: {
+		i18n.RU: "выбранный временной слот недоступен",
+		i18n.EN: "the selected time slot is not available",
+		i18n.KK: "таңдалған уақыт аралығы қолжетімсіз",
+	},
+	CodeCompanyClosed: {
+		i18n.RU: "компания закрыта в выбранную дату",
+		i18n.EN: "the company is closed on the selected date",
+		i18n.KK: "компания таңдалған күні жұмыс істемейді",
+	},
+	CodeBookingDateInPast: {
+		i18n.RU: "дата бронирования в прошлом",
+		i18n.EN: "the booking date is in the past",
+		i18n.KK: "брондау күні өтіп кеткен",
+	},
+	CodeBookingTooFarAhead: {
+		i18n.RU: "дата бронирования слишком далеко в будущем",
+		i18n.EN: "the booking date is too far in the future",
+		i18n.KK: "брондау күні тым алыс болашақта",
+	},
+	CodeBookingTooEarly: {
+		i18n.RU: "слишком поздно для бронирования этого слота",
+		i18n.EN: "it is too late to book this slot",
+		i18n.KK: "бұл уақыт аралығын брондауға тым кеш",
+	},
+	CodeInvalidTimeSlot: {
+		i18n.RU: "некорректный временной слот",
+		i18n.EN: "invalid time slot",
+		i18n.KK: "уақыт аралығы жарамсыз",
+	},
+	CodeServiceNotAvailableAtAddress: {
+		i18n.RU: "услуга недоступна на выбранном адресе",
+		i18n.EN: "the service is not available at the selected address",
+		i18n.KK: "қызмет таңдалған мекенжайда қолжетімсіз",
+	},
+	CodeInvalidBookingStatus: {
+		i18n.RU: "некорректный статус бронирования",
+		i18n.EN: "invalid booking status",
+		i18n.KK: "брондау мәртебесі жарамсыз",
+	},
+	CodeBookingCannotBeCancelled: {
+		i18n.RU: "бронирование не может быть отменено",
+		i18n.EN: "the booking cannot be cancelled",
+		i18n.KK: "брондаудан бас тарту мүмкін емес",
+	},
+	CodeBookingNotCancelled: {
+		i18n.RU: "бронирование не отменено",
+		i18n.EN: "the booking is not cancelled",
+		i18n.KK: "брондаудан бас тартылмаған",
+	},
+
+	CodeConfigConflicts: {
+		i18n.RU: "изменение конфигурации конфликтует с существующими бронированиями, используйте dryRun=true для просмотра или force=true для применения",
+		i18n.EN: "the configuration change conflicts with existing bookings, use dryRun=true to preview or force=true to apply",
+		i18n.KK: "конфигурацияны өзгерту бар брондаулармен қайшы келеді, алдын ала қарау үшін dryRun=true, қолдану үшін force=true пайдаланыңыз",
+	},
+	CodeConfigAlreadyExists: {
+		i18n.RU: "конфигурация уже существует",
+		i18n.EN: "configuration already exists",
+		i18n.KK: "конфигурация бұрыннан бар",
+	},
+	CodeCalendarFeedConflict: {
+		i18n.RU: "ссылка на календарь уже перевыпускается, повторите запрос",
+		i18n.EN: "the calendar link is already being reissued, retry the request",
+		i18n.KK: "күнтізбе сілтемесі қазір қайта шығарылуда, сұранысты қайталаңыз",
+	},
+}
+
+// messages переводы сообщений обработчиков и сервисов, ключ - исходное сообщение (i18n.Source)
+// Сообщения, совпадающие с сообщением своего кода, переводятся через codeMessages
+var messages = map[string]i18n.Text{
+	// Аутентификация и доступ
+	"отсутствуют учетные данные":                       {i18n.EN: "credentials are missing", i18n.KK: "тіркелгі деректері жоқ"},
+	"некорректные учетные данные":                      {i18n.EN: "invalid credentials", i18n.KK: "тіркелгі деректері жарамсыз"},
+	"отсутствует ID пользователя":                      {i18n.EN: "user ID is missing", i18n.KK: "пайдаланушы ID-і жоқ"},
+	"нет доступа к бронированиям другого пользователя": {i18n.EN: "no access to another user's bookings", i18n.KK: "басқа пайдаланушының брондауларына қолжетімділік жоқ"},
+	"нет доступа к календарю другого пользователя":     {i18n.EN: "no access to another user's calendar", i18n.KK: "басқа пайдаланушының күнтізбесіне қолжетімділік жоқ"},
+
+	// Параметры и тело запроса
+	"некорректное тело запроса":                                    {i18n.EN: "invalid request body", i18n.KK: "сұраныс денесі жарамсыз"},
+	"некорректный тип значения":                                    {i18n.EN: "invalid value type", i18n.KK: "мән түрі жарамсыз"},
+	"некорректное значение":                                        {i18n.EN: "invalid value", i18n.KK: "мән жарамсыз"},
+	"недопустимое значение":                                        {i18n.EN: "value is not allowed", i18n.KK: "рұқсат етілмеген мән"},
+	"значение вне допустимого диапазона":                           {i18n.EN: "value is out of the allowed range", i18n.KK: "мән рұқсат етілген аралықтан тыс"},
+	"некорректный формат значения":                                 {i18n.EN: "invalid value format", i18n.KK: "мән пішімі жарамсыз"},
+	"неизвестное поле":                                             {i18n.EN: "unknown field", i18n.KK: "белгісіз өріс"},
+	"некорректный файл импорта":                                    {i18n.EN: "invalid import file", i18n.KK: "импорт файлы жарамсыз"},
+	"некорректные данные конфигурации":                             {i18n.EN: "invalid configuration data", i18n.KK: "конфигурация деректері жарамсыз"},
+	"некорректное сообщение":                                       {i18n.EN: "invalid message", i18n.KK: "хабарлама жарамсыз"},
+	"неизвестный тип сообщения":                                    {i18n.EN: "unknown message type", i18n.KK: "хабарлама түрі белгісіз"},
+	"обязательное поле":                                            {i18n.EN: "required field", i18n.KK: "міндетті өріс"},
+	"ожидается целое число":                                        {i18n.EN: "an integer is expected", i18n.KK: "бүтін сан күтіледі"},
+	"ожидается true или false":                                     {i18n.EN: "true or false is expected", i18n.KK: "true немесе false күтіледі"},
+	"некорректный курсор пагинации":                                {i18n.EN: "invalid pagination cursor", i18n.KK: "беттеу курсоры жарамсыз"},
+	"смещение не может быть отрицательным":                         {i18n.EN: "offset must not be negative", i18n.KK: "ығысу теріс бола алмайды"},
+	"размер страницы должен быть от 1 до 200":                      {i18n.EN: "page size must be between 1 and 200", i18n.KK: "бет өлшемі 1 мен 200 аралығында болуы керек"},
+	"допустимые значения: asc, desc":                               {i18n.EN: "allowed values: asc, desc", i18n.KK: "рұқсат етілген мәндер: asc, desc"},
+	"допустимые значения: pending, confirmed":                      {i18n.EN: "allowed values: pending, confirmed", i18n.KK: "рұқсат етілген мәндер: pending, confirmed"},
+	"допустимые значения: debug, info, warn, error":                {i18n.EN: "allowed values: debug, info, warn, error", i18n.KK: "рұқсат етілген мәндер: debug, info, warn, error"},
+	"нельзя указывать вместе с from/to":                            {i18n.EN: "cannot be combined with from/to", i18n.KK: "from/to параметрлерімен бірге көрсетуге болмайды"},
+	"слишком много адресов в фильтре, максимум 100":                {i18n.EN: "too many addresses in the filter, maximum is 100", i18n.KK: "сүзгіде мекенжайлар тым көп, ең көбі 100"},
+	"некорректный формат экспорта, допустимые значения: csv, xlsx": {i18n.EN: "invalid export format, allowed values: csv, xlsx", i18n.KK: "экспорт пішімі жарамсыз, рұқсат етілген мәндер: csv, xlsx"},
+	"некорректный формат экспорта, допустимые значения: json, csv": {i18n.EN: "invalid export format, allowed values: json, csv", i18n.KK: "экспорт пішімі жарамсыз, рұқсат етілген мәндер: json, csv"},
+
+	// Идентификаторы
+	"некорректный ID":                            {i18n.EN: "invalid ID", i18n.KK: "ID жарамсыз"},
+	"ID должен быть положительным":               {i18n.EN: "ID must be positive", i18n.KK: "ID оң сан болуы керек"},
+	"некорректный ID бронирования":               {i18n.EN: "invalid booking ID", i18n.KK: "брондау ID-і жарамсыз"},
+	"некорректный ID компании":                   {i18n.EN: "invalid company ID", i18n.KK: "компания ID-і жарамсыз"},
+	"некорректный ID адреса":                     {i18n.EN: "invalid address ID", i18n.KK: "мекенжай ID-і жарамсыз"},
+	"некорректный ID услуги":                     {i18n.EN: "invalid service ID", i18n.KK: "қызмет ID-і жарамсыз"},
+	"ID услуги обязателен":                       {i18n.EN: "service ID is required", i18n.KK: "қызмет ID-і міндетті"},
+	"некорректный ID пользователя":               {i18n.EN: "invalid user ID", i18n.KK: "пайдаланушы ID-і жарамсыз"},
+	"ID пользователя должен быть положительным":  {i18n.EN: "user ID must be positive", i18n.KK: "пайдаланушы ID-і оң сан болуы керек"},
+	"ID услуги указывается вместе с ID компании": {i18n.EN: "service ID must be given together with company ID", i18n.KK: "қызмет ID-і компания ID-імен бірге көрсетіледі"},
+
+	// Даты и время
+	"дата обязательна":                                            {i18n.EN: "date is required", i18n.KK: "күн міндетті"},
+	"некорректный формат даты, ожидается YYYY-MM-DD":              {i18n.EN: "invalid date format, expected YYYY-MM-DD", i18n.KK: "күн пішімі жарамсыз, YYYY-MM-DD күтіледі"},
+	"некорректный формат даты бронирования, ожидается YYYY-MM-DD": {i18n.EN: "invalid booking date format, expected YYYY-MM-DD", i18n.KK: "брондау күнінің пішімі жарамсыз, YYYY-MM-DD күтіледі"},
+	"некорректный формат времени, ожидается HH:MM":                {i18n.EN: "invalid time format, expected HH:MM", i18n.KK: "уақыт пішімі жарамсыз, HH:MM күтіледі"},
+	"некорректный формат времени начала, ожидается HH:MM":         {i18n.EN: "invalid start time format, expected HH:MM", i18n.KK: "басталу уақытының пішімі жарамсыз, HH:MM күтіледі"},
+	"конец периода раньше начала":                                 {i18n.EN: "the end of the period is before its start", i18n.KK: "кезеңнің соңы басынан бұрын"},
+	"конец диапазона времени должен быть позже начала":            {i18n.EN: "the end of the time range must be after its start", i18n.KK: "уақыт аралығының соңы басынан кейін болуы керек"},
+
+	// Бронирования
+	"причина обязательна":                                      {i18n.EN: "reason is required", i18n.KK: "себеп міндетті"},
+	"бронирование уже принадлежит этому пользователю":          {i18n.EN: "the booking already belongs to this user", i18n.KK: "брондау осы пайдаланушыға тиесілі"},
+	"отмененное бронирование восстанавливается через /restore": {i18n.EN: "a cancelled booking is restored via /restore", i18n.KK: "бас тартылған брондау /restore арқылы қалпына келтіріледі"},
+
+	// Конфигурация
+	"должно быть от 1 до 480":   {i18n.EN: "must be between 1 and 480", i18n.KK: "1 мен 480 аралығында болуы керек"},
+	"должно быть от 1 до 100":   {i18n.EN: "must be between 1 and 100", i18n.KK: "1 мен 100 аралығында болуы керек"},
+	"должно быть от 0 до 365":   {i18n.EN: "must be between 0 and 365", i18n.KK: "0 мен 365 аралығында болуы керек"},
+	"должно быть от 0 до 10080": {i18n.EN: "must be between 0 and 10080", i18n.KK: "0 мен 10080 аралығында болуы керек"},
+
+	// Импорт конфигурации (ошибки строк файла)
+	"уровень конфигурации уже указан в файле": {i18n.EN: "the configuration level is already defined in the file", i18n.KK: "конфигурация деңгейі файлда бұрын көрсетілген"},
+	"адрес не принадлежит компании":           {i18n.EN: "the address does not belong to the company", i18n.KK: "мекенжай компанияға тиесілі емес"},
+	"услуга не найдена в компании":            {i18n.EN: "the service was not found in the company", i18n.KK: "қызмет компаниядан табылмады"},
+	"услуга недоступна на этом адресе":        {i18n.EN: "the service is not available at this address", i18n.KK: "қызмет осы мекенжайда қолжетімсіз"},
+
+	// Транзакции
+	"бронирования одновременно изменяются другим запросом, повторите запрос": {i18n.EN: "bookings are being modified by another request, retry the request", i18n.KK: "брондауларды басқа сұраныс бір уақытта өзгертуде, сұранысты қайталаңыз"},
+
+	// Потоки
+	"превышено количество подключений к потоку слотов, повторите позже":       {i18n.EN: "too many connections to the slots stream, try again later", i18n.KK: "слоттар ағынына қосылымдар саны шектен асты, кейінірек қайталаңыз"},
+	"превышено количество подключений к потоку бронирований, повторите позже": {i18n.EN: "too many connections to the bookings stream, try again later", i18n.KK: "брондаулар ағынына қосылымдар саны шектен асты, кейінірек қайталаңыз"},
+}
+
+// invalidValue сообщение поля без перевода
+var invalidValue = i18n.Text{
+	i18n.EN: "invalid value",
+	i18n.KK: "мән жарамсыз",
+}
+
+// defaultMessage сообщение кода на исходном языке
+func defaultMessage(code Code) string {
+	return codeMessages[code][i18n.Source]
+}
+
+// Localize возвращает ошибку с сообщениями на языке l
+// Сообщение без перевода заменяется сообщением кода, сообщение поля - общим "некорректное значение"
+func (e *Error) Localize(l i18n.Locale) *Error {
+	if l == i18n.Source {
+		return e
+	}
+
+	result := *e
+	result.Message = translate(e.Message, l, codeMessages[e.Code])
+	if len(e.Fields) > 0 {
+		result.Fields = make([]FieldError, len(e.Fields))
+		for i, f := range e.Fields {
+			result.Fields[i] = FieldError{Field: f.Field, Message: translate(f.Message, l, invalidValue)}
+		}
+	}
+	return &result
+}
+
+// Translate переводит исходное сообщение сервиса на язык l
+// Используется для сообщений вне ошибок API (например, ошибки строк импорта в теле ответа 422);
+// сообщение без перевода возвращается как есть
+func Translate(message string, l i18n.Locale) string {
+	if l == i18n.Source {
+		return message
+	}
+	return translate(message, l, nil)
+}
+
+// translate переводит сообщение на язык l, без перевода используется fallback
+func translate(message string, l i18n.Locale, fallback i18n.Text) string {
+	if text, ok := messages[message].In(l); ok {
+		return text
+	}
+	if text, ok := fallback.In(l); ok {
+		return text
+	}
+	return message
+}
@@ -0,0 +1,101 @@
+package apierror
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+)
+
+// sourceRoot каталог, в котором ищутся константы сообщений (internal/)
+const sourceRoot = "../.."
+
+// messageConstants собирает строковые константы msg* из исходников: имя -> сообщение
+func messageConstants(t *testing.T) map[string]string {
+	t.Helper()
+
+	constants := make(map[string]string)
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(sourceRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if !strings.HasPrefix(name.Name, "msg") || i >= len(vs.Values) {
+						continue
+					}
+					lit, ok := vs.Values[i].(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					value, err := strconv.Unquote(lit.Value)
+					require.NoError(t, err)
+					constants[filepath.ToSlash(path)+":"+name.Name] = value
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return constants
+}
+
+// TestMessagesTranslated проверяет, что у каждого сообщения обработчиков (константы msg*) есть перевод:
+// в messages или совпадением с сообщением кода в codeMessages
+func TestMessagesTranslated(t *testing.T) {
+	codeTexts := make(map[string]bool, len(codeMessages))
+	for _, text := range codeMessages {
+		codeTexts[text[i18n.Source]] = true
+	}
+
+	constants := messageConstants(t)
+	require.NotEmpty(t, constants)
+
+	for name, message := range constants {
+		if codeTexts[message] {
+			continue
+		}
+		text, ok := messages[message]
+		if !assert.True(t, ok, "%s: нет перевода для %q", name, message) {
+			continue
+		}
+		for _, l := range []i18n.Locale{i18n.EN, i18n.KK} {
+			_, ok := text.In(l)
+			assert.True(t, ok, "%s: нет перевода %s для %q", name, l, message)
+		}
+	}
+}
+
+// TestCodeMessagesComplete проверяет, что у каждого кода есть сообщение на всех языках
+func TestCodeMessagesComplete(t *testing.T) {
+	for code, text := range codeMessages {
+		for _, l := range []i18n.Locale{i18n.Source, i18n.EN, i18n.KK} {
+			_, ok := text.In(l)
+			assert.True(t, ok, "код %s: нет сообщения %s", code, l)
+		}
+	}
+}
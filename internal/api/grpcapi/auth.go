@@ -0,0 +1,67 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	"github.com/m04kA/SMC-BookingService/pkg/api/bookingv1"
+)
+
+// publicMethods методы без аутентификации (как публичные маршруты HTTP API)
+var publicMethods = map[string]bool{
+	bookingv1.SlotService_GetAvailableSlots_FullMethodName:  true,
+	bookingv1.ConfigService_GetCompanyConfig_FullMethodName: true,
+}
+
+// authInterceptor проверяет учетные данные из metadata той же цепочкой аутентификаторов,
+// что и middleware.Auth, и сохраняет пользователя в контекст так же, как HTTP транспорт
+func authInterceptor(authenticator Authenticator, logger Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		identity, err := authenticator.Authenticate(requestFromMetadata(ctx))
+		if err != nil {
+			logger.WarnContext(ctx, "gRPC %s - authentication failed: %v", info.FullMethod, err)
+			return nil, problemStatus(middleware.AuthError(err))
+		}
+
+		return handler(middleware.WithIdentity(ctx, identity), req)
+	}
+}
+
+// requestFromMetadata переносит metadata запроса в заголовки HTTP запроса
+// Аутентификаторы читают только заголовки (Authorization, X-User-ID, X-User-Role)
+func requestFromMetadata(ctx context.Context) *http.Request {
+	r := &http.Request{Header: make(http.Header)}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		// Псевдозаголовки HTTP/2 (:authority) не являются учетными данными
+		if strings.HasPrefix(key, ":") {
+			continue
+		}
+		for _, v := range values {
+			r.Header.Add(key, v)
+		}
+	}
+
+	return r.WithContext(ctx)
+}
+
+// authUserID возвращает ID пользователя, сохраненный authInterceptor
+func authUserID(ctx context.Context, logger Logger, method string) (int64, error) {
+	userID, err := handlers.ResolveUserID(ctx, 0)
+	if err != nil {
+		logger.WarnContext(ctx, "gRPC %s - missing user ID: %v", method, err)
+		return 0, problemStatus(handlers.MapError(err))
+	}
+	return userID, nil
+}
@@ -0,0 +1,165 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	bookingModels "github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	"github.com/m04kA/SMC-BookingService/pkg/api/bookingv1"
+)
+
+// bookingServer адаптер BookingService над use case создания и сервисом бронирований
+type bookingServer struct {
+	bookingv1.UnimplementedBookingServiceServer
+
+	createBooking CreateBookingUseCase
+	service       BookingService
+	logger        Logger
+}
+
+// CreateBooking создает бронирование от имени авторизованного пользователя
+func (s *bookingServer) CreateBooking(ctx context.Context, req *bookingv1.CreateBookingRequest) (*bookingv1.Booking, error) {
+	const method = "CreateBooking"
+
+	userID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+
+	useCaseReq, err := toCreateBookingRequest(req, userID)
+	if err != nil {
+		return nil, invalidArgument(ctx, s.logger, method, msgInvalidParams, err)
+	}
+
+	result, err := s.createBooking.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromCreateBookingResponse(result), nil
+}
+
+// GetBooking возвращает бронирование, если оно доступно пользователю
+func (s *bookingServer) GetBooking(ctx context.Context, req *bookingv1.GetBookingRequest) (*bookingv1.Booking, error) {
+	const method = "GetBooking"
+
+	userID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetBookingId() <= 0 {
+		return nil, invalidField(ctx, s.logger, method, "booking_id", msgInvalidBookingID)
+	}
+
+	booking, err := s.service.GetByID(ctx, req.GetBookingId(), userID)
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromBookingResponse(booking), nil
+}
+
+// CancelBooking отменяет бронирование
+func (s *bookingServer) CancelBooking(ctx context.Context, req *bookingv1.CancelBookingRequest) (*bookingv1.CancelBookingResponse, error) {
+	const method = "CancelBooking"
+
+	userID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetBookingId() <= 0 {
+		return nil, invalidField(ctx, s.logger, method, "booking_id", msgInvalidBookingID)
+	}
+
+	err = s.service.Cancel(ctx, req.GetBookingId(), &bookingModels.CancelBookingRequest{
+		UserID:             userID,
+		CancellationReason: req.GetCancellationReason(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return &bookingv1.CancelBookingResponse{}, nil
+}
+
+// UpdateBookingStatus изменяет статус бронирования (менеджеры и операторы компании)
+func (s *bookingServer) UpdateBookingStatus(ctx context.Context, req *bookingv1.UpdateBookingStatusRequest) (*bookingv1.UpdateBookingStatusResponse, error) {
+	const method = "UpdateBookingStatus"
+
+	userID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetBookingId() <= 0 {
+		return nil, invalidField(ctx, s.logger, method, "booking_id", msgInvalidBookingID)
+	}
+
+	err = s.service.UpdateStatus(ctx, req.GetBookingId(), &bookingModels.UpdateStatusRequest{
+		UserID: userID,
+		Status: req.GetStatus(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return &bookingv1.UpdateBookingStatusResponse{}, nil
+}
+
+// ListUserBookings возвращает историю бронирований пользователя
+// user_id = 0 - авторизованный пользователь; чужие бронирования доступны только администратору
+func (s *bookingServer) ListUserBookings(ctx context.Context, req *bookingv1.ListUserBookingsRequest) (*bookingv1.BookingList, error) {
+	const method = "ListUserBookings"
+
+	authUserID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.GetUserId()
+	if userID == 0 {
+		userID = authUserID
+	}
+	if userID != authUserID && !middleware.IsAdmin(ctx) {
+		s.logger.WarnContext(ctx, "gRPC %s - access denied: user_id=%d, auth_user_id=%d", method, userID, authUserID)
+		return nil, problemStatus(apierror.New(http.StatusForbidden, apierror.CodeAccessDenied, msgForbiddenUser))
+	}
+
+	serviceReq, err := toUserBookingsRequest(req, userID)
+	if err != nil {
+		return nil, invalidArgument(ctx, s.logger, method, msgInvalidParams, err)
+	}
+
+	result, err := s.service.GetUserBookings(ctx, serviceReq)
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromBookingList(result), nil
+}
+
+// ListCompanyBookings возвращает бронирования компании с фильтрами
+func (s *bookingServer) ListCompanyBookings(ctx context.Context, req *bookingv1.ListCompanyBookingsRequest) (*bookingv1.BookingList, error) {
+	const method = "ListCompanyBookings"
+
+	userID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCompanyId() <= 0 {
+		return nil, invalidField(ctx, s.logger, method, "company_id", msgInvalidCompanyID)
+	}
+
+	serviceReq, err := toCompanyBookingsRequest(req, userID)
+	if err != nil {
+		return nil, invalidArgument(ctx, s.logger, method, msgInvalidParams, err)
+	}
+
+	result, err := s.service.GetCompanyBookings(ctx, serviceReq)
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromBookingList(result), nil
+}
@@ -0,0 +1,71 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/config"
+	configModels "github.com/m04kA/SMC-BookingService/internal/service/config/models"
+	"github.com/m04kA/SMC-BookingService/pkg/api/bookingv1"
+)
+
+// configServer адаптер ConfigService над сервисом конфигурации
+type configServer struct {
+	bookingv1.UnimplementedConfigServiceServer
+
+	service ConfigService
+	logger  Logger
+}
+
+// GetCompanyConfig возвращает конфигурацию с иерархическим поиском
+// Если конфигурация не настроена, возвращаются значения по умолчанию (id = 0)
+func (s *configServer) GetCompanyConfig(ctx context.Context, req *bookingv1.GetCompanyConfigRequest) (*bookingv1.SlotsConfig, error) {
+	const method = "GetCompanyConfig"
+
+	if req.GetCompanyId() <= 0 {
+		return nil, invalidField(ctx, s.logger, method, "company_id", msgInvalidCompanyID)
+	}
+
+	result, err := s.service.GetWithHierarchy(ctx, &configModels.GetConfigRequest{
+		CompanyID: req.GetCompanyId(),
+		AddressID: req.AddressId,
+		ServiceID: req.ServiceId,
+	})
+	if err != nil {
+		if errors.Is(err, config.ErrConfigNotFound) {
+			return defaultConfig(req.GetCompanyId()), nil
+		}
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromConfigResponse(result), nil
+}
+
+// UpdateCompanyConfig обновляет конфигурацию, найденную иерархическим поиском (как PUT /companies/{id}/config)
+func (s *configServer) UpdateCompanyConfig(ctx context.Context, req *bookingv1.UpdateCompanyConfigRequest) (*bookingv1.SlotsConfig, error) {
+	const method = "UpdateCompanyConfig"
+
+	userID, err := authUserID(ctx, s.logger, method)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCompanyId() <= 0 {
+		return nil, invalidField(ctx, s.logger, method, "company_id", msgInvalidCompanyID)
+	}
+
+	existing, err := s.service.GetWithHierarchy(ctx, &configModels.GetConfigRequest{
+		CompanyID: req.GetCompanyId(),
+		AddressID: req.AddressId,
+		ServiceID: req.ServiceId,
+	})
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	result, err := s.service.Update(ctx, existing.ID, toUpdateConfigRequest(req, userID))
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromConfigResponse(result), nil
+}
@@ -0,0 +1,45 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/auth"
+	bookingModels "github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	configModels "github.com/m04kA/SMC-BookingService/internal/service/config/models"
+	createBooking "github.com/m04kA/SMC-BookingService/internal/usecase/create_booking"
+	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+)
+
+type CreateBookingUseCase interface {
+	Execute(ctx context.Context, req *createBooking.Request) (*createBooking.Response, error)
+}
+
+type GetAvailableSlotsUseCase interface {
+	Execute(ctx context.Context, req *getAvailableSlots.Request) (*getAvailableSlots.Response, error)
+}
+
+type BookingService interface {
+	GetByID(ctx context.Context, id int64, userID int64) (*bookingModels.BookingResponse, error)
+	Cancel(ctx context.Context, bookingID int64, req *bookingModels.CancelBookingRequest) error
+	UpdateStatus(ctx context.Context, bookingID int64, req *bookingModels.UpdateStatusRequest) error
+	GetUserBookings(ctx context.Context, req *bookingModels.GetUserBookingsRequest) (*bookingModels.BookingListResponse, error)
+	GetCompanyBookings(ctx context.Context, req *bookingModels.GetCompanyBookingsRequest) (*bookingModels.BookingListResponse, error)
+}
+
+type ConfigService interface {
+	GetWithHierarchy(ctx context.Context, req *configModels.GetConfigRequest) (*configModels.ConfigResponse, error)
+	Update(ctx context.Context, id int64, req *configModels.UpdateConfigRequest) (*configModels.ConfigResponse, error)
+}
+
+// Authenticator проверяет учетные данные (та же цепочка, что и у HTTP middleware.Auth)
+type Authenticator interface {
+	Authenticate(r *http.Request) (*auth.Identity, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
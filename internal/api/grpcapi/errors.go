@@ -0,0 +1,106 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+)
+
+// Сообщения клиенту - те же, что у HTTP обработчиков
+const (
+	msgInvalidDate      = "некорректный формат даты, ожидается YYYY-MM-DD"
+	msgInvalidTime      = "некорректный формат времени начала, ожидается HH:MM"
+	msgInvalidParams    = "некорректные параметры запроса"
+	msgInvalidBookingID = "некорректный ID бронирования"
+	msgInvalidCompanyID = "некорректный ID компании"
+	msgForbiddenUser    = "нет доступа к бронированиям другого пользователя"
+)
+
+// errorDomain домен ошибок в деталях ErrorInfo
+const errorDomain = "bookingservice.smc"
+
+// failedPreconditionCodes ошибки, которые исправляются не изменением запроса, а состоянием системы
+// (в HTTP это 400 или 409, в gRPC - FailedPrecondition)
+var failedPreconditionCodes = map[apierror.Code]bool{
+	apierror.CodeCompanyClosed:            true,
+	apierror.CodeBookingTooEarly:          true,
+	apierror.CodeBookingCannotBeCancelled: true,
+	apierror.CodeBookingNotCancelled:      true,
+	apierror.CodeConfigConflicts:          true,
+}
+
+// toStatus преобразует ошибку сервиса или use case в статус gRPC по общему каталогу ошибок API
+// Ошибки вне каталога возвращаются клиенту как Internal без подробностей
+func toStatus(ctx context.Context, logger Logger, method string, err error) error {
+	return problemStatus(handlers.ServiceError(ctx, logger, "gRPC "+method, err))
+}
+
+// invalidArgument ошибка разбора запроса (до вызова сервиса)
+// Ошибки полей (domain.FieldError) передаются в деталях BadRequest, иначе - сообщение msg
+func invalidArgument(ctx context.Context, logger Logger, method, msg string, err error) error {
+	logger.WarnContext(ctx, "gRPC %s - invalid argument: %v", method, err)
+	return problemStatus(handlers.InvalidParams(msg, err))
+}
+
+// invalidField ошибка обязательного или некорректного поля запроса
+func invalidField(ctx context.Context, logger Logger, method, field, msg string) error {
+	logger.WarnContext(ctx, "gRPC %s - invalid argument: %s", method, field)
+	return problemStatus(apierror.Validation(apierror.Field(field, msg)))
+}
+
+// problemStatus статус gRPC для ошибки API
+// Код ошибки API передается в деталях ErrorInfo (reason), ошибки полей - в BadRequest
+func problemStatus(e *apierror.Error) error {
+	problem := e.Problem()
+	st := status.New(grpcCode(e), problem.Detail)
+
+	info := &errdetails.ErrorInfo{Reason: string(e.Code), Domain: errorDomain}
+	if len(e.Fields) == 0 {
+		if withDetails, err := st.WithDetails(info); err == nil {
+			st = withDetails
+		}
+		return st.Err()
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, f := range e.Fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Message,
+		})
+	}
+	if withDetails, err := st.WithDetails(info, badRequest); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// grpcCode код gRPC по HTTP статусу ошибки API
+func grpcCode(e *apierror.Error) codes.Code {
+	if failedPreconditionCodes[e.Code] {
+		return codes.FailedPrecondition
+	}
+
+	switch e.Status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
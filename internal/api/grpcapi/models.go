@@ -0,0 +1,282 @@
+package grpcapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	bookingModels "github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	configModels "github.com/m04kA/SMC-BookingService/internal/service/config/models"
+	createBooking "github.com/m04kA/SMC-BookingService/internal/usecase/create_booking"
+	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+	"github.com/m04kA/SMC-BookingService/pkg/api/bookingv1"
+	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+	"github.com/m04kA/SMC-BookingService/pkg/types"
+)
+
+// toCreateBookingRequest конвертирует запрос gRPC в модель use case
+// userID - ID авторизованного пользователя
+func toCreateBookingRequest(req *bookingv1.CreateBookingRequest, userID int64) (*createBooking.Request, error) {
+	bookingDate, err := time.Parse(domain.DateFormat, req.GetBookingDate())
+	if err != nil {
+		return nil, domain.InvalidField(err, "booking_date", msgInvalidDate)
+	}
+
+	startTime, err := types.NewTimeStringFromString(req.GetStartTime())
+	if err != nil {
+		return nil, domain.InvalidField(err, "start_time", msgInvalidTime)
+	}
+
+	result := &createBooking.Request{
+		UserID:    userID,
+		CompanyID: req.GetCompanyId(),
+		AddressID: req.GetAddressId(),
+		ServiceID: req.GetServiceId(),
+		Date:      bookingDate,
+		StartTime: startTime,
+		Notes:     req.Notes,
+	}
+
+	if car := req.GetCar(); car != nil {
+		result.Car = &createBooking.Car{
+			ID:           car.GetId(),
+			Brand:        car.GetBrand(),
+			Model:        car.GetModel(),
+			LicensePlate: car.GetLicensePlate(),
+		}
+	}
+
+	return result, nil
+}
+
+// fromCreateBookingResponse конвертирует созданное бронирование в сообщение gRPC
+func fromCreateBookingResponse(resp *createBooking.Response) *bookingv1.Booking {
+	return &bookingv1.Booking{
+		Id:                resp.ID,
+		UserId:            resp.UserID,
+		CompanyId:         resp.CompanyID,
+		AddressId:         resp.AddressID,
+		ServiceId:         resp.ServiceID,
+		CarId:             resp.CarID,
+		BookingDate:       resp.BookingDate.Format(domain.DateFormat),
+		StartTime:         resp.StartTime.String(),
+		DurationMinutes:   int32(resp.DurationMinutes),
+		Status:            resp.Status,
+		ServiceName:       resp.ServiceName,
+		ServicePrice:      resp.ServicePrice,
+		CarBrand:          resp.CarBrand,
+		CarModel:          resp.CarModel,
+		CarLicensePlate:   resp.CarLicensePlate,
+		Notes:             resp.Notes,
+		CarDetailsPending: resp.CarDetailsPending,
+		CreatedAt:         timestamppb.New(resp.CreatedAt),
+		UpdatedAt:         timestamppb.New(resp.UpdatedAt),
+	}
+}
+
+// fromBookingResponse конвертирует бронирование сервиса в сообщение gRPC
+func fromBookingResponse(b *bookingModels.BookingResponse) *bookingv1.Booking {
+	result := &bookingv1.Booking{
+		Id:                 b.ID,
+		UserId:             b.UserID,
+		CompanyId:          b.CompanyID,
+		AddressId:          b.AddressID,
+		ServiceId:          b.ServiceID,
+		CarId:              b.CarID,
+		BookingDate:        b.BookingDate,
+		StartTime:          b.StartTime,
+		DurationMinutes:    int32(b.DurationMinutes),
+		Status:             b.Status,
+		ServiceName:        b.ServiceName,
+		ServicePrice:       b.ServicePrice,
+		CarBrand:           b.CarBrand,
+		CarModel:           b.CarModel,
+		CarLicensePlate:    b.CarLicensePlate,
+		Notes:              b.Notes,
+		CarDetailsPending:  b.CarDetailsPending,
+		CancellationReason: b.CancellationReason,
+		CreatedAt:          timestamppb.New(b.CreatedAt),
+		UpdatedAt:          timestamppb.New(b.UpdatedAt),
+	}
+
+	// CancelledAt в модели сервиса - строка ISO 8601
+	if b.CancelledAt != nil {
+		if cancelledAt, err := time.Parse(time.RFC3339, *b.CancelledAt); err == nil {
+			result.CancelledAt = timestamppb.New(cancelledAt)
+		}
+	}
+
+	return result
+}
+
+// fromBookingList конвертирует страницу бронирований в сообщение gRPC
+func fromBookingList(list *bookingModels.BookingListResponse) *bookingv1.BookingList {
+	result := &bookingv1.BookingList{
+		Bookings:   make([]*bookingv1.Booking, len(list.Bookings)),
+		NextCursor: list.NextCursor,
+	}
+	for i := range list.Bookings {
+		result.Bookings[i] = fromBookingResponse(&list.Bookings[i])
+	}
+	return result
+}
+
+// toPageRequest конвертирует параметры пагинации (не переданы - первая страница по умолчанию)
+func toPageRequest(page *bookingv1.PageRequest) bookingModels.PageRequest {
+	if page == nil {
+		return bookingModels.PageRequest{}
+	}
+	return bookingModels.PageRequest{
+		Limit:  int(page.GetLimit()),
+		Cursor: page.Cursor,
+		Sort:   page.Sort,
+	}
+}
+
+// toUserBookingsRequest конвертирует запрос истории бронирований пользователя
+func toUserBookingsRequest(req *bookingv1.ListUserBookingsRequest, userID int64) (*bookingModels.GetUserBookingsRequest, error) {
+	from, err := parseOptionalDate(req.From, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalDate(req.To, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingModels.GetUserBookingsRequest{
+		UserID:    userID,
+		Status:    req.Status,
+		StartDate: from,
+		EndDate:   to,
+		Page:      toPageRequest(req.GetPage()),
+	}, nil
+}
+
+// toCompanyBookingsRequest конвертирует запрос бронирований компании
+func toCompanyBookingsRequest(req *bookingv1.ListCompanyBookingsRequest, userID int64) (*bookingModels.GetCompanyBookingsRequest, error) {
+	from, err := parseOptionalDate(req.From, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseOptionalDate(req.To, "to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingModels.GetCompanyBookingsRequest{
+		UserID:          userID,
+		CompanyID:       req.GetCompanyId(),
+		AddressID:       req.AddressId,
+		ServiceID:       req.ServiceId,
+		CustomerID:      req.CustomerId,
+		StartDate:       from,
+		EndDate:         to,
+		TimeFrom:        req.TimeFrom,
+		TimeTo:          req.TimeTo,
+		Statuses:        req.GetStatuses(),
+		LicensePlate:    req.LicensePlate,
+		IncludeInactive: req.GetIncludeInactive(),
+		Page:            toPageRequest(req.GetPage()),
+	}, nil
+}
+
+// toAvailableSlotsRequest конвертирует запрос доступных слотов в модель use case
+func toAvailableSlotsRequest(req *bookingv1.GetAvailableSlotsRequest) (*getAvailableSlots.Request, error) {
+	date, err := time.Parse(domain.DateFormat, req.GetDate())
+	if err != nil {
+		return nil, domain.InvalidField(err, "date", msgInvalidDate)
+	}
+
+	return &getAvailableSlots.Request{
+		CompanyID: req.GetCompanyId(),
+		AddressID: req.GetAddressId(),
+		ServiceID: req.GetServiceId(),
+		Date:      date,
+	}, nil
+}
+
+// fromAvailableSlotsResponse конвертирует ответ use case в сообщение gRPC
+func fromAvailableSlotsResponse(resp *getAvailableSlots.Response) *bookingv1.AvailableSlots {
+	slots := make([]*bookingv1.Slot, len(resp.Slots))
+	for i, slot := range resp.Slots {
+		slots[i] = &bookingv1.Slot{
+			StartTime:       slot.StartTime.String(),
+			DurationMinutes: int32(slot.DurationMinutes),
+			AvailableSpots:  int32(slot.AvailableSpots),
+			TotalSpots:      int32(slot.TotalSpots),
+		}
+	}
+
+	return &bookingv1.AvailableSlots{
+		Date:      resp.Date.Format(domain.DateFormat),
+		CompanyId: resp.CompanyID,
+		AddressId: resp.AddressID,
+		ServiceId: resp.ServiceID,
+		Slots:     slots,
+	}
+}
+
+// fromConfigResponse конвертирует конфигурацию сервиса в сообщение gRPC
+func fromConfigResponse(c *configModels.ConfigResponse) *bookingv1.SlotsConfig {
+	result := &bookingv1.SlotsConfig{
+		Id:                      c.ID,
+		CompanyId:               c.CompanyID,
+		AddressId:               c.AddressID,
+		ServiceId:               c.ServiceID,
+		SlotDurationMinutes:     int32(c.SlotDurationMinutes),
+		MaxConcurrentBookings:   int32(c.MaxConcurrentBookings),
+		AdvanceBookingDays:      int32(c.AdvanceBookingDays),
+		MinBookingNoticeMinutes: int32(c.MinBookingNoticeMinutes),
+	}
+	if !c.CreatedAt.IsZero() {
+		result.CreatedAt = timestamppb.New(c.CreatedAt)
+	}
+	if !c.UpdatedAt.IsZero() {
+		result.UpdatedAt = timestamppb.New(c.UpdatedAt)
+	}
+	return result
+}
+
+// defaultConfig конфигурация по умолчанию для компании без настроенной конфигурации
+func defaultConfig(companyID int64) *bookingv1.SlotsConfig {
+	return &bookingv1.SlotsConfig{
+		Id:                      0, // 0 означает, что это не из БД
+		CompanyId:               companyID,
+		SlotDurationMinutes:     domain.DefaultSlotDurationMinutes,
+		MaxConcurrentBookings:   domain.DefaultMaxConcurrentBookings,
+		AdvanceBookingDays:      domain.DefaultAdvanceBookingDays,
+		MinBookingNoticeMinutes: domain.DefaultMinBookingNoticeMinutes,
+	}
+}
+
+// toUpdateConfigRequest конвертирует запрос обновления конфигурации в модель сервиса
+func toUpdateConfigRequest(req *bookingv1.UpdateCompanyConfigRequest, userID int64) *configModels.UpdateConfigRequest {
+	return &configModels.UpdateConfigRequest{
+		UserID:                  userID,
+		SlotDurationMinutes:     optionalInt(req.SlotDurationMinutes),
+		MaxConcurrentBookings:   optionalInt(req.MaxConcurrentBookings),
+		AdvanceBookingDays:      optionalInt(req.AdvanceBookingDays),
+		MinBookingNoticeMinutes: optionalInt(req.MinBookingNoticeMinutes),
+		Force:                   req.GetForce(),
+	}
+}
+
+func parseOptionalDate(value *string, field string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	date, err := time.Parse(domain.DateFormat, *value)
+	if err != nil {
+		return nil, domain.InvalidField(err, field, msgInvalidDate)
+	}
+	return &date, nil
+}
+
+func optionalInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	return ptr.Ptr(int(*value))
+}
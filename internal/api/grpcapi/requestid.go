@@ -0,0 +1,33 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
+)
+
+// requestIDInterceptor принимает идентификатор запроса из metadata x-request-id или генерирует новый
+// и возвращает его в заголовках ответа (как middleware.RequestID для HTTP)
+func requestIDInterceptor() grpc.UnaryServerInterceptor {
+	key := strings.ToLower(requestid.Header)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(key); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(key, id))
+
+		return handler(requestid.WithContext(ctx, id), req)
+	}
+}
@@ -0,0 +1,108 @@
+// Package grpcapi gRPC API для внутренних сервисов (schemas/proto/booking/v1/booking.proto)
+//
+// Тонкие адаптеры над теми же use case и сервисами, что и HTTP обработчики: запросы
+// конвертируются в модели сервисов, ошибки - в статусы gRPC по общему каталогу ошибок API (handlers.MapError).
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/m04kA/SMC-BookingService/pkg/api/bookingv1"
+)
+
+// Config настройки gRPC сервера
+type Config struct {
+	Reflection bool // Регистрировать server reflection (grpcurl, Postman)
+}
+
+// Server gRPC сервер сервиса бронирований
+type Server struct {
+	server *grpc.Server
+	logger Logger
+}
+
+// NewServer создает gRPC сервер и регистрирует сервисы бронирований, слотов и конфигурации
+func NewServer(
+	createBooking CreateBookingUseCase,
+	getAvailableSlots GetAvailableSlotsUseCase,
+	bookingService BookingService,
+	configService ConfigService,
+	authenticator Authenticator,
+	cfg Config,
+	logger Logger,
+) *Server {
+	server := grpc.NewServer(
+		// Спан вызова с контекстом трассы из метаданных traceparent
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			requestIDInterceptor(),
+			sourceInterceptor(),
+			loggingInterceptor(logger),
+			authInterceptor(authenticator, logger),
+		),
+	)
+
+	bookingv1.RegisterBookingServiceServer(server, &bookingServer{
+		createBooking: createBooking,
+		service:       bookingService,
+		logger:        logger,
+	})
+	bookingv1.RegisterSlotServiceServer(server, &slotServer{
+		getAvailableSlots: getAvailableSlots,
+		logger:            logger,
+	})
+	bookingv1.RegisterConfigServiceServer(server, &configServer{
+		service: configService,
+		logger:  logger,
+	})
+
+	if cfg.Reflection {
+		reflection.Register(server)
+	}
+
+	return &Server{
+		server: server,
+		logger: logger,
+	}
+}
+
+// Serve принимает соединения до остановки сервера
+// После Shutdown возвращает nil
+func (s *Server) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+// Shutdown перестает принимать новые вызовы и ждет завершения текущих
+// Если ctx завершится раньше, оставшиеся вызовы прерываются
+func (s *Server) Shutdown(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.logger.Warn("gRPC: graceful stop timed out, closing remaining calls")
+		s.server.Stop()
+		<-done
+	}
+}
+
+// loggingInterceptor логирует завершение каждого вызова с кодом и длительностью
+func loggingInterceptor(logger Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logger.InfoContext(ctx, "gRPC %s - %s (%s)", info.FullMethod, status.Code(err), time.Since(start))
+		return resp, err
+	}
+}
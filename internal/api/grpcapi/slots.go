@@ -0,0 +1,32 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/pkg/api/bookingv1"
+)
+
+// slotServer адаптер SlotService над use case доступных слотов
+type slotServer struct {
+	bookingv1.UnimplementedSlotServiceServer
+
+	getAvailableSlots GetAvailableSlotsUseCase
+	logger            Logger
+}
+
+// GetAvailableSlots возвращает доступные слоты адреса компании для услуги на дату
+func (s *slotServer) GetAvailableSlots(ctx context.Context, req *bookingv1.GetAvailableSlotsRequest) (*bookingv1.AvailableSlots, error) {
+	const method = "GetAvailableSlots"
+
+	useCaseReq, err := toAvailableSlotsRequest(req)
+	if err != nil {
+		return nil, invalidArgument(ctx, s.logger, method, msgInvalidParams, err)
+	}
+
+	result, err := s.getAvailableSlots.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, toStatus(ctx, s.logger, method, err)
+	}
+
+	return fromAvailableSlotsResponse(result), nil
+}
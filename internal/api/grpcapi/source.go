@@ -0,0 +1,17 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/m04kA/SMC-BookingService/pkg/metrics"
+)
+
+// sourceInterceptor отмечает вызовы gRPC как источник изменений в бизнес метриках
+// (как middleware.Source для HTTP)
+func sourceInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(metrics.WithSource(ctx, metrics.SourceGRPC), req)
+	}
+}
@@ -0,0 +1,17 @@
+package admin_get_booking_history
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+)
+
+type AdminService interface {
+	GetHistory(ctx context.Context, bookingID int64, adminID int64) (*models.BookingHistoryResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
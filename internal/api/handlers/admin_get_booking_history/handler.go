@@ -0,0 +1,62 @@
+package admin_get_booking_history
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidBookingID = "некорректный ID бронирования"
+	msgMissingUserID    = "отсутствует ID пользователя"
+)
+
+type Handler struct {
+	service AdminService
+	logger  Logger
+}
+
+func NewHandler(service AdminService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/admin/bookings/{bookingId}/history
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем bookingId из URL
+	vars := mux.Vars(r)
+	bookingIDStr := vars["bookingId"]
+
+	bookingID, err := strconv.ParseInt(bookingIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /admin/bookings/{id}/history - Invalid booking ID: %v", err)
+		handlers.RespondFieldError(w, "bookingId", msgInvalidBookingID)
+		return
+	}
+
+	// Получаем ID администратора из контекста (через middleware Auth)
+	adminID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "GET /admin/bookings/{id}/history - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	history, err := h.service.GetHistory(r.Context(), bookingID, adminID)
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /admin/bookings/{id}/history - Failed to get history: booking_id=%d", bookingID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "GET /admin/bookings/{id}/history - History retrieved: booking_id=%d, entries=%d",
+		bookingID, len(history.Entries))
+	handlers.RespondJSON(w, http.StatusOK, history)
+}
@@ -0,0 +1,5 @@
+package admin_get_log_level
+
+type LevelController interface {
+	Level() string
+}
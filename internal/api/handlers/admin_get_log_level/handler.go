@@ -0,0 +1,23 @@
+package admin_get_log_level
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+)
+
+type Handler struct {
+	levels LevelController
+}
+
+func NewHandler(levels LevelController) *Handler {
+	return &Handler{
+		levels: levels,
+	}
+}
+
+// Handle GET /api/v1/admin/log-level
+// Возвращает текущий уровень логирования
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	handlers.RespondJSON(w, http.StatusOK, &LogLevelResponse{Level: h.levels.Level()})
+}
@@ -0,0 +1,6 @@
+package admin_get_log_level
+
+// LogLevelResponse HTTP response model
+type LogLevelResponse struct {
+	Level string `json:"level"`
+}
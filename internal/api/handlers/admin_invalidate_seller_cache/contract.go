@@ -0,0 +1,15 @@
+package admin_invalidate_seller_cache
+
+import "context"
+
+// SellerCache кеш ответов SellerService
+type SellerCache interface {
+	InvalidateCompany(companyID int64)
+	InvalidateService(companyID, serviceID int64)
+	InvalidateAll()
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+}
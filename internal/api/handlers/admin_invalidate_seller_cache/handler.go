@@ -0,0 +1,75 @@
+package admin_invalidate_seller_cache
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+)
+
+const (
+	msgInvalidRequestBody = "некорректное тело запроса"
+	msgInvalidCompanyID   = "некорректный ID компании"
+	msgInvalidServiceID   = "некорректный ID услуги"
+	msgServiceNoCompany   = "ID услуги указывается вместе с ID компании"
+	logPrefix             = "POST /admin/cache/sellerservice/invalidate"
+)
+
+type Handler struct {
+	cache  SellerCache
+	logger Logger
+}
+
+func NewHandler(cache SellerCache, logger Logger) *Handler {
+	return &Handler{
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+// Handle POST /api/v1/admin/cache/sellerservice/invalidate
+// Удаляет из кеша SellerService компанию, услугу или все записи, чтобы изменения в SellerService
+// (менеджеры, адреса, расписание, услуги) применились до истечения TTL.
+// Кеш локальный: инвалидация действует только на реплике, получившей запрос
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req InvalidateCacheRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid request body: %v", logPrefix, err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	if req.CompanyID != nil && *req.CompanyID <= 0 {
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+	if req.ServiceID != nil {
+		if req.CompanyID == nil {
+			handlers.RespondFieldError(w, "serviceId", msgServiceNoCompany)
+			return
+		}
+		if *req.ServiceID <= 0 {
+			handlers.RespondFieldError(w, "serviceId", msgInvalidServiceID)
+			return
+		}
+	}
+
+	resp := &InvalidateCacheResponse{CompanyID: req.CompanyID, ServiceID: req.ServiceID}
+	switch {
+	case req.ServiceID != nil:
+		h.cache.InvalidateService(*req.CompanyID, *req.ServiceID)
+		resp.Scope = ScopeService
+	case req.CompanyID != nil:
+		h.cache.InvalidateCompany(*req.CompanyID)
+		resp.Scope = ScopeCompany
+	default:
+		h.cache.InvalidateAll()
+		resp.Scope = ScopeAll
+	}
+
+	adminID, _ := middleware.GetUserID(r.Context())
+	h.logger.InfoContext(r.Context(), "%s - Cache invalidated: scope=%s, company_id=%d, service_id=%d, admin_id=%d",
+		logPrefix, resp.Scope, ptr.PtrGet(req.CompanyID), ptr.PtrGet(req.ServiceID), adminID)
+	handlers.RespondJSON(w, http.StatusOK, resp)
+}
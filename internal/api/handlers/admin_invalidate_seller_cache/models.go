@@ -0,0 +1,22 @@
+package admin_invalidate_seller_cache
+
+// Области инвалидации кеша
+const (
+	ScopeAll     = "all"
+	ScopeCompany = "company"
+	ScopeService = "service"
+)
+
+// InvalidateCacheRequest HTTP request model
+// Без полей очищается весь кеш, с companyId - компания и ее услуги, с companyId и serviceId - одна услуга
+type InvalidateCacheRequest struct {
+	CompanyID *int64 `json:"companyId,omitempty"`
+	ServiceID *int64 `json:"serviceId,omitempty"`
+}
+
+// InvalidateCacheResponse HTTP response model
+type InvalidateCacheResponse struct {
+	Scope     string `json:"scope"`
+	CompanyID *int64 `json:"companyId,omitempty"`
+	ServiceID *int64 `json:"serviceId,omitempty"`
+}
@@ -0,0 +1,21 @@
+package admin_reassign_booking
+
+import (
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+)
+
+// ReassignBookingRequest HTTP request model
+type ReassignBookingRequest struct {
+	UserID int64  `json:"userId"`
+	Reason string `json:"reason"`
+}
+
+// ToServiceRequest конвертирует HTTP request в модель сервиса
+// adminID - ID авторизованного администратора
+func (r *ReassignBookingRequest) ToServiceRequest(adminID int64) *models.ReassignBookingRequest {
+	return &models.ReassignBookingRequest{
+		AdminID: adminID,
+		UserID:  r.UserID,
+		Reason:  r.Reason,
+	}
+}
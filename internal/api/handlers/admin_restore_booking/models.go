@@ -0,0 +1,23 @@
+package admin_restore_booking
+
+import (
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+)
+
+// RestoreBookingRequest HTTP request model
+type RestoreBookingRequest struct {
+	Status *string `json:"status,omitempty"`
+	Reason string  `json:"reason"`
+	Force  bool    `json:"force,omitempty"`
+}
+
+// ToServiceRequest конвертирует HTTP request в модель сервиса
+// adminID - ID авторизованного администратора
+func (r *RestoreBookingRequest) ToServiceRequest(adminID int64) *models.RestoreBookingRequest {
+	return &models.RestoreBookingRequest{
+		AdminID: adminID,
+		Status:  r.Status,
+		Reason:  r.Reason,
+		Force:   r.Force,
+	}
+}
@@ -0,0 +1,17 @@
+package admin_search_bookings
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+)
+
+type AdminService interface {
+	SearchBookings(ctx context.Context, req *models.SearchBookingsRequest) (*models.SearchBookingsResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,56 @@
+package admin_search_bookings
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgMissingUserID = "отсутствует ID пользователя"
+	msgInvalidParams = "некорректные параметры запроса"
+)
+
+type Handler struct {
+	service AdminService
+	logger  Logger
+}
+
+func NewHandler(service AdminService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/admin/bookings
+// Query params: companyId, userId, addressId, serviceId, status (через запятую), from, to, licensePlate, limit, offset
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Получаем ID администратора из контекста (через middleware Auth)
+	adminID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "GET /admin/bookings - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	// Формируем запрос к сервису
+	serviceReq, err := ToServiceRequest(adminID, r.URL.Query())
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /admin/bookings - Invalid parameters: %v", err)
+		handlers.RespondInvalidParams(w, msgInvalidParams, err)
+		return
+	}
+
+	result, err := h.service.SearchBookings(r.Context(), serviceReq)
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /admin/bookings - Failed to search bookings: admin_id=%d", adminID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "GET /admin/bookings - Bookings found: admin_id=%d, count=%d", adminID, len(result.Bookings))
+	handlers.RespondJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,94 @@
+package admin_search_bookings
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+)
+
+// Сообщения клиенту об ошибках query параметров
+const (
+	msgInvalidID     = "некорректный ID"
+	msgInvalidDate   = "некорректный формат даты, ожидается YYYY-MM-DD"
+	msgInvalidNumber = "ожидается целое число"
+)
+
+// ToServiceRequest формирует запрос к сервису из query параметров
+func ToServiceRequest(adminID int64, query url.Values) (*models.SearchBookingsRequest, error) {
+	req := &models.SearchBookingsRequest{AdminID: adminID}
+
+	var err error
+	if req.CompanyID, err = parseOptionalID(query, "companyId"); err != nil {
+		return nil, err
+	}
+	if req.UserID, err = parseOptionalID(query, "userId"); err != nil {
+		return nil, err
+	}
+	if req.AddressID, err = parseOptionalID(query, "addressId"); err != nil {
+		return nil, err
+	}
+	if req.ServiceID, err = parseOptionalID(query, "serviceId"); err != nil {
+		return nil, err
+	}
+
+	// Парсим статусы, перечисленные через запятую
+	if statusStr := query.Get("status"); statusStr != "" {
+		for _, status := range strings.Split(statusStr, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				req.Statuses = append(req.Statuses, status)
+			}
+		}
+	}
+
+	if req.StartDate, err = parseOptionalDate(query, "from"); err != nil {
+		return nil, err
+	}
+	if req.EndDate, err = parseOptionalDate(query, "to"); err != nil {
+		return nil, err
+	}
+
+	if plate := strings.TrimSpace(query.Get("licensePlate")); plate != "" {
+		req.LicensePlate = &plate
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if req.Limit, err = strconv.Atoi(limitStr); err != nil {
+			return nil, domain.InvalidField(err, "limit", msgInvalidNumber)
+		}
+	}
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		if req.Offset, err = strconv.Atoi(offsetStr); err != nil {
+			return nil, domain.InvalidField(err, "offset", msgInvalidNumber)
+		}
+	}
+
+	return req, nil
+}
+
+func parseOptionalID(query url.Values, name string) (*int64, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, domain.InvalidField(err, name, msgInvalidID)
+	}
+	return &id, nil
+}
+
+func parseOptionalDate(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(domain.DateFormat, value)
+	if err != nil {
+		return nil, domain.InvalidField(err, name, msgInvalidDate)
+	}
+	return &date, nil
+}
@@ -0,0 +1,18 @@
+package admin_update_booking_status
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+	bookingsModels "github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+type AdminService interface {
+	ForceStatus(ctx context.Context, bookingID int64, req *models.ForceStatusRequest) (*bookingsModels.BookingResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,72 @@
+package admin_update_booking_status
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidBookingID   = "некорректный ID бронирования"
+	msgInvalidRequestBody = "некорректное тело запроса"
+	msgMissingUserID      = "отсутствует ID пользователя"
+)
+
+type Handler struct {
+	service AdminService
+	logger  Logger
+}
+
+func NewHandler(service AdminService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle PATCH /api/v1/admin/bookings/{bookingId}/status
+// Принудительно устанавливает статус без проверки допустимости перехода
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем bookingId из URL
+	vars := mux.Vars(r)
+	bookingIDStr := vars["bookingId"]
+
+	bookingID, err := strconv.ParseInt(bookingIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "PATCH /admin/bookings/{id}/status - Invalid booking ID: %v", err)
+		handlers.RespondFieldError(w, "bookingId", msgInvalidBookingID)
+		return
+	}
+
+	// Получаем ID администратора из контекста (через middleware Auth)
+	adminID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "PATCH /admin/bookings/{id}/status - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	// Декодируем body
+	var req ForceStatusRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "PATCH /admin/bookings/{id}/status - Invalid request body: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	booking, err := h.service.ForceStatus(r.Context(), bookingID, req.ToServiceRequest(adminID))
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("PATCH /admin/bookings/{id}/status - Failed to force status: booking_id=%d", bookingID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "PATCH /admin/bookings/{id}/status - Status forced: booking_id=%d, status=%s, admin_id=%d",
+		bookingID, req.Status, adminID)
+	handlers.RespondJSON(w, http.StatusOK, booking)
+}
@@ -0,0 +1,21 @@
+package admin_update_booking_status
+
+import (
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+)
+
+// ForceStatusRequest HTTP request model
+type ForceStatusRequest struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+// ToServiceRequest конвертирует HTTP request в модель сервиса
+// adminID - ID авторизованного администратора
+func (r *ForceStatusRequest) ToServiceRequest(adminID int64) *models.ForceStatusRequest {
+	return &models.ForceStatusRequest{
+		AdminID: adminID,
+		Status:  r.Status,
+		Reason:  r.Reason,
+	}
+}
@@ -0,0 +1,13 @@
+package admin_update_log_level
+
+import "context"
+
+type LevelController interface {
+	Level() string
+	SetLevel(level string) error
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+}
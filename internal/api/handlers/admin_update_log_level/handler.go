@@ -0,0 +1,48 @@
+package admin_update_log_level
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidRequestBody = "некорректное тело запроса"
+	msgInvalidLevel       = "допустимые значения: debug, info, warn, error"
+)
+
+type Handler struct {
+	levels LevelController
+	logger Logger
+}
+
+func NewHandler(levels LevelController, logger Logger) *Handler {
+	return &Handler{
+		levels: levels,
+		logger: logger,
+	}
+}
+
+// Handle PUT /api/v1/admin/log-level
+// Меняет уровень логирования без перезапуска (до следующего перезапуска сервиса)
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req UpdateLogLevelRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "PUT /admin/log-level - Invalid request body: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	previous := h.levels.Level()
+	if err := h.levels.SetLevel(req.Level); err != nil {
+		h.logger.WarnContext(r.Context(), "PUT /admin/log-level - Invalid level: %v", err)
+		handlers.RespondFieldError(w, "level", msgInvalidLevel)
+		return
+	}
+
+	adminID, _ := middleware.GetUserID(r.Context())
+	h.logger.InfoContext(r.Context(), "PUT /admin/log-level - Log level changed: %s -> %s, admin_id=%d",
+		previous, h.levels.Level(), adminID)
+	handlers.RespondJSON(w, http.StatusOK, &LogLevelResponse{Level: h.levels.Level()})
+}
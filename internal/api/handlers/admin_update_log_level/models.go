@@ -0,0 +1,11 @@
+package admin_update_log_level
+
+// UpdateLogLevelRequest HTTP request model
+type UpdateLogLevelRequest struct {
+	Level string `json:"level"`
+}
+
+// LogLevelResponse HTTP response model
+type LogLevelResponse struct {
+	Level string `json:"level"`
+}
@@ -1,22 +1,18 @@
 package cancel_booking
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
-	"github.com/m04kA/SMC-BookingService/internal/service/bookings"
 )
 
 const (
 	msgInvalidBookingID   = "некорректный ID бронирования"
 	msgInvalidRequestBody = "некорректное тело запроса"
-	msgNotFound           = "бронирование не найдено"
-	msgForbidden          = "доступ запрещен"
-	msgCannotCancel       = "бронирование не может быть отменено"
 )
 
 type Handler struct {
@@ -39,48 +35,39 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	bookingID, err := strconv.ParseInt(bookingIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("PATCH /bookings/{id}/cancel - Invalid booking ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidBookingID)
+		h.logger.WarnContext(r.Context(), "PATCH /bookings/{id}/cancel - Invalid booking ID: %v", err)
+		handlers.RespondFieldError(w, "bookingId", msgInvalidBookingID)
 		return
 	}
 
 	// Декодируем body
 	var req CancelBookingRequest
 	if err := handlers.DecodeJSON(r, &req); err != nil {
-		h.logger.Warn("PATCH /bookings/{id}/cancel - Invalid request body: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		h.logger.WarnContext(r.Context(), "PATCH /bookings/{id}/cancel - Invalid request body: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	// Определяем пользователя по авторизации (userId в теле должен совпадать с ней)
+	userID, err := handlers.ResolveUserID(r.Context(), req.UserID)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "PATCH /bookings/{id}/cancel - Invalid user identity: booking_id=%d, error=%v", bookingID, err)
+		handlers.RespondProblem(w, handlers.MapError(err))
 		return
 	}
 
 	// Конвертируем в модель сервиса
-	serviceReq := req.ToServiceRequest()
+	serviceReq := req.ToServiceRequest(userID)
 
 	// Отменяем бронирование
 	err = h.service.Cancel(r.Context(), bookingID, serviceReq)
 	if err != nil {
-		switch {
-		case errors.Is(err, bookings.ErrBookingNotFound):
-			h.logger.Warn("PATCH /bookings/{id}/cancel - Booking not found: booking_id=%d", bookingID)
-			handlers.RespondNotFound(w, msgNotFound)
-
-		case errors.Is(err, bookings.ErrAccessDenied):
-			h.logger.Warn("PATCH /bookings/{id}/cancel - Access denied: booking_id=%d, user_id=%d",
-				bookingID, req.UserID)
-			handlers.RespondForbidden(w, msgForbidden)
-
-		case errors.Is(err, bookings.ErrCannotCancel):
-			h.logger.Warn("PATCH /bookings/{id}/cancel - Cannot cancel: booking_id=%d", bookingID)
-			handlers.RespondBadRequest(w, msgCannotCancel)
-
-		default:
-			h.logger.Error("PATCH /bookings/{id}/cancel - Failed to cancel booking: booking_id=%d, error=%v",
-				bookingID, err)
-			handlers.RespondInternalError(w)
-		}
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("PATCH /bookings/{id}/cancel - Failed to cancel booking: booking_id=%d", bookingID), err)
 		return
 	}
 
-	h.logger.Info("PATCH /bookings/{id}/cancel - Booking cancelled successfully: booking_id=%d, user_id=%d",
-		bookingID, req.UserID)
+	h.logger.InfoContext(r.Context(), "PATCH /bookings/{id}/cancel - Booking cancelled successfully: booking_id=%d, user_id=%d",
+		bookingID, userID)
 	handlers.RespondJSON(w, http.StatusOK, nil)
 }
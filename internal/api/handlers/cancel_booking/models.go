@@ -5,20 +5,22 @@ import (
 )
 
 // CancelBookingRequest HTTP request model
+// UserID опционален: пользователь определяется по авторизации, при указании должен совпадать с ней
 type CancelBookingRequest struct {
-	UserID             int64   `json:"userId"`
+	UserID             int64   `json:"userId,omitempty"`
 	CancellationReason *string `json:"cancellationReason,omitempty"`
 }
 
 // ToServiceRequest конвертирует HTTP request в модель сервиса
-func (r *CancelBookingRequest) ToServiceRequest() *models.CancelBookingRequest {
+// userID - ID авторизованного пользователя
+func (r *CancelBookingRequest) ToServiceRequest(userID int64) *models.CancelBookingRequest {
 	reason := ""
 	if r.CancellationReason != nil {
 		reason = *r.CancellationReason
 	}
 
 	return &models.CancelBookingRequest{
-		UserID:             r.UserID,
+		UserID:             userID,
 		CancellationReason: reason,
 	}
 }
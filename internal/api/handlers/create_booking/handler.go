@@ -1,28 +1,17 @@
 package create_booking
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
-	createBooking "github.com/m04kA/SMC-BookingService/internal/usecase/create_booking"
 )
 
 const (
-	msgInvalidRequestBody  = "некорректное тело запроса"
-	msgInvalidDate         = "некорректный формат даты бронирования, ожидается YYYY-MM-DD"
-	msgInvalidTime         = "некорректный формат времени начала, ожидается HH:MM"
-	msgSlotNotAvailable    = "выбранный временной слот недоступен"
-	msgCompanyNotFound     = "компания не найдена"
-	msgServiceNotFound     = "услуга не найдена"
-	msgAddressNotFound     = "адрес не найден"
-	msgCarNotFound         = "автомобиль не найден"
-	msgCompanyClosed       = "компания закрыта в выбранную дату"
-	msgInvalidBookingDate  = "некорректная дата бронирования"
-	msgDateTooFar          = "дата бронирования слишком далеко в будущем"
-	msgInvalidTimeSlot     = "некорректный временной слот"
-	msgTooLateToBook       = "слишком поздно для бронирования этого слота"
-	msgServiceNotAvailable = "услуга недоступна на выбранном адресе"
+	msgInvalidRequestBody = "некорректное тело запроса"
+	msgInvalidParams      = "некорректные параметры запроса"
+	msgInvalidDate        = "некорректный формат даты бронирования, ожидается YYYY-MM-DD"
+	msgInvalidTime        = "некорректный формат времени начала, ожидается HH:MM"
 )
 
 type Handler struct {
@@ -41,21 +30,24 @@ func NewHandler(useCase CreateBookingUseCase, logger Logger) *Handler {
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	var req CreateBookingRequest
 	if err := handlers.DecodeJSON(r, &req); err != nil {
-		h.logger.Warn("POST /bookings - Invalid request body: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		h.logger.WarnContext(r.Context(), "POST /bookings - Invalid request body: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	// Определяем пользователя по авторизации (userId в теле должен совпадать с ней)
+	userID, err := handlers.ResolveUserID(r.Context(), req.UserID)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /bookings - Invalid user identity: %v", err)
+		handlers.RespondProblem(w, handlers.MapError(err))
 		return
 	}
 
 	// Конвертируем HTTP запрос в модель use case (с парсингом даты и времени)
-	useCaseReq, err := req.ToUseCaseRequest()
+	useCaseReq, err := req.ToUseCaseRequest(userID)
 	if err != nil {
-		h.logger.Warn("POST /bookings - Failed to parse request: %v", err)
-		// Определяем тип ошибки парсинга
-		if err.Error() == "parsing time" || err.Error() == "invalid time string format" {
-			handlers.RespondBadRequest(w, msgInvalidTime)
-		} else {
-			handlers.RespondBadRequest(w, msgInvalidDate)
-		}
+		h.logger.WarnContext(r.Context(), "POST /bookings - Failed to parse request: %v", err)
+		handlers.RespondInvalidParams(w, msgInvalidParams, err)
 		return
 	}
 
@@ -63,63 +55,16 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	result, err := h.useCase.Execute(r.Context(), useCaseReq)
 	if err != nil {
 		// Обработка ошибок use case
-		switch {
-		case errors.Is(err, createBooking.ErrSlotNotAvailable):
-			h.logger.Warn("POST /bookings - Slot not available: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondError(w, http.StatusConflict, msgSlotNotAvailable)
-
-		case errors.Is(err, createBooking.ErrCompanyNotFound):
-			h.logger.Warn("POST /bookings - Company not found: company_id=%d", req.CompanyID)
-			handlers.RespondNotFound(w, msgCompanyNotFound)
-
-		case errors.Is(err, createBooking.ErrServiceNotFound):
-			h.logger.Warn("POST /bookings - Service not found: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondNotFound(w, msgServiceNotFound)
-
-		case errors.Is(err, createBooking.ErrAddressNotFound):
-			h.logger.Warn("POST /bookings - Address not found: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondNotFound(w, msgAddressNotFound)
-
-		case errors.Is(err, createBooking.ErrCarNotFound):
-			h.logger.Warn("POST /bookings - Car not found: user_id=%d", req.UserID)
-			handlers.RespondNotFound(w, msgCarNotFound)
-
-		case errors.Is(err, createBooking.ErrCompanyClosed):
-			h.logger.Warn("POST /bookings - Company closed: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondBadRequest(w, msgCompanyClosed)
-
-		case errors.Is(err, createBooking.ErrInvalidDate):
-			h.logger.Warn("POST /bookings - Invalid booking date: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondBadRequest(w, msgInvalidBookingDate)
-
-		case errors.Is(err, createBooking.ErrDateTooFarInFuture):
-			h.logger.Warn("POST /bookings - Date too far in future: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondBadRequest(w, msgDateTooFar)
-
-		case errors.Is(err, createBooking.ErrInvalidTimeSlot):
-			h.logger.Warn("POST /bookings - Invalid time slot: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondBadRequest(w, msgInvalidTimeSlot)
-
-		case errors.Is(err, createBooking.ErrTooLateToBook):
-			h.logger.Warn("POST /bookings - Too late to book: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondBadRequest(w, msgTooLateToBook)
-
-		case errors.Is(err, createBooking.ErrServiceNotAvailableAtAddress):
-			h.logger.Warn("POST /bookings - Service not available at address: user_id=%d, company_id=%d", req.UserID, req.CompanyID)
-			handlers.RespondBadRequest(w, msgServiceNotAvailable)
-
-		default:
-			h.logger.Error("POST /bookings - Failed to create booking: user_id=%d, company_id=%d, error=%v",
-				req.UserID, req.CompanyID, err)
-			handlers.RespondInternalError(w)
-		}
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("POST /bookings - Failed to create booking: user_id=%d, company_id=%d",
+				userID, req.CompanyID), err)
 		return
 	}
 
 	// Формируем HTTP ответ
 	response := FromUseCaseResponse(result)
 
-	h.logger.Info("POST /bookings - Booking created successfully: booking_id=%d, user_id=%d, company_id=%d",
-		result.ID, req.UserID, req.CompanyID)
+	h.logger.InfoContext(r.Context(), "POST /bookings - Booking created successfully: booking_id=%d, user_id=%d, company_id=%d",
+		result.ID, userID, req.CompanyID)
 	handlers.RespondJSON(w, http.StatusCreated, response)
 }
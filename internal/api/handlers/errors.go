@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/service/admin"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings"
+	"github.com/m04kA/SMC-BookingService/internal/service/calendar"
+	"github.com/m04kA/SMC-BookingService/internal/service/config"
+	createBooking "github.com/m04kA/SMC-BookingService/internal/usecase/create_booking"
+	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+	"github.com/m04kA/SMC-BookingService/pkg/txretry"
+)
+
+// Сообщения клиенту для ошибок каталога
+const (
+	msgMissingUserID       = "отсутствует ID пользователя"
+	msgUserIDMismatch      = "ID пользователя не совпадает с авторизованным пользователем"
+	msgForbidden           = "доступ запрещен"
+	msgInvalidParams       = "некорректные параметры запроса"
+	msgInvalidConfig       = "некорректные данные конфигурации"
+	msgBookingNotFound     = "бронирование не найдено"
+	msgCompanyNotFound     = "компания не найдена"
+	msgAddressNotFound     = "адрес не найден"
+	msgServiceNotFound     = "услуга не найдена"
+	msgCarNotFound         = "автомобиль не найден"
+	msgConfigNotFound      = "конфигурация не найдена"
+	msgFeedNotFound        = "ссылка на календарь не найдена или отозвана"
+	msgSlotNotAvailable    = "выбранный временной слот недоступен"
+	msgCompanyClosed       = "компания закрыта в выбранную дату"
+	msgDateInPast          = "дата бронирования в прошлом"
+	msgDateTooFar          = "дата бронирования слишком далеко в будущем"
+	msgTooEarly            = "слишком поздно для бронирования этого слота"
+	msgInvalidTimeSlot     = "некорректный временной слот"
+	msgServiceNotAvailable = "услуга недоступна на выбранном адресе"
+	msgInvalidStatus       = "некорректный статус бронирования"
+	msgCannotCancel        = "бронирование не может быть отменено"
+	msgNotCancelled        = "бронирование не отменено"
+	msgConfigConflicts     = "изменение конфигурации конфликтует с существующими бронированиями, используйте dryRun=true для просмотра или force=true для применения"
+	msgConfigAlreadyExists = "конфигурация уже существует"
+	msgFeedConflict        = "ссылка на календарь уже перевыпускается, повторите запрос"
+	msgInvalidType         = "некорректный тип значения"
+	msgConcurrentUpdate    = "бронирования одновременно изменяются другим запросом, повторите запрос"
+	msgTryAgainLater       = "сервис временно недоступен, повторите позже"
+)
+
+// Logger логгер ошибок обработчиков (подмножество контрактов Logger пакетов обработчиков)
+type Logger interface {
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
+
+// errorMapping соответствие ошибки сервиса или use case ошибке API
+type errorMapping struct {
+	err     error
+	status  int
+	code    apierror.Code
+	message string
+}
+
+// errorCatalog единая таблица ошибок API (HTTP и gRPC): ошибка сервиса -> HTTP статус, код и сообщение
+// Проверяется первое совпадение, поэтому уточняющие ошибки идут раньше общих (ErrInvalidInput)
+var errorCatalog = []errorMapping{
+	// Пользователь
+	{ErrMissingUserID, http.StatusUnauthorized, apierror.CodeUnauthenticated, msgMissingUserID},
+	{ErrUserIDMismatch, http.StatusForbidden, apierror.CodeUserIDMismatch, msgUserIDMismatch},
+
+	// Доступ
+	{bookings.ErrAccessDenied, http.StatusForbidden, apierror.CodeAccessDenied, msgForbidden},
+	{config.ErrAccessDenied, http.StatusForbidden, apierror.CodeAccessDenied, msgForbidden},
+	{admin.ErrAccessDenied, http.StatusForbidden, apierror.CodeAccessDenied, msgForbidden},
+	{calendar.ErrAccessDenied, http.StatusForbidden, apierror.CodeAccessDenied, msgForbidden},
+
+	// Ресурсы не найдены
+	{bookings.ErrBookingNotFound, http.StatusNotFound, apierror.CodeBookingNotFound, msgBookingNotFound},
+	{admin.ErrBookingNotFound, http.StatusNotFound, apierror.CodeBookingNotFound, msgBookingNotFound},
+	{bookings.ErrCompanyNotFound, http.StatusNotFound, apierror.CodeCompanyNotFound, msgCompanyNotFound},
+	{config.ErrCompanyNotFound, http.StatusNotFound, apierror.CodeCompanyNotFound, msgCompanyNotFound},
+	{calendar.ErrCompanyNotFound, http.StatusNotFound, apierror.CodeCompanyNotFound, msgCompanyNotFound},
+	{createBooking.ErrCompanyNotFound, http.StatusNotFound, apierror.CodeCompanyNotFound, msgCompanyNotFound},
+	{getAvailableSlots.ErrCompanyNotFound, http.StatusNotFound, apierror.CodeCompanyNotFound, msgCompanyNotFound},
+	{config.ErrAddressNotFound, http.StatusNotFound, apierror.CodeAddressNotFound, msgAddressNotFound},
+	{calendar.ErrAddressNotFound, http.StatusNotFound, apierror.CodeAddressNotFound, msgAddressNotFound},
+	{createBooking.ErrAddressNotFound, http.StatusNotFound, apierror.CodeAddressNotFound, msgAddressNotFound},
+	{getAvailableSlots.ErrAddressNotFound, http.StatusNotFound, apierror.CodeAddressNotFound, msgAddressNotFound},
+	{bookings.ErrServiceNotFound, http.StatusNotFound, apierror.CodeServiceNotFound, msgServiceNotFound},
+	{config.ErrServiceNotFound, http.StatusNotFound, apierror.CodeServiceNotFound, msgServiceNotFound},
+	{createBooking.ErrServiceNotFound, http.StatusNotFound, apierror.CodeServiceNotFound, msgServiceNotFound},
+	{getAvailableSlots.ErrServiceNotFound, http.StatusNotFound, apierror.CodeServiceNotFound, msgServiceNotFound},
+	{bookings.ErrCarNotFound, http.StatusNotFound, apierror.CodeCarNotFound, msgCarNotFound},
+	{createBooking.ErrCarNotFound, http.StatusNotFound, apierror.CodeCarNotFound, msgCarNotFound},
+	{config.ErrConfigNotFound, http.StatusNotFound, apierror.CodeConfigNotFound, msgConfigNotFound},
+	{calendar.ErrFeedNotFound, http.StatusNotFound, apierror.CodeCalendarFeedNotFound, msgFeedNotFound},
+
+	// Правила бронирования
+	{createBooking.ErrSlotNotAvailable, http.StatusConflict, apierror.CodeSlotNotAvailable, msgSlotNotAvailable},
+	{admin.ErrSlotNotAvailable, http.StatusConflict, apierror.CodeSlotNotAvailable, msgSlotNotAvailable},
+	{createBooking.ErrCompanyClosed, http.StatusBadRequest, apierror.CodeCompanyClosed, msgCompanyClosed},
+	{getAvailableSlots.ErrCompanyClosed, http.StatusBadRequest, apierror.CodeCompanyClosed, msgCompanyClosed},
+	{createBooking.ErrInvalidDate, http.StatusBadRequest, apierror.CodeBookingDateInPast, msgDateInPast},
+	{getAvailableSlots.ErrInvalidDate, http.StatusBadRequest, apierror.CodeBookingDateInPast, msgDateInPast},
+	{createBooking.ErrDateTooFarInFuture, http.StatusBadRequest, apierror.CodeBookingTooFarAhead, msgDateTooFar},
+	{getAvailableSlots.ErrDateTooFarInFuture, http.StatusBadRequest, apierror.CodeBookingTooFarAhead, msgDateTooFar},
+	{createBooking.ErrTooLateToBook, http.StatusBadRequest, apierror.CodeBookingTooEarly, msgTooEarly},
+	{createBooking.ErrInvalidTimeSlot, http.StatusBadRequest, apierror.CodeInvalidTimeSlot, msgInvalidTimeSlot},
+	{createBooking.ErrServiceNotAvailableAtAddress, http.StatusBadRequest, apierror.CodeServiceNotAvailableAtAddress, msgServiceNotAvailable},
+	{getAvailableSlots.ErrServiceNotAvailableAtAddress, http.StatusBadRequest, apierror.CodeServiceNotAvailableAtAddress, msgServiceNotAvailable},
+	{bookings.ErrInvalidStatus, http.StatusBadRequest, apierror.CodeInvalidBookingStatus, msgInvalidStatus},
+	{bookings.ErrCannotCancel, http.StatusBadRequest, apierror.CodeBookingCannotBeCancelled, msgCannotCancel},
+	{admin.ErrNotCancelled, http.StatusConflict, apierror.CodeBookingNotCancelled, msgNotCancelled},
+
+	// Конфигурация и календари
+	{config.ErrConfigConflicts, http.StatusConflict, apierror.CodeConfigConflicts, msgConfigConflicts},
+	{config.ErrConfigAlreadyExists, http.StatusConflict, apierror.CodeConfigAlreadyExists, msgConfigAlreadyExists},
+	{calendar.ErrConflict, http.StatusConflict, apierror.CodeCalendarFeedConflict, msgFeedConflict},
+
+	// Транзакции, прерванные PostgreSQL после всех повторов
+	{txretry.ErrSerializationFailure, http.StatusConflict, apierror.CodeConflict, msgConcurrentUpdate},
+	{txretry.ErrDeadlock, http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, msgTryAgainLater},
+
+	// Некорректные входные данные (подробности - в ошибках полей)
+	{bookings.ErrInvalidInput, http.StatusBadRequest, apierror.CodeValidationFailed, msgInvalidParams},
+	{admin.ErrInvalidInput, http.StatusBadRequest, apierror.CodeValidationFailed, msgInvalidParams},
+	{config.ErrInvalidInput, http.StatusBadRequest, apierror.CodeValidationFailed, msgInvalidConfig},
+	{createBooking.ErrInvalidInput, http.StatusBadRequest, apierror.CodeValidationFailed, msgInvalidParams},
+	{getAvailableSlots.ErrInvalidInput, http.StatusBadRequest, apierror.CodeValidationFailed, msgInvalidParams},
+}
+
+// MapError возвращает ошибку API для ошибки сервиса, use case или разбора запроса
+// Ошибки полей (domain.FieldError) передаются в деталях ответа 400, неизвестные ошибки - 500 без подробностей
+func MapError(err error) *apierror.Error {
+	var apiErr *apierror.Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	for _, m := range errorCatalog {
+		if errors.Is(err, m.err) {
+			result := apierror.New(m.status, m.code, m.message)
+			if m.status == http.StatusBadRequest {
+				result.Fields = fieldErrors(err)
+			}
+			return result
+		}
+	}
+
+	if fields := fieldErrors(err); len(fields) > 0 {
+		return apierror.Validation(fields...)
+	}
+
+	return apierror.Internal()
+}
+
+// ServiceError логирует ошибку сервиса и возвращает ошибку API по каталогу
+// Ошибки клиента логируются как предупреждение, внутренние - как ошибка с исходной причиной.
+// op - операция и контекст для лога: "PATCH /bookings/{id}/cancel - Failed to cancel booking: booking_id=1"
+func ServiceError(ctx context.Context, logger Logger, op string, err error) *apierror.Error {
+	apiErr := MapError(err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		logger.ErrorContext(ctx, "%s, error=%v", op, err)
+	} else {
+		logger.WarnContext(ctx, "%s - %s: %v", op, apiErr.Code, err)
+	}
+	return apiErr
+}
+
+// RespondServiceError логирует ошибку сервиса и отвечает ошибкой по каталогу
+func RespondServiceError(ctx context.Context, w http.ResponseWriter, logger Logger, op string, err error) {
+	RespondProblem(w, ServiceError(ctx, logger, op, err))
+}
+
+// RespondFieldError отправляет ошибку 400 VALIDATION_FAILED для одного поля запроса
+func RespondFieldError(w http.ResponseWriter, field, message string) {
+	RespondProblem(w, apierror.Validation(apierror.Field(field, message)))
+}
+
+// InvalidParams возвращает ошибку 400 разбора параметров запроса
+// Ошибки полей (domain.FieldError) передаются клиенту, иначе используется общее сообщение message
+func InvalidParams(message string, err error) *apierror.Error {
+	apiErr := apierror.Validation(fieldErrors(err)...)
+	if len(apiErr.Fields) == 0 {
+		apiErr.Message = message
+	}
+	return apiErr
+}
+
+// RespondInvalidParams отправляет ошибку 400 разбора параметров запроса
+func RespondInvalidParams(w http.ResponseWriter, message string, err error) {
+	RespondProblem(w, InvalidParams(message, err))
+}
+
+// RespondInvalidBody отправляет ошибку 400 на тело запроса, которое не удалось разобрать
+// Для значения неверного типа указывается поле
+func RespondInvalidBody(w http.ResponseWriter, message string, err error) {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		RespondFieldError(w, typeErr.Field, msgInvalidType)
+		return
+	}
+	RespondProblem(w, apierror.New(http.StatusBadRequest, apierror.CodeBadRequest, message))
+}
+
+// fieldErrors собирает ошибки полей из цепочки ошибок (в том числе из нескольких %w)
+func fieldErrors(err error) []apierror.FieldError {
+	var result []apierror.FieldError
+
+	var walk func(err error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+		case *domain.FieldError:
+			result = append(result, apierror.Field(e.Field, e.Message))
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				walk(inner)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+
+	return result
+}
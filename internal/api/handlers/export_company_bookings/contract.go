@@ -0,0 +1,18 @@
+package export_company_bookings
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+type BookingService interface {
+	ExportCompanyBookings(ctx context.Context, req *models.GetCompanyBookingsRequest, exporter bookings.BookingExporter) error
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,93 @@
+package export_company_bookings
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers/get_company_bookings"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidCompanyID = "некорректный ID компании"
+	msgMissingUserID    = "отсутствует ID пользователя"
+	msgInvalidFormat    = "некорректный формат экспорта, допустимые значения: csv, xlsx"
+	msgInvalidParams    = "некорректные параметры запроса"
+)
+
+type Handler struct {
+	service BookingService
+	logger  Logger
+}
+
+func NewHandler(service BookingService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{companyId}/bookings/export
+// Query params: format - csv (по умолчанию) или xlsx; фильтры как у GET /companies/{companyId}/bookings
+// (limit и cursor не учитываются - выгружаются все подходящие бронирования)
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем companyId из URL
+	vars := mux.Vars(r)
+	companyIDStr := vars["companyId"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/bookings/export - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Получаем userID из контекста (через middleware Auth)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/bookings/export - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	format, err := ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/bookings/export - Invalid format: %v", err)
+		handlers.RespondFieldError(w, "format", msgInvalidFormat)
+		return
+	}
+
+	// Фильтры те же, что у списка бронирований компании
+	serviceReq, err := get_company_bookings.ToServiceRequest(companyID, userID, r.URL.Query())
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/bookings/export - Invalid parameters: %v", err)
+		handlers.RespondInvalidParams(w, msgInvalidParams, err)
+		return
+	}
+
+	exporter := NewExporter(w, format, companyID, LabelsFor(i18n.FromContext(r.Context())))
+
+	// Выгружаем бронирования (сервис сам проверит права доступа до начала записи)
+	err = h.service.ExportCompanyBookings(r.Context(), serviceReq, exporter)
+	if err != nil {
+		// Ответ уже начат - статус изменить нельзя, файл будет неполным
+		if exporter.Started() {
+			h.logger.ErrorContext(r.Context(), "GET /companies/{id}/bookings/export - Export interrupted: company_id=%d, format=%s, error=%v",
+				companyID, format, err)
+			return
+		}
+
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /companies/{id}/bookings/export - Failed to export bookings: company_id=%d",
+				companyID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "GET /companies/{id}/bookings/export - Bookings exported: company_id=%d, format=%s, count=%d",
+		companyID, format, exporter.Count())
+}
@@ -0,0 +1,339 @@
+package export_company_bookings
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	"github.com/m04kA/SMC-BookingService/pkg/xlsx"
+)
+
+// Поддерживаемые форматы экспорта
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// utf8BOM метка порядка байт: без нее Excel открывает CSV с кириллицей в неверной кодировке
+const utf8BOM = "\uFEFF"
+
+// Выгрузка длится дольше общего WriteTimeout сервера, поэтому дедлайн записи продлевается
+// перед началом ответа и после каждой пачки строк
+const (
+	writeTimeout  = 30 * time.Second // Таймаут записи одной пачки строк
+	deadlineBatch = 500              // Количество строк, после которого дедлайн продлевается
+)
+
+// ParseFormat проверяет формат экспорта (по умолчанию csv)
+func ParseFormat(value string) (string, error) {
+	switch value {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s", value)
+	}
+}
+
+// Labels локализованные подписи выгрузки
+type Labels struct {
+	Locale       i18n.Locale // Язык названий статусов и формата дат
+	Sheet        string
+	Columns      []string
+	TotalsTitle  string
+	TotalsStatus string
+	TotalsCount  string
+	TotalsAmount string
+	TotalsAll    string
+}
+
+// labels подписи выгрузки по языкам
+var labels = map[i18n.Locale]Labels{
+	i18n.RU: {
+		Sheet: "Бронирования",
+		Columns: []string{
+			"ID", "Дата", "Время", "Длительность, мин", "ID адреса", "ID услуги", "Услуга", "Стоимость",
+			"Статус", "ID клиента", "Марка", "Модель", "Госномер", "Причина отмены", "Создано",
+		},
+		TotalsTitle:  "Итоги по статусам",
+		TotalsStatus: "Статус",
+		TotalsCount:  "Количество",
+		TotalsAmount: "Сумма",
+		TotalsAll:    "Всего",
+	},
+	i18n.EN: {
+		Sheet: "Bookings",
+		Columns: []string{
+			"ID", "Date", "Time", "Duration, min", "Address ID", "Service ID", "Service", "Price",
+			"Status", "Customer ID", "Car brand", "Car model", "License plate", "Cancellation reason", "Created at",
+		},
+		TotalsTitle:  "Totals by status",
+		TotalsStatus: "Status",
+		TotalsCount:  "Count",
+		TotalsAmount: "Amount",
+		TotalsAll:    "Total",
+	},
+	i18n.KK: {
+		Sheet: "Брондаулар",
+		Columns: []string{
+			"ID", "Күні", "Уақыты", "Ұзақтығы, мин", "Мекенжай ID", "Қызмет ID", "Қызмет", "Құны",
+			"Мәртебе", "Клиент ID", "Маркасы", "Моделі", "Мемлекеттік нөмір", "Бас тарту себебі", "Құрылған уақыты",
+		},
+		TotalsTitle:  "Мәртебелер бойынша қорытынды",
+		TotalsStatus: "Мәртебе",
+		TotalsCount:  "Саны",
+		TotalsAmount: "Сомасы",
+		TotalsAll:    "Барлығы",
+	},
+}
+
+// LabelsFor возвращает подписи выгрузки на языке ответа (middleware.Locale)
+func LabelsFor(l i18n.Locale) Labels {
+	result, ok := labels[l]
+	if !ok {
+		l, result = i18n.Source, labels[i18n.Source]
+	}
+	result.Locale = l
+	return result
+}
+
+// status возвращает локализованное название статуса
+func (l Labels) status(status string) string {
+	return i18n.StatusName(l.Locale, status)
+}
+
+// date форматирует дату бронирования (YYYY-MM-DD) в формате языка
+func (l Labels) date(value string) string {
+	date, err := time.Parse(domain.DateFormat, value)
+	if err != nil {
+		return value
+	}
+	return i18n.FormatDate(l.Locale, date)
+}
+
+// Exporter записывает выгрузку в HTTP ответ
+// Заголовки ответа отправляются в Begin, после проверки прав доступа сервисом
+type Exporter struct {
+	w         http.ResponseWriter
+	rc        *http.ResponseController
+	format    string
+	companyID int64
+	labels    Labels
+
+	csv     *csv.Writer
+	xlsx    *xlsx.Writer
+	started bool
+	count   int
+}
+
+// NewExporter создает получатель выгрузки в формате format
+func NewExporter(w http.ResponseWriter, format string, companyID int64, labels Labels) *Exporter {
+	return &Exporter{
+		w:         w,
+		rc:        http.NewResponseController(w),
+		format:    format,
+		companyID: companyID,
+		labels:    labels,
+	}
+}
+
+// Started возвращает true, если ответ уже начат
+func (e *Exporter) Started() bool {
+	return e.started
+}
+
+// Count возвращает количество выгруженных бронирований
+func (e *Exporter) Count() int {
+	return e.count
+}
+
+// Begin отправляет заголовки ответа и строку заголовков таблицы
+func (e *Exporter) Begin() error {
+	e.started = true
+
+	if err := e.extendDeadline(); err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("company-%d-bookings.%s", e.companyID, e.format)
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+
+	if e.format == FormatXLSX {
+		e.w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		e.w.WriteHeader(http.StatusOK)
+
+		writer, err := xlsx.NewWriter(e.w, e.labels.Sheet)
+		if err != nil {
+			return err
+		}
+		e.xlsx = writer
+		return e.xlsx.WriteRow(boldCells(e.labels.Columns...)...)
+	}
+
+	e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	e.w.WriteHeader(http.StatusOK)
+
+	if _, err := e.w.Write([]byte(utf8BOM)); err != nil {
+		return err
+	}
+	e.csv = csv.NewWriter(e.w)
+	return e.csv.Write(e.labels.Columns)
+}
+
+// Row записывает строку бронирования
+func (e *Exporter) Row(b *models.BookingResponse) error {
+	e.count++
+	if e.count%deadlineBatch == 0 {
+		if err := e.extendDeadline(); err != nil {
+			return err
+		}
+	}
+
+	status := e.labels.status(b.Status)
+	bookingDate := e.labels.date(b.BookingDate)
+	createdAt := i18n.FormatDateTime(e.labels.Locale, b.CreatedAt)
+
+	if e.xlsx != nil {
+		return e.xlsx.WriteRow(
+			xlsx.Int(b.ID),
+			xlsx.Text(bookingDate),
+			xlsx.Text(b.StartTime),
+			xlsx.Int(int64(b.DurationMinutes)),
+			xlsx.Int(b.AddressID),
+			xlsx.Int(b.ServiceID),
+			xlsx.Text(b.ServiceName),
+			xlsx.Number(b.ServicePrice),
+			xlsx.Text(status),
+			xlsx.Int(b.UserID),
+			xlsx.Text(optional(b.CarBrand)),
+			xlsx.Text(optional(b.CarModel)),
+			xlsx.Text(optional(b.CarLicensePlate)),
+			xlsx.Text(optional(b.CancellationReason)),
+			xlsx.Text(createdAt),
+		)
+	}
+
+	return e.csv.Write([]string{
+		strconv.FormatInt(b.ID, 10),
+		bookingDate,
+		b.StartTime,
+		strconv.Itoa(b.DurationMinutes),
+		strconv.FormatInt(b.AddressID, 10),
+		strconv.FormatInt(b.ServiceID, 10),
+		csvText(b.ServiceName),
+		formatAmount(b.ServicePrice),
+		status,
+		strconv.FormatInt(b.UserID, 10),
+		csvText(optional(b.CarBrand)),
+		csvText(optional(b.CarModel)),
+		csvText(optional(b.CarLicensePlate)),
+		csvText(optional(b.CancellationReason)),
+		createdAt,
+	})
+}
+
+// End записывает итоги по статусам после пустой строки и завершает файл
+func (e *Exporter) End(totals []models.StatusTotal) error {
+	if err := e.extendDeadline(); err != nil {
+		return err
+	}
+
+	var allCount int
+	var allAmount float64
+	for _, t := range totals {
+		allCount += t.Count
+		allAmount += t.Amount
+	}
+
+	if e.xlsx != nil {
+		rows := [][]xlsx.Cell{
+			{},
+			{xlsx.Text(e.labels.TotalsTitle).Bold()},
+			boldCells(e.labels.TotalsStatus, e.labels.TotalsCount, e.labels.TotalsAmount),
+		}
+		for _, t := range totals {
+			rows = append(rows, []xlsx.Cell{
+				xlsx.Text(e.labels.status(t.Status)), xlsx.Int(int64(t.Count)), xlsx.Number(roundAmount(t.Amount)),
+			})
+		}
+		rows = append(rows, []xlsx.Cell{
+			xlsx.Text(e.labels.TotalsAll).Bold(), xlsx.Int(int64(allCount)).Bold(), xlsx.Number(roundAmount(allAmount)).Bold(),
+		})
+
+		for _, row := range rows {
+			if err := e.xlsx.WriteRow(row...); err != nil {
+				return err
+			}
+		}
+		return e.xlsx.Close()
+	}
+
+	records := [][]string{
+		{},
+		{e.labels.TotalsTitle},
+		{e.labels.TotalsStatus, e.labels.TotalsCount, e.labels.TotalsAmount},
+	}
+	for _, t := range totals {
+		records = append(records, []string{e.labels.status(t.Status), strconv.Itoa(t.Count), formatAmount(t.Amount)})
+	}
+	records = append(records, []string{e.labels.TotalsAll, strconv.Itoa(allCount), formatAmount(allAmount)})
+
+	if err := e.csv.WriteAll(records); err != nil {
+		return err
+	}
+	return e.csv.Error()
+}
+
+// extendDeadline продлевает дедлайн записи ответа на writeTimeout
+func (e *Exporter) extendDeadline() error {
+	if err := e.rc.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+// boldCells создает строку заголовков с жирным шрифтом
+func boldCells(values ...string) []xlsx.Cell {
+	cells := make([]xlsx.Cell, len(values))
+	for i, v := range values {
+		cells[i] = xlsx.Text(v).Bold()
+	}
+	return cells
+}
+
+// optional форматирует опциональное значение (nil - пустая строка)
+func optional(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// csvText экранирует текст, введенный пользователями, от выполнения как формулы (CSV injection)
+// Значение, начинающееся с =, +, -, @, табуляции или перевода каретки, Excel и Google Sheets
+// воспринимают как формулу, поэтому к нему добавляется апостроф (рекомендация OWASP)
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// formatAmount форматирует денежную сумму с двумя знаками после точки
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// roundAmount округляет сумму до копеек (накопленная погрешность float64)
+func roundAmount(amount float64) float64 {
+	value, _ := strconv.ParseFloat(formatAmount(amount), 64)
+	return value
+}
@@ -0,0 +1,112 @@
+package export_company_bookings
+
+import (
+	"encoding/csv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+)
+
+func TestCSVText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"empty", "", ""},
+		{"plain text", "BMW", "BMW"},
+		{"formula", "=HYPERLINK(\"http://evil\")", "'=HYPERLINK(\"http://evil\")"},
+		{"plus", "+1+2", "'+1+2"},
+		{"minus", "-2+3", "'-2+3"},
+		{"at", "@SUM(A1)", "'@SUM(A1)"},
+		{"tab", "\t=1", "'\t=1"},
+		{"carriage return", "\r=1", "'\r=1"},
+		{"formula char inside", "A=1", "A=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, csvText(tt.value))
+		})
+	}
+}
+
+func TestExporter_CSVEscapesUserText(t *testing.T) {
+	rec := httptest.NewRecorder()
+	exporter := NewExporter(rec, FormatCSV, 1, LabelsFor(i18n.EN))
+
+	require.NoError(t, exporter.Begin())
+	require.NoError(t, exporter.Row(&models.BookingResponse{
+		ID:                 10,
+		UserID:             20,
+		BookingDate:        "2025-10-15",
+		StartTime:          "10:00",
+		DurationMinutes:    60,
+		Status:             "cancelled_by_user",
+		ServiceName:        "=cmd|' /C calc'!A0",
+		ServicePrice:       -100,
+		CarBrand:           ptr.Ptr("+BMW"),
+		CarModel:           ptr.Ptr("X5"),
+		CarLicensePlate:    ptr.Ptr("@A123"),
+		CancellationReason: ptr.Ptr("-1"),
+		CreatedAt:          time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+	}))
+	require.NoError(t, exporter.End(nil))
+
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(rec.Body.String(), utf8BOM)))
+	reader.FieldsPerRecord = -1 // Итоги короче строк бронирований
+	records, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(records), 2)
+
+	row := records[1]
+	assert.Equal(t, "'=cmd|' /C calc'!A0", row[6])
+	assert.Equal(t, "-100.00", row[7], "числовые колонки не экранируются")
+	assert.Equal(t, "'+BMW", row[10])
+	assert.Equal(t, "X5", row[11])
+	assert.Equal(t, "'@A123", row[12])
+	assert.Equal(t, "'-1", row[13])
+}
+
+func TestExporter_OutlivesServerWriteTimeout(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exporter := NewExporter(w, FormatCSV, 1, LabelsFor(i18n.EN))
+		if err := exporter.Begin(); err != nil {
+			return
+		}
+		// Выгрузка длится дольше WriteTimeout сервера
+		time.Sleep(200 * time.Millisecond)
+		for i := range 3 {
+			if err := exporter.Row(&models.BookingResponse{ID: int64(i + 1), Status: "confirmed"}); err != nil {
+				return
+			}
+		}
+		_ = exporter.End(nil)
+	}))
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err, "ответ не должен обрываться по WriteTimeout сервера")
+
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(body), utf8BOM)))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(records), 4, "заголовок и все строки бронирований")
+}
@@ -0,0 +1,17 @@
+package export_company_config
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+)
+
+type ConfigService interface {
+	GetAllByCompany(ctx context.Context, companyID int64, userID int64) (*models.ConfigListResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
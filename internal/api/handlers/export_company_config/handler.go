@@ -0,0 +1,88 @@
+package export_company_config
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidCompanyID = "некорректный ID компании"
+	msgMissingUserID    = "отсутствует ID пользователя"
+	msgInvalidFormat    = "некорректный формат экспорта, допустимые значения: json, csv"
+)
+
+type Handler struct {
+	service ConfigService
+	logger  Logger
+}
+
+func NewHandler(service ConfigService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{companyId}/config/export
+// Query params: format (опционально) - json (по умолчанию) или csv
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем companyId из URL
+	vars := mux.Vars(r)
+	companyIDStr := vars["companyId"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/config/export - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Получаем userID из контекста (через middleware Auth)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/config/export - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	format, err := ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/config/export - Invalid format: %v", err)
+		handlers.RespondFieldError(w, "format", msgInvalidFormat)
+		return
+	}
+
+	// Получаем все конфигурации компании (сервис сам проверит права доступа)
+	result, err := h.service.GetAllByCompany(r.Context(), companyID, userID)
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /companies/{id}/config/export - Failed to export configs: company_id=%d", companyID), err)
+		return
+	}
+
+	if format == FormatJSON {
+		h.logger.InfoContext(r.Context(), "GET /companies/{id}/config/export - Configs exported: company_id=%d, format=json, count=%d",
+			companyID, len(result.Configs))
+		handlers.RespondJSON(w, http.StatusOK, result)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"company-%d-config.csv\"", companyID))
+	w.WriteHeader(http.StatusOK)
+	if err := WriteCSV(w, result.Configs); err != nil {
+		h.logger.ErrorContext(r.Context(), "GET /companies/{id}/config/export - Failed to write CSV: company_id=%d, error=%v",
+			companyID, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "GET /companies/{id}/config/export - Configs exported: company_id=%d, format=csv, count=%d",
+		companyID, len(result.Configs))
+}
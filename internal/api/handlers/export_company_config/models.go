@@ -0,0 +1,73 @@
+package export_company_config
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+)
+
+// Поддерживаемые форматы экспорта
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// CSVHeader заголовок CSV файла, совпадает с форматом импорта
+var CSVHeader = []string{
+	"address_id",
+	"service_id",
+	"slot_duration_minutes",
+	"max_concurrent_bookings",
+	"advance_booking_days",
+	"min_booking_notice_minutes",
+}
+
+// ParseFormat проверяет формат экспорта (по умолчанию json)
+func ParseFormat(value string) (string, error) {
+	switch value {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s", value)
+	}
+}
+
+// WriteCSV записывает конфигурации в CSV: одна строка на уровень (адрес, услуга)
+// Пустые address_id/service_id означают "для всех адресов/услуг"
+func WriteCSV(w io.Writer, configs []models.ConfigResponse) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+
+	for _, c := range configs {
+		record := []string{
+			formatOptionalID(c.AddressID),
+			formatOptionalID(c.ServiceID),
+			strconv.Itoa(c.SlotDurationMinutes),
+			strconv.Itoa(c.MaxConcurrentBookings),
+			strconv.Itoa(c.AdvanceBookingDays),
+			strconv.Itoa(c.MinBookingNoticeMinutes),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatOptionalID форматирует опциональный ID (nil - пустая строка)
+func formatOptionalID(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatInt(*id, 10)
+}
@@ -1,27 +1,22 @@
 package get_available_slots
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
-	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
 )
 
 const (
-	msgInvalidCompanyID    = "некорректный ID компании"
-	msgInvalidAddressID    = "некорректный ID адреса"
-	msgInvalidServiceID    = "некорректный ID услуги"
-	msgMissingServiceID    = "ID услуги обязателен"
-	msgMissingDate         = "дата обязательна"
-	msgInvalidDate         = "некорректный формат даты, ожидается YYYY-MM-DD"
-	msgCompanyNotFound     = "компания не найдена"
-	msgAddressNotFound     = "адрес не найден"
-	msgServiceNotFound     = "услуга не найдена"
-	msgServiceNotAvailable = "услуга недоступна на выбранном адресе"
+	msgInvalidCompanyID = "некорректный ID компании"
+	msgInvalidAddressID = "некорректный ID адреса"
+	msgInvalidServiceID = "некорректный ID услуги"
+	msgMissingServiceID = "ID услуги обязателен"
+	msgMissingDate      = "дата обязательна"
+	msgInvalidDate      = "некорректный формат даты, ожидается YYYY-MM-DD"
 )
 
 type Handler struct {
@@ -45,8 +40,8 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	companyIDStr := vars["companyId"]
 	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Invalid company ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
 		return
 	}
 
@@ -54,39 +49,39 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	addressIDStr := vars["addressId"]
 	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Invalid address ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Invalid address ID: %v", err)
+		handlers.RespondFieldError(w, "addressId", msgInvalidAddressID)
 		return
 	}
 
 	// Извлекаем serviceId из query параметров
 	serviceIDStr := r.URL.Query().Get("serviceId")
 	if serviceIDStr == "" {
-		h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Missing service ID")
-		handlers.RespondBadRequest(w, msgMissingServiceID)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Missing service ID")
+		handlers.RespondFieldError(w, "serviceId", msgMissingServiceID)
 		return
 	}
 
 	serviceID, err := strconv.ParseInt(serviceIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Invalid service ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidServiceID)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Invalid service ID: %v", err)
+		handlers.RespondFieldError(w, "serviceId", msgInvalidServiceID)
 		return
 	}
 
 	// Извлекаем date из query параметров
 	dateStr := r.URL.Query().Get("date")
 	if dateStr == "" {
-		h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Missing date")
-		handlers.RespondBadRequest(w, msgMissingDate)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Missing date")
+		handlers.RespondFieldError(w, "date", msgMissingDate)
 		return
 	}
 
 	// Формируем запрос к use case (с парсингом даты)
 	useCaseReq, err := ToUseCaseRequest(companyID, addressID, serviceID, dateStr)
 	if err != nil {
-		h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Invalid date format: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidDate)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Invalid date format: %v", err)
+		handlers.RespondFieldError(w, "date", msgInvalidDate)
 		return
 	}
 
@@ -94,38 +89,16 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	result, err := h.useCase.Execute(r.Context(), useCaseReq)
 	if err != nil {
 		// Обработка ошибок use case
-		switch {
-		case errors.Is(err, getAvailableSlots.ErrCompanyNotFound):
-			h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Company not found: company_id=%d", companyID)
-			handlers.RespondNotFound(w, msgCompanyNotFound)
-
-		case errors.Is(err, getAvailableSlots.ErrAddressNotFound):
-			h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Address not found: company_id=%d, address_id=%d",
-				companyID, addressID)
-			handlers.RespondNotFound(w, msgAddressNotFound)
-
-		case errors.Is(err, getAvailableSlots.ErrServiceNotFound):
-			h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Service not found: company_id=%d, service_id=%d",
-				companyID, serviceID)
-			handlers.RespondNotFound(w, msgServiceNotFound)
-
-		case errors.Is(err, getAvailableSlots.ErrServiceNotAvailableAtAddress):
-			h.logger.Warn("GET /companies/{id}/addresses/{id}/available-slots - Service not available at address: company_id=%d, address_id=%d, service_id=%d",
-				companyID, addressID, serviceID)
-			handlers.RespondBadRequest(w, msgServiceNotAvailable)
-
-		default:
-			h.logger.Error("GET /companies/{id}/addresses/{id}/available-slots - Failed to get slots: company_id=%d, address_id=%d, service_id=%d, error=%v",
-				companyID, addressID, serviceID, err)
-			handlers.RespondInternalError(w)
-		}
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /companies/{id}/addresses/{id}/available-slots - Failed to get slots: company_id=%d, address_id=%d, service_id=%d",
+				companyID, addressID, serviceID), err)
 		return
 	}
 
 	// Формируем HTTP ответ
 	response := FromUseCaseResponse(result)
 
-	h.logger.Info("GET /companies/{id}/addresses/{id}/available-slots - Slots retrieved successfully: company_id=%d, address_id=%d, service_id=%d, slots_count=%d",
+	h.logger.InfoContext(r.Context(), "GET /companies/{id}/addresses/{id}/available-slots - Slots retrieved successfully: company_id=%d, address_id=%d, service_id=%d, slots_count=%d",
 		companyID, addressID, serviceID, len(result.Slots))
 	handlers.RespondJSON(w, http.StatusOK, response)
 }
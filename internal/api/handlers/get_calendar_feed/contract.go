@@ -0,0 +1,17 @@
+package get_calendar_feed
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/calendar/models"
+)
+
+type CalendarService interface {
+	GetFeed(ctx context.Context, token string) (*models.Feed, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
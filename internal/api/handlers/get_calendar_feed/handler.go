@@ -0,0 +1,48 @@
+package get_calendar_feed
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/pkg/ical"
+)
+
+type Handler struct {
+	service CalendarService
+	logger  Logger
+}
+
+func NewHandler(service CalendarService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/calendar/{token}.ics
+// Публичный календарь (iCalendar) бронирований пользователя или расписания адреса компании
+// Доступ определяется токеном из ссылки, аутентификация не требуется
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	token := mux.Vars(r)["token"]
+
+	feed, err := h.service.GetFeed(r.Context(), token)
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger, "GET /calendar/{token}.ics - Failed to get feed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Content-Disposition", "inline; filename=\"bookings.ics\"")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := ToCalendar(feed, i18n.FromContext(r.Context())).WriteTo(w); err != nil {
+		h.logger.WarnContext(r.Context(), "GET /calendar/{token}.ics - Failed to write calendar: %v", err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "GET /calendar/{token}.ics - Calendar rendered: scope=%s, events=%d", feed.Scope, len(feed.Events))
+}
@@ -0,0 +1,199 @@
+package get_calendar_feed
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/service/calendar/models"
+	"github.com/m04kA/SMC-BookingService/pkg/ical"
+)
+
+// prodID идентификатор продукта в календаре
+const prodID = "-//SMC//BookingService//RU"
+
+// uidDomain домен UID событий: UID бронирования не меняется между загрузками календаря
+const uidDomain = "smc-bookingservice"
+
+// labels подписи календаря
+type labels struct {
+	MyBookings      string // Название календаря клиента
+	AddressSchedule string // Название календаря адреса без названия компании и адреса
+	Schedule        string // Префикс названия календаря адреса
+	Status          string
+	Car             string
+	Customer        string
+	Notes           string
+	Reason          string
+	Booking         string // Номер бронирования, %d - ID
+}
+
+// calendarLabels подписи календаря по языкам
+var calendarLabels = map[i18n.Locale]labels{
+	i18n.RU: {
+		MyBookings:      "Мои бронирования",
+		AddressSchedule: "Расписание адреса",
+		Schedule:        "Расписание: ",
+		Status:          "Статус: ",
+		Car:             "Автомобиль: ",
+		Customer:        "Клиент: ",
+		Notes:           "Комментарий: ",
+		Reason:          "Причина отмены: ",
+		Booking:         "Бронирование №%d",
+	},
+	i18n.EN: {
+		MyBookings:      "My bookings",
+		AddressSchedule: "Address schedule",
+		Schedule:        "Schedule: ",
+		Status:          "Status: ",
+		Car:             "Car: ",
+		Customer:        "Customer: ",
+		Notes:           "Comment: ",
+		Reason:          "Cancellation reason: ",
+		Booking:         "Booking #%d",
+	},
+	i18n.KK: {
+		MyBookings:      "Менің брондауларым",
+		AddressSchedule: "Мекенжай кестесі",
+		Schedule:        "Кесте: ",
+		Status:          "Мәртебе: ",
+		Car:             "Көлік: ",
+		Customer:        "Клиент: ",
+		Notes:           "Түсініктеме: ",
+		Reason:          "Бас тарту себебі: ",
+		Booking:         "Брондау №%d",
+	},
+}
+
+// eventStatuses статусы событий календаря по статусам бронирований
+var eventStatuses = map[string]string{
+	"pending":              ical.StatusTentative,
+	"confirmed":            ical.StatusConfirmed,
+	"in_progress":          ical.StatusConfirmed,
+	"completed":            ical.StatusConfirmed,
+	"cancelled_by_user":    ical.StatusCancelled,
+	"cancelled_by_company": ical.StatusCancelled,
+	"no_show":              ical.StatusConfirmed,
+}
+
+// ToCalendar формирует iCalendar из данных подписки с подписями на языке l
+func ToCalendar(feed *models.Feed, l i18n.Locale) *ical.Calendar {
+	text, ok := calendarLabels[l]
+	if !ok {
+		l, text = i18n.Source, calendarLabels[i18n.Source]
+	}
+
+	cal := &ical.Calendar{
+		ProdID:   prodID,
+		Name:     calendarName(feed, text),
+		TimeZone: feed.TimeZone,
+		Events:   make([]ical.Event, 0, len(feed.Events)),
+	}
+
+	for _, e := range feed.Events {
+		cal.Events = append(cal.Events, ical.Event{
+			UID:          EventUID(e.BookingID),
+			Start:        e.Start,
+			End:          e.End,
+			Summary:      summary(feed.Scope, e),
+			Description:  description(feed.Scope, e, l, text),
+			Location:     location(feed.Scope, e),
+			Status:       eventStatuses[e.Status],
+			Created:      e.CreatedAt,
+			LastModified: e.UpdatedAt,
+		})
+	}
+
+	return cal
+}
+
+// EventUID возвращает UID события бронирования
+func EventUID(bookingID int64) string {
+	return fmt.Sprintf("booking-%d@%s", bookingID, uidDomain)
+}
+
+// calendarName название календаря
+func calendarName(feed *models.Feed, text labels) string {
+	if feed.Scope != models.ScopeAddress {
+		return text.MyBookings
+	}
+
+	parts := make([]string, 0, 2)
+	if feed.CompanyName != "" {
+		parts = append(parts, feed.CompanyName)
+	}
+	if feed.Address != "" {
+		parts = append(parts, feed.Address)
+	}
+	if len(parts) == 0 {
+		return text.AddressSchedule
+	}
+	return text.Schedule + strings.Join(parts, ", ")
+}
+
+// summary заголовок события: услуга и компания (для клиента) или госномер (для расписания адреса)
+func summary(scope string, e models.FeedEvent) string {
+	detail := e.CompanyName
+	if scope == models.ScopeAddress {
+		detail = optional(e.CarLicensePlate)
+	}
+	if detail == "" {
+		return e.ServiceName
+	}
+	return e.ServiceName + " - " + detail
+}
+
+// description описание события: статус, автомобиль, клиент, комментарии
+func description(scope string, e models.FeedEvent, l i18n.Locale, text labels) string {
+	lines := []string{text.Status + i18n.StatusName(l, e.Status)}
+
+	if car := car(e); car != "" {
+		lines = append(lines, text.Car+car)
+	}
+	if scope == models.ScopeAddress {
+		lines = append(lines, text.Customer+strconv.FormatInt(e.UserID, 10))
+	}
+	if notes := optional(e.Notes); notes != "" {
+		lines = append(lines, text.Notes+notes)
+	}
+	if reason := optional(e.CancellationReason); reason != "" {
+		lines = append(lines, text.Reason+reason)
+	}
+	lines = append(lines, fmt.Sprintf(text.Booking, e.BookingID))
+
+	return strings.Join(lines, "\n")
+}
+
+// location место события: адрес (для клиента - с названием компании)
+func location(scope string, e models.FeedEvent) string {
+	if scope == models.ScopeAddress || e.CompanyName == "" {
+		return e.Address
+	}
+	if e.Address == "" {
+		return e.CompanyName
+	}
+	return e.CompanyName + ", " + e.Address
+}
+
+// car описание автомобиля "Марка Модель, госномер"
+func car(e models.FeedEvent) string {
+	name := strings.TrimSpace(optional(e.CarBrand) + " " + optional(e.CarModel))
+	plate := optional(e.CarLicensePlate)
+	switch {
+	case name == "":
+		return plate
+	case plate == "":
+		return name
+	default:
+		return name + ", " + plate
+	}
+}
+
+// optional форматирует опциональное значение (nil - пустая строка)
+func optional(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -1,23 +1,33 @@
 package get_company_bookings
 
 import (
-	"fmt"
+	"errors"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
 	"github.com/m04kA/SMC-BookingService/internal/domain"
 	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
 )
 
+// Сообщения клиенту об ошибках query параметров
+const (
+	msgInvalidID     = "некорректный ID"
+	msgInvalidDate   = "некорректный формат даты, ожидается YYYY-MM-DD"
+	msgInvalidBool   = "ожидается true или false"
+	msgDateWithRange = "нельзя указывать вместе с from/to"
+)
+
+// errDateWithRange date и from/to заданы одновременно
+var errDateWithRange = errors.New("date cannot be combined with from/to")
+
 // ToServiceRequest формирует запрос к сервису из query параметров
-func ToServiceRequest(
-	companyID int64,
-	userID int64,
-	addressIDStr string,
-	statusStr string,
-	dateStr string,
-	includeInactiveStr string,
-) (*models.GetCompanyBookingsRequest, error) {
+// Query params: addressId, serviceId, userId, status (через запятую), date, from, to, timeFrom, timeTo,
+// licensePlate, includeInactive, limit, cursor, sort (все опционально)
+// date задает один день и не может использоваться вместе с from/to
+func ToServiceRequest(companyID int64, userID int64, query url.Values) (*models.GetCompanyBookingsRequest, error) {
 	req := &models.GetCompanyBookingsRequest{
 		UserID:          userID,
 		CompanyID:       companyID,
@@ -25,37 +35,86 @@ func ToServiceRequest(
 	}
 
 	// Парсим addressId если указан
-	if addressIDStr != "" {
+	if addressIDStr := query.Get("addressId"); addressIDStr != "" {
 		addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
 		if err != nil {
-			return nil, err
+			return nil, domain.InvalidField(err, "addressId", msgInvalidID)
 		}
 		req.AddressID = &addressID
 	}
 
-	// Парсим status если указан
-	if statusStr != "" {
-		req.Status = &statusStr
+	// Парсим serviceId и userId (клиент) если указаны
+	if serviceIDStr := query.Get("serviceId"); serviceIDStr != "" {
+		serviceID, err := strconv.ParseInt(serviceIDStr, 10, 64)
+		if err != nil {
+			return nil, domain.InvalidField(err, "serviceId", msgInvalidID)
+		}
+		req.ServiceID = &serviceID
+	}
+	if customerIDStr := query.Get("userId"); customerIDStr != "" {
+		customerID, err := strconv.ParseInt(customerIDStr, 10, 64)
+		if err != nil {
+			return nil, domain.InvalidField(err, "userId", msgInvalidID)
+		}
+		req.CustomerID = &customerID
+	}
+
+	// Парсим статусы: через запятую и/или повторяющимся параметром
+	for _, statusStr := range query["status"] {
+		for _, status := range strings.Split(statusStr, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				req.Statuses = append(req.Statuses, status)
+			}
+		}
 	}
 
-	// Парсим date если указана
-	if dateStr != "" {
+	// Парсим диапазон времени начала (HH:MM)
+	if timeFrom := query.Get("timeFrom"); timeFrom != "" {
+		req.TimeFrom = &timeFrom
+	}
+	if timeTo := query.Get("timeTo"); timeTo != "" {
+		req.TimeTo = &timeTo
+	}
+
+	// Парсим часть госномера
+	if licensePlate := query.Get("licensePlate"); licensePlate != "" {
+		req.LicensePlate = &licensePlate
+	}
+
+	// Парсим период: date или from/to
+	from, to, err := handlers.ParseDateRange(query)
+	if err != nil {
+		return nil, err
+	}
+	req.StartDate = from
+	req.EndDate = to
+
+	if dateStr := query.Get("date"); dateStr != "" {
+		if from != nil || to != nil {
+			return nil, domain.InvalidField(errDateWithRange, "date", msgDateWithRange)
+		}
 		date, err := time.Parse(domain.DateFormat, dateStr)
 		if err != nil {
-			return nil, err
+			return nil, domain.InvalidField(err, "date", msgInvalidDate)
 		}
 		req.StartDate = &date
 		req.EndDate = &date
 	}
 
 	// Парсим includeInactive если указан
-	if includeInactiveStr != "" {
+	if includeInactiveStr := query.Get("includeInactive"); includeInactiveStr != "" {
 		includeInactive, err := strconv.ParseBool(includeInactiveStr)
 		if err != nil {
-			return nil, fmt.Errorf("invalid includeInactive value: %w", err)
+			return nil, domain.InvalidField(err, "includeInactive", msgInvalidBool)
 		}
 		req.IncludeInactive = includeInactive
 	}
 
+	page, err := handlers.ParsePageRequest(query)
+	if err != nil {
+		return nil, err
+	}
+	req.Page = page
+
 	return req, nil
 }
@@ -2,6 +2,7 @@ package get_company_config
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -13,6 +14,8 @@ import (
 
 const (
 	msgInvalidCompanyID = "некорректный ID компании"
+	msgInvalidAddressID = "некорректный ID адреса"
+	msgInvalidServiceID = "некорректный ID услуги"
 	msgInvalidParams    = "некорректные параметры запроса"
 )
 
@@ -38,8 +41,8 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("GET /companies/{id}/config - Invalid company ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/config - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
 		return
 	}
 
@@ -50,8 +53,8 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	// Формируем запрос к сервису
 	serviceReq, err := ToServiceRequest(companyID, addressIDStr, serviceIDStr)
 	if err != nil {
-		h.logger.Warn("GET /companies/{id}/config - Invalid parameters: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidParams)
+		h.logger.WarnContext(r.Context(), "GET /companies/{id}/config - Invalid parameters: %v", err)
+		handlers.RespondInvalidParams(w, msgInvalidParams, err)
 		return
 	}
 
@@ -60,20 +63,19 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		// Если конфигурация не найдена - возвращаем дефолтные значения
 		if errors.Is(err, config.ErrConfigNotFound) {
-			h.logger.Info("GET /companies/{id}/config - Config not found, returning defaults: company_id=%d",
+			h.logger.InfoContext(r.Context(), "GET /companies/{id}/config - Config not found, returning defaults: company_id=%d",
 				companyID)
 			defaultConfig := GetDefaultConfigResponse(companyID)
 			handlers.RespondJSON(w, http.StatusOK, defaultConfig)
 			return
 		}
 
-		h.logger.Error("GET /companies/{id}/config - Failed to get config: company_id=%d, error=%v",
-			companyID, err)
-		handlers.RespondInternalError(w)
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /companies/{id}/config - Failed to get config: company_id=%d", companyID), err)
 		return
 	}
 
-	h.logger.Info("GET /companies/{id}/config - Config retrieved successfully: company_id=%d, config_id=%d",
+	h.logger.InfoContext(r.Context(), "GET /companies/{id}/config - Config retrieved successfully: company_id=%d, config_id=%d",
 		companyID, result.ID)
 	handlers.RespondJSON(w, http.StatusOK, result)
 }
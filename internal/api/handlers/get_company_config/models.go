@@ -19,7 +19,7 @@ func ToServiceRequest(companyID int64, addressIDStr string, serviceIDStr string)
 	if addressIDStr != "" {
 		addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
 		if err != nil {
-			return nil, err
+			return nil, domain.InvalidField(err, "addressId", msgInvalidAddressID)
 		}
 		req.AddressID = &addressID
 	}
@@ -28,7 +28,7 @@ func ToServiceRequest(companyID int64, addressIDStr string, serviceIDStr string)
 	if serviceIDStr != "" {
 		serviceID, err := strconv.ParseInt(serviceIDStr, 10, 64)
 		if err != nil {
-			return nil, err
+			return nil, domain.InvalidField(err, "serviceId", msgInvalidServiceID)
 		}
 		req.ServiceID = &serviceID
 	}
@@ -39,7 +39,7 @@ func ToServiceRequest(companyID int64, addressIDStr string, serviceIDStr string)
 // GetDefaultConfigResponse возвращает дефолтную конфигурацию
 func GetDefaultConfigResponse(companyID int64) *models.ConfigResponse {
 	return &models.ConfigResponse{
-		ID:                      0,  // 0 означает, что это не из БД
+		ID:                      0, // 0 означает, что это не из БД
 		CompanyID:               companyID,
 		AddressID:               nil,
 		ServiceID:               nil,
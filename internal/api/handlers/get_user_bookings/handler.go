@@ -1,17 +1,21 @@
 package get_user_bookings
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
-	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
 )
 
 const (
 	msgInvalidUserID = "некорректный ID пользователя"
+	msgMissingUserID = "отсутствует ID пользователя"
+	msgForbidden     = "нет доступа к бронированиям другого пользователя"
+	msgInvalidParams = "некорректные параметры запроса"
 )
 
 type Handler struct {
@@ -27,6 +31,8 @@ func NewHandler(service BookingService, logger Logger) *Handler {
 }
 
 // Handle GET /api/v1/users/{userId}/bookings
+// Query params: status, from, to, limit, cursor, sort (опционально)
+// Доступно владельцу бронирований или администратору
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	// Извлекаем userId из URL
 	vars := mux.Vars(r)
@@ -34,34 +40,42 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := strconv.ParseInt(userIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("GET /users/{userId}/bookings - Invalid user ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidUserID)
+		h.logger.WarnContext(r.Context(), "GET /users/{userId}/bookings - Invalid user ID: %v", err)
+		handlers.RespondFieldError(w, "userId", msgInvalidUserID)
 		return
 	}
 
-	// Получаем status из query параметров (опционально)
-	status := r.URL.Query().Get("status")
-	var statusPtr *string
-	if status != "" {
-		statusPtr = &status
+	// Проверяем доступ: только сам пользователь или администратор
+	authUserID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "GET /users/{userId}/bookings - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+	if authUserID != userID && !middleware.IsAdmin(r.Context()) {
+		h.logger.WarnContext(r.Context(), "GET /users/{userId}/bookings - Access denied: user_id=%d, auth_user_id=%d",
+			userID, authUserID)
+		handlers.RespondForbidden(w, msgForbidden)
+		return
 	}
 
 	// Формируем запрос к сервису
-	serviceReq := &models.GetUserBookingsRequest{
-		UserID: userID,
-		Status: statusPtr,
+	serviceReq, err := ToServiceRequest(userID, r.URL.Query())
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "GET /users/{userId}/bookings - Invalid parameters: %v", err)
+		handlers.RespondInvalidParams(w, msgInvalidParams, err)
+		return
 	}
 
 	// Получаем бронирования пользователя
 	result, err := h.service.GetUserBookings(r.Context(), serviceReq)
 	if err != nil {
-		h.logger.Error("GET /users/{userId}/bookings - Failed to get bookings: user_id=%d, error=%v",
-			userID, err)
-		handlers.RespondInternalError(w)
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("GET /users/{userId}/bookings - Failed to get bookings: user_id=%d", userID), err)
 		return
 	}
 
-	h.logger.Info("GET /users/{userId}/bookings - Bookings retrieved successfully: user_id=%d, count=%d",
+	h.logger.InfoContext(r.Context(), "GET /users/{userId}/bookings - Bookings retrieved successfully: user_id=%d, count=%d",
 		userID, len(result.Bookings))
-	handlers.RespondJSON(w, http.StatusOK, result.Bookings)
+	handlers.RespondJSON(w, http.StatusOK, result)
 }
@@ -0,0 +1,33 @@
+package get_user_bookings
+
+import (
+	"net/url"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+// ToServiceRequest формирует запрос к сервису из query параметров
+// Query params: status, from, to, limit, cursor, sort (все опционально)
+func ToServiceRequest(userID int64, query url.Values) (*models.GetUserBookingsRequest, error) {
+	req := &models.GetUserBookingsRequest{UserID: userID}
+
+	if status := query.Get("status"); status != "" {
+		req.Status = &status
+	}
+
+	from, to, err := handlers.ParseDateRange(query)
+	if err != nil {
+		return nil, err
+	}
+	req.StartDate = from
+	req.EndDate = to
+
+	page, err := handlers.ParsePageRequest(query)
+	if err != nil {
+		return nil, err
+	}
+	req.Page = page
+
+	return req, nil
+}
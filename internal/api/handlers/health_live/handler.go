@@ -0,0 +1,23 @@
+package health_live
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+)
+
+type Handler struct{}
+
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// Handle GET /healthz
+// Проверка живости процесса (liveness): зависимости не проверяются, чтобы их сбой не перезапускал сервис
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	handlers.RespondJSON(w, http.StatusOK, &LivenessResponse{
+		Status:    "ok",
+		Timestamp: time.Now(),
+	})
+}
@@ -0,0 +1,9 @@
+package health_live
+
+import "time"
+
+// LivenessResponse HTTP response model
+type LivenessResponse struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,11 @@
+package health_ready
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/infra/health"
+)
+
+type Checker interface {
+	Check(ctx context.Context) health.Report
+}
@@ -0,0 +1,31 @@
+package health_ready
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+)
+
+type Handler struct {
+	checker Checker
+}
+
+func NewHandler(checker Checker) *Handler {
+	return &Handler{
+		checker: checker,
+	}
+}
+
+// Handle GET /readyz
+// Проверка готовности (readiness): PostgreSQL, миграции, SellerService и UserService.
+// 200 - сервис принимает запросы (ok или degraded), 503 - недоступна критичная зависимость
+// или сервис завершает работу
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	report := h.checker.Check(r.Context())
+
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	handlers.RespondJSON(w, status, ToReadinessResponse(report))
+}
@@ -0,0 +1,42 @@
+package health_ready
+
+import (
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/infra/health"
+)
+
+// ReadinessResponse HTTP response model
+type ReadinessResponse struct {
+	Status    string                        `json:"status"`
+	Timestamp time.Time                     `json:"timestamp"`
+	Checks    map[string]DependencyResponse `json:"checks"`
+}
+
+// DependencyResponse результат проверки зависимости
+// Текст ошибки не отдается: /readyz публичный, ошибка логируется на сервере (health.Checker)
+type DependencyResponse struct {
+	Status    string    `json:"status"`
+	Critical  bool      `json:"critical"`
+	LatencyMs int64     `json:"latencyMs"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// ToReadinessResponse конвертирует результат проверок в HTTP ответ
+func ToReadinessResponse(report health.Report) *ReadinessResponse {
+	checks := make(map[string]DependencyResponse, len(report.Checks))
+	for name, result := range report.Checks {
+		checks[name] = DependencyResponse{
+			Status:    result.Status,
+			Critical:  result.Critical,
+			LatencyMs: result.Latency.Milliseconds(),
+			CheckedAt: result.CheckedAt,
+		}
+	}
+
+	return &ReadinessResponse{
+		Status:    report.Status,
+		Timestamp: time.Now(),
+		Checks:    checks,
+	}
+}
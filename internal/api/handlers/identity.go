@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+var (
+	// ErrMissingUserID возвращается, когда в контексте нет авторизованного пользователя
+	ErrMissingUserID = errors.New("missing authenticated user ID")
+
+	// ErrUserIDMismatch возвращается, когда ID пользователя в запросе не совпадает с авторизованным
+	ErrUserIDMismatch = errors.New("user ID does not match authenticated user")
+)
+
+// ResolveUserID возвращает ID авторизованного пользователя (из middleware.Auth)
+// claimedUserID - ID пользователя из пути или тела запроса (0 - не указан).
+// Если он указан и отличается от авторизованного, возвращается ErrUserIDMismatch
+func ResolveUserID(ctx context.Context, claimedUserID int64) (int64, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return 0, ErrMissingUserID
+	}
+
+	if claimedUserID != 0 && claimedUserID != userID {
+		return 0, ErrUserIDMismatch
+	}
+
+	return userID, nil
+}
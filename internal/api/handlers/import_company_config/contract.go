@@ -0,0 +1,17 @@
+package import_company_config
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+)
+
+type ConfigService interface {
+	Import(ctx context.Context, req *models.ImportConfigsRequest) (*models.ImportConfigsResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,123 @@
+package import_company_config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	"github.com/m04kA/SMC-BookingService/internal/service/config"
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+)
+
+// maxImportBodyBytes максимальный размер файла импорта
+const maxImportBodyBytes = 1 << 20
+
+const (
+	msgInvalidBool        = "ожидается true или false"
+	msgInvalidCompanyID   = "некорректный ID компании"
+	msgMissingUserID      = "отсутствует ID пользователя"
+	msgInvalidRequestBody = "некорректный файл импорта"
+)
+
+type Handler struct {
+	service ConfigService
+	logger  Logger
+}
+
+func NewHandler(service ConfigService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle POST /api/v1/companies/{companyId}/config/import
+// Тело запроса: JSON ({"configs": [...]}) или CSV (Content-Type: text/csv)
+// Query params: replace (опционально) - удалить конфигурации, отсутствующие в файле,
+// force (опционально) - применить, несмотря на конфликты с существующими бронированиями
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем companyId из URL
+	vars := mux.Vars(r)
+	companyIDStr := vars["companyId"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Получаем userID из контекста (через middleware Auth)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	replace, err := handlers.ParseBoolQuery(r.URL.Query().Get("replace"))
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Invalid replace value: %v", err)
+		handlers.RespondFieldError(w, "replace", msgInvalidBool)
+		return
+	}
+	force, err := handlers.ParseBoolQuery(r.URL.Query().Get("force"))
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Invalid force value: %v", err)
+		handlers.RespondFieldError(w, "force", msgInvalidBool)
+		return
+	}
+
+	// Разбираем файл импорта в зависимости от формата
+	body := http.MaxBytesReader(w, r.Body, maxImportBodyBytes)
+	var items []models.ImportConfigItem
+	if IsCSV(r.Header.Get("Content-Type")) {
+		items, err = ParseCSV(body)
+	} else {
+		items, err = ParseJSON(body)
+	}
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Invalid import file: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	result, err := h.service.Import(r.Context(), &models.ImportConfigsRequest{
+		UserID:    userID,
+		CompanyID: companyID,
+		Replace:   replace,
+		Force:     force,
+		Items:     items,
+	})
+	// Импорт конфликтует с бронированиями - ничего не применено, возвращаем конфликты
+	if errors.Is(err, config.ErrConfigConflicts) && result != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Conflicts with bookings: company_id=%d, bookings=%d",
+			companyID, len(result.ConflictingBookings))
+		handlers.RespondJSON(w, http.StatusConflict, result)
+		return
+	}
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("POST /companies/{id}/config/import - Failed to import configs: company_id=%d", companyID), err)
+		return
+	}
+
+	// Файл не прошёл валидацию - ничего не применено, возвращаем ошибки по строкам
+	if !result.Applied {
+		LocalizeLineErrors(result.Errors, i18n.FromContext(r.Context()))
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/config/import - Validation failed: company_id=%d, errors=%d",
+			companyID, len(result.Errors))
+		handlers.RespondJSON(w, http.StatusUnprocessableEntity, result)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "POST /companies/{id}/config/import - Configs imported: company_id=%d, created=%d, updated=%d, deleted=%d",
+		companyID, result.Created, result.Updated, result.Deleted)
+	handlers.RespondJSON(w, http.StatusOK, result)
+}
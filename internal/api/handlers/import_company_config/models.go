@@ -0,0 +1,150 @@
+package import_company_config
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+)
+
+// Колонки CSV файла импорта (совпадают с форматом экспорта)
+const (
+	columnAddressID               = "address_id"
+	columnServiceID               = "service_id"
+	columnSlotDurationMinutes     = "slot_duration_minutes"
+	columnMaxConcurrentBookings   = "max_concurrent_bookings"
+	columnAdvanceBookingDays      = "advance_booking_days"
+	columnMinBookingNoticeMinutes = "min_booking_notice_minutes"
+)
+
+var requiredColumns = []string{
+	columnAddressID,
+	columnServiceID,
+	columnSlotDurationMinutes,
+	columnMaxConcurrentBookings,
+	columnAdvanceBookingDays,
+	columnMinBookingNoticeMinutes,
+}
+
+// ImportJSONRequest тело JSON импорта (совместимо с ответом экспорта)
+type ImportJSONRequest struct {
+	Configs []models.ImportConfigItem `json:"configs"`
+}
+
+// IsCSV проверяет, что тело запроса передано в формате CSV
+func IsCSV(contentType string) bool {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	return strings.EqualFold(mediaType, "text/csv")
+}
+
+// ParseJSON разбирает JSON импорт, номер строки - позиция элемента в массиве (с 1)
+func ParseJSON(r io.Reader) ([]models.ImportConfigItem, error) {
+	var req ImportJSONRequest
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	for i := range req.Configs {
+		req.Configs[i].Line = i + 1
+	}
+	return req.Configs, nil
+}
+
+// ParseCSV разбирает CSV импорт: первая строка - заголовок, далее одна строка на уровень (адрес, услуга)
+// Пустые address_id/service_id означают "для всех адресов/услуг"
+func ParseCSV(r io.Reader) ([]models.ImportConfigItem, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	items := []models.ImportConfigItem{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		item, err := parseCSVRecord(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		item.Line = line
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// parseCSVRecord конвертирует строку CSV в элемент импорта
+func parseCSVRecord(record []string, columns map[string]int) (models.ImportConfigItem, error) {
+	var (
+		item models.ImportConfigItem
+		err  error
+	)
+
+	if item.AddressID, err = parseOptionalID(record[columns[columnAddressID]]); err != nil {
+		return item, fmt.Errorf("%s: %w", columnAddressID, err)
+	}
+	if item.ServiceID, err = parseOptionalID(record[columns[columnServiceID]]); err != nil {
+		return item, fmt.Errorf("%s: %w", columnServiceID, err)
+	}
+	if item.SlotDurationMinutes, err = strconv.Atoi(strings.TrimSpace(record[columns[columnSlotDurationMinutes]])); err != nil {
+		return item, fmt.Errorf("%s: %w", columnSlotDurationMinutes, err)
+	}
+	if item.MaxConcurrentBookings, err = strconv.Atoi(strings.TrimSpace(record[columns[columnMaxConcurrentBookings]])); err != nil {
+		return item, fmt.Errorf("%s: %w", columnMaxConcurrentBookings, err)
+	}
+	if item.AdvanceBookingDays, err = strconv.Atoi(strings.TrimSpace(record[columns[columnAdvanceBookingDays]])); err != nil {
+		return item, fmt.Errorf("%s: %w", columnAdvanceBookingDays, err)
+	}
+	if item.MinBookingNoticeMinutes, err = strconv.Atoi(strings.TrimSpace(record[columns[columnMinBookingNoticeMinutes]])); err != nil {
+		return item, fmt.Errorf("%s: %w", columnMinBookingNoticeMinutes, err)
+	}
+
+	return item, nil
+}
+
+// parseOptionalID парсит опциональный ID (пустая строка - nil)
+func parseOptionalID(value string) (*int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// LocalizeLineErrors переводит сообщения ошибок строк импорта на язык ответа
+func LocalizeLineErrors(lineErrors []models.ImportLineError, l i18n.Locale) {
+	for i := range lineErrors {
+		lineErrors[i].Message = apierror.Translate(lineErrors[i].Message, l)
+	}
+}
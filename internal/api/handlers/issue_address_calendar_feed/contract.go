@@ -0,0 +1,17 @@
+package issue_address_calendar_feed
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/calendar/models"
+)
+
+type CalendarService interface {
+	IssueAddressFeed(ctx context.Context, companyID, addressID int64, userID int64) (*models.FeedTokenResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,74 @@
+package issue_address_calendar_feed
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidCompanyID = "некорректный ID компании"
+	msgInvalidAddressID = "некорректный ID адреса"
+	msgMissingUserID    = "отсутствует ID пользователя"
+)
+
+type Handler struct {
+	service CalendarService
+	logger  Logger
+}
+
+func NewHandler(service CalendarService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle POST /api/v1/companies/{companyId}/addresses/{addressId}/calendar-feed
+// Выпускает ссылку на календарь расписания адреса, предыдущая ссылка перестает работать
+// Доступно менеджерам и операторам компании, администраторам платформы
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	// Извлекаем companyId из URL
+	companyID, err := strconv.ParseInt(vars["companyId"], 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/addresses/{id}/calendar-feed - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Извлекаем addressId из URL
+	addressID, err := strconv.ParseInt(vars["addressId"], 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/addresses/{id}/calendar-feed - Invalid address ID: %v", err)
+		handlers.RespondFieldError(w, "addressId", msgInvalidAddressID)
+		return
+	}
+
+	// Получаем userID из контекста (через middleware Auth)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "POST /companies/{id}/addresses/{id}/calendar-feed - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	// Сервис сам проверит права на бронирования компании
+	result, err := h.service.IssueAddressFeed(r.Context(), companyID, addressID, userID)
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("POST /companies/{id}/addresses/{id}/calendar-feed - Failed to issue feed: company_id=%d, address_id=%d",
+				companyID, addressID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "POST /companies/{id}/addresses/{id}/calendar-feed - Calendar feed issued: company_id=%d, address_id=%d, user_id=%d",
+		companyID, addressID, userID)
+	handlers.RespondJSON(w, http.StatusCreated, result)
+}
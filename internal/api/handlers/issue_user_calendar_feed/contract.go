@@ -0,0 +1,17 @@
+package issue_user_calendar_feed
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/calendar/models"
+)
+
+type CalendarService interface {
+	IssueUserFeed(ctx context.Context, userID int64, actorID int64) (*models.FeedTokenResponse, error)
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
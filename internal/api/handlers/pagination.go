@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+const (
+	msgInvalidLimit = "ожидается целое число"
+	msgInvalidDate  = "некорректный формат даты, ожидается YYYY-MM-DD"
+)
+
+// ParsePageRequest извлекает параметры пагинации из query: limit, cursor, sort
+func ParsePageRequest(query url.Values) (models.PageRequest, error) {
+	var page models.PageRequest
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return page, domain.InvalidField(err, "limit", msgInvalidLimit)
+		}
+		page.Limit = limit
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		page.Cursor = &cursor
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		page.Sort = &sort
+	}
+
+	return page, nil
+}
+
+// ParseDateRange извлекает период из query: from, to (YYYY-MM-DD, включительно)
+func ParseDateRange(query url.Values) (from, to *time.Time, err error) {
+	if fromStr := query.Get("from"); fromStr != "" {
+		date, err := time.Parse(domain.DateFormat, fromStr)
+		if err != nil {
+			return nil, nil, domain.InvalidField(err, "from", msgInvalidDate)
+		}
+		from = &date
+	}
+
+	if toStr := query.Get("to"); toStr != "" {
+		date, err := time.Parse(domain.DateFormat, toStr)
+		if err != nil {
+			return nil, nil, domain.InvalidField(err, "to", msgInvalidDate)
+		}
+		to = &date
+	}
+
+	return from, to, nil
+}
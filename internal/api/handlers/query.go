@@ -0,0 +1,11 @@
+package handlers
+
+import "strconv"
+
+// ParseBoolQuery парсит опциональный булев query параметр (пустое значение - false)
+func ParseBoolQuery(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,13 @@
+package revoke_user_calendar_feed
+
+import "context"
+
+type CalendarService interface {
+	RevokeUserFeed(ctx context.Context, userID int64) error
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,70 @@
+package revoke_user_calendar_feed
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidUserID = "некорректный ID пользователя"
+	msgMissingUserID = "отсутствует ID пользователя"
+	msgForbidden     = "нет доступа к календарю другого пользователя"
+)
+
+type Handler struct {
+	service CalendarService
+	logger  Logger
+}
+
+func NewHandler(service CalendarService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle DELETE /api/v1/users/{userId}/calendar-feed
+// Отзывает ссылку на календарь бронирований пользователя
+// Доступно самому пользователю или администратору
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем userId из URL
+	vars := mux.Vars(r)
+	userIDStr := vars["userId"]
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "DELETE /users/{userId}/calendar-feed - Invalid user ID: %v", err)
+		handlers.RespondFieldError(w, "userId", msgInvalidUserID)
+		return
+	}
+
+	// Проверяем доступ: только сам пользователь или администратор
+	authUserID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "DELETE /users/{userId}/calendar-feed - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+	if authUserID != userID && !middleware.IsAdmin(r.Context()) {
+		h.logger.WarnContext(r.Context(), "DELETE /users/{userId}/calendar-feed - Access denied: user_id=%d, auth_user_id=%d",
+			userID, authUserID)
+		handlers.RespondForbidden(w, msgForbidden)
+		return
+	}
+
+	if err := h.service.RevokeUserFeed(r.Context(), userID); err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("DELETE /users/{userId}/calendar-feed - Failed to revoke feed: user_id=%d", userID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "DELETE /users/{userId}/calendar-feed - Calendar feed revoked: user_id=%d, auth_user_id=%d",
+		userID, authUserID)
+	handlers.RespondJSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,99 @@
+package stream_available_slots
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+)
+
+// slotCache последний расчет слотов для каждого запроса с открытыми потоками
+//
+// Все подписчики ключа получают сигнал об изменении одновременно: слоты рассчитываются один раз
+// на версию (singleflight), остальные соединения получают тот же результат.
+// Запись удаляется, когда закрывается последнее соединение с этим запросом
+type slotCache struct {
+	mu      sync.Mutex
+	entries map[string]*slotEntry
+	group   singleflight.Group
+}
+
+type slotEntry struct {
+	refs    int
+	version uint64
+	data    []byte // JSON ответа; nil - еще не рассчитан
+}
+
+func newSlotCache() *slotCache {
+	return &slotCache{
+		entries: make(map[string]*slotEntry),
+	}
+}
+
+// acquire регистрирует соединение с запросом key
+func (c *slotCache) acquire(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &slotEntry{}
+		c.entries[key] = entry
+	}
+	entry.refs++
+}
+
+// release снимает регистрацию соединения и удаляет запись после последнего
+func (c *slotCache) release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	entry.refs--
+	if entry.refs <= 0 {
+		delete(c.entries, key)
+	}
+}
+
+// get возвращает слоты не старше version: из кеша или рассчитывает один раз на все соединения
+// Расчет не отменяется при отключении клиента, начавшего его: результат ждут другие соединения
+func (c *slotCache) get(ctx context.Context, key string, version uint64, compute func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && entry.data != nil && entry.version >= version {
+		data := entry.data
+		c.mu.Unlock()
+		return data, nil
+	}
+	c.mu.Unlock()
+
+	v, err, _ := c.group.Do(fmt.Sprintf("%s@%d", key, version), func() (interface{}, error) {
+		data, err := compute(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if entry, ok := c.entries[key]; ok && version >= entry.version {
+			entry.version = version
+			entry.data = data
+		}
+		c.mu.Unlock()
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// cacheKey ключ запроса слотов: компания, адрес, услуга и дата
+func cacheKey(req *getAvailableSlots.Request) string {
+	return fmt.Sprintf("%d:%d:%d:%s", req.CompanyID, req.AddressID, req.ServiceID, req.Date.Format(domain.DateFormat))
+}
@@ -0,0 +1,71 @@
+package stream_available_slots
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlotCache_ComputesOncePerVersion(t *testing.T) {
+	cache := newSlotCache()
+	cache.acquire("1:100:1:2025-10-15")
+	defer cache.release("1:100:1:2025-10-15")
+
+	var calls atomic.Int64
+	started, release := make(chan struct{}), make(chan struct{})
+	compute := func(context.Context) ([]byte, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return []byte(`{"slots":[]}`), nil
+	}
+
+	// Одновременные запросы одной версии ждут один расчет
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := cache.get(context.Background(), "1:100:1:2025-10-15", 1, compute)
+			assert.NoError(t, err)
+			assert.Equal(t, `{"slots":[]}`, string(data))
+		}()
+	}
+	<-started
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int64(1), calls.Load())
+
+	// Запрос той же или более старой версии отдается из кеша
+	_, err := cache.get(context.Background(), "1:100:1:2025-10-15", 1, compute)
+	require.NoError(t, err)
+	_, err = cache.get(context.Background(), "1:100:1:2025-10-15", 0, compute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), calls.Load())
+
+	// Новая версия рассчитывается заново
+	_, err = cache.get(context.Background(), "1:100:1:2025-10-15", 2, compute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestSlotCache_ReleaseRemovesEntry(t *testing.T) {
+	cache := newSlotCache()
+	cache.acquire("key")
+	cache.acquire("key")
+
+	_, err := cache.get(context.Background(), "key", 1, func(context.Context) ([]byte, error) {
+		return []byte("{}"), nil
+	})
+	require.NoError(t, err)
+
+	cache.release("key")
+	assert.Contains(t, cache.entries, "key")
+	cache.release("key")
+	assert.NotContains(t, cache.entries, "key")
+}
@@ -0,0 +1,25 @@
+package stream_available_slots
+
+import (
+	"context"
+	"time"
+
+	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+)
+
+type GetAvailableSlotsUseCase interface {
+	Execute(ctx context.Context, req *getAvailableSlots.Request) (*getAvailableSlots.Response, error)
+}
+
+// SlotEventSubscriber подписка на изменения доступности слотов адреса на дату
+// Version растет при каждом изменении, пока у адреса на дату есть подписчики
+type SlotEventSubscriber interface {
+	Subscribe(companyID, addressID int64, date time.Time) (<-chan struct{}, func())
+	Version(companyID, addressID int64, date time.Time) uint64
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,248 @@
+package stream_available_slots
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	getAvailableSlotsHandler "github.com/m04kA/SMC-BookingService/internal/api/handlers/get_available_slots"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	getAvailableSlots "github.com/m04kA/SMC-BookingService/internal/usecase/get_available_slots"
+)
+
+const (
+	msgInvalidCompanyID   = "некорректный ID компании"
+	msgInvalidAddressID   = "некорректный ID адреса"
+	msgInvalidServiceID   = "некорректный ID услуги"
+	msgMissingServiceID   = "ID услуги обязателен"
+	msgMissingDate        = "дата обязательна"
+	msgInvalidDate        = "некорректный формат даты, ожидается YYYY-MM-DD"
+	msgTooManyConnections = "превышено количество подключений к потоку слотов, повторите позже"
+)
+
+const (
+	reconnectInterval = 5 * time.Second  // Интервал переподключения клиента (поле retry)
+	writeTimeout      = 10 * time.Second // Таймаут записи одного события
+	heartbeatComment  = "ping"
+	logPrefix         = "GET /companies/{id}/addresses/{id}/available-slots/stream"
+)
+
+// Config настройки потока
+type Config struct {
+	HeartbeatInterval time.Duration // Интервал keep-alive комментариев
+	MaxDuration       time.Duration // Максимальная длительность соединения (0 - без ограничения)
+	MaxConnections    int           // Максимальное количество одновременных соединений (0 - без ограничения)
+}
+
+type Handler struct {
+	useCase    GetAvailableSlotsUseCase
+	subscriber SlotEventSubscriber
+	config     Config
+	cache      *slotCache
+	active     atomic.Int64
+	logger     Logger
+}
+
+func NewHandler(useCase GetAvailableSlotsUseCase, subscriber SlotEventSubscriber, config Config, logger Logger) *Handler {
+	return &Handler{
+		useCase:    useCase,
+		subscriber: subscriber,
+		config:     config,
+		cache:      newSlotCache(),
+		logger:     logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{companyId}/addresses/{addressId}/available-slots/stream
+// Query params: serviceId (required), date (required, YYYY-MM-DD)
+// Поток Server-Sent Events: сразу отправляет текущие слоты (event: slots), затем - новые слоты
+// при каждом их изменении (создание, отмена, смена статуса бронирований на адресе в эту дату,
+// изменение конфигурации слотов компании)
+// Ошибки до начала потока возвращаются как обычные JSON ответы
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	// Извлекаем companyId из URL
+	companyID, err := strconv.ParseInt(vars["companyId"], 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid company ID: %v", logPrefix, err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Извлекаем addressId из URL
+	addressID, err := strconv.ParseInt(vars["addressId"], 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid address ID: %v", logPrefix, err)
+		handlers.RespondFieldError(w, "addressId", msgInvalidAddressID)
+		return
+	}
+
+	// Извлекаем serviceId из query параметров
+	serviceIDStr := r.URL.Query().Get("serviceId")
+	if serviceIDStr == "" {
+		h.logger.WarnContext(r.Context(), "%s - Missing service ID", logPrefix)
+		handlers.RespondFieldError(w, "serviceId", msgMissingServiceID)
+		return
+	}
+
+	serviceID, err := strconv.ParseInt(serviceIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid service ID: %v", logPrefix, err)
+		handlers.RespondFieldError(w, "serviceId", msgInvalidServiceID)
+		return
+	}
+
+	// Извлекаем date из query параметров
+	dateStr := r.URL.Query().Get("date")
+	if dateStr == "" {
+		h.logger.WarnContext(r.Context(), "%s - Missing date", logPrefix)
+		handlers.RespondFieldError(w, "date", msgMissingDate)
+		return
+	}
+
+	useCaseReq, err := getAvailableSlotsHandler.ToUseCaseRequest(companyID, addressID, serviceID, dateStr)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid date format: %v", logPrefix, err)
+		handlers.RespondFieldError(w, "date", msgInvalidDate)
+		return
+	}
+
+	// Ограничиваем количество одновременных соединений
+	if active := h.active.Add(1); h.config.MaxConnections > 0 && active > int64(h.config.MaxConnections) {
+		h.active.Add(-1)
+		h.logger.WarnContext(r.Context(), "%s - Too many connections: limit=%d", logPrefix, h.config.MaxConnections)
+		w.Header().Set("Retry-After", strconv.Itoa(int(reconnectInterval.Seconds())))
+		handlers.RespondProblem(w, apierror.New(http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, msgTooManyConnections))
+		return
+	}
+	defer h.active.Add(-1)
+
+	// Подписываемся до расчета слотов, чтобы не пропустить изменение между расчетом и подпиской
+	updates, unsubscribe := h.subscriber.Subscribe(companyID, addressID, useCaseReq.Date)
+	defer unsubscribe()
+
+	key := cacheKey(useCaseReq)
+	h.cache.acquire(key)
+	defer h.cache.release(key)
+
+	ctx := r.Context()
+
+	slots, err := h.computeSlots(ctx, useCaseReq)
+	if err != nil {
+		handlers.RespondProblem(w, h.mapError(r.Context(), err, useCaseReq))
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Отключаем буферизацию ответа в nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	ew := newEventWriter(w, writeTimeout)
+	if err := ew.retry(reconnectInterval); err != nil {
+		h.logger.WarnContext(ctx, "%s - Failed to start stream: %v", logPrefix, err)
+		return
+	}
+	if err := ew.event(eventSlots, slots); err != nil {
+		h.logger.WarnContext(ctx, "%s - Failed to send slots: %v", logPrefix, err)
+		return
+	}
+
+	h.logger.InfoContext(ctx, "%s - Stream started: company_id=%d, address_id=%d, service_id=%d, date=%s",
+		logPrefix, companyID, addressID, serviceID, dateStr)
+
+	heartbeat := time.NewTicker(h.config.HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	// Соединение ограничено по времени: клиент переподключится (EventSource делает это сам),
+	// а нагрузка перераспределится между репликами
+	var deadline <-chan time.Time
+	if h.config.MaxDuration > 0 {
+		timer := time.NewTimer(h.config.MaxDuration)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	sent := 1
+	for {
+		select {
+		case <-ctx.Done():
+			h.logger.InfoContext(ctx, "%s - Client disconnected: company_id=%d, address_id=%d, events=%d",
+				logPrefix, companyID, addressID, sent)
+			return
+
+		case <-deadline:
+			h.logger.InfoContext(ctx, "%s - Max duration reached: company_id=%d, address_id=%d, events=%d",
+				logPrefix, companyID, addressID, sent)
+			return
+
+		case _, ok := <-updates:
+			if !ok {
+				// Сервер останавливается
+				return
+			}
+
+			updated, err := h.computeSlots(ctx, useCaseReq)
+			if err != nil {
+				if err := ew.errorEvent(h.mapError(ctx, err, useCaseReq).Localize(i18n.FromContext(ctx))); err != nil {
+					h.logger.WarnContext(ctx, "%s - Failed to send error: %v", logPrefix, err)
+				}
+				return
+			}
+
+			// Изменение могло не затронуть слоты этой услуги (например, другая длительность)
+			if bytes.Equal(updated, slots) {
+				continue
+			}
+			slots = updated
+
+			if err := ew.event(eventSlots, slots); err != nil {
+				h.logger.WarnContext(ctx, "%s - Failed to send slots: %v", logPrefix, err)
+				return
+			}
+			sent++
+
+		case <-heartbeat.C:
+			if err := ew.comment(heartbeatComment); err != nil {
+				h.logger.WarnContext(ctx, "%s - Failed to send heartbeat: %v", logPrefix, err)
+				return
+			}
+		}
+	}
+}
+
+// computeSlots возвращает актуальные доступные слоты в JSON
+// Соединения с одним запросом получают один расчет на каждое изменение (см. slotCache)
+func (h *Handler) computeSlots(ctx context.Context, req *getAvailableSlots.Request) ([]byte, error) {
+	version := h.subscriber.Version(req.CompanyID, req.AddressID, req.Date)
+	return h.cache.get(ctx, cacheKey(req), version, func(ctx context.Context) ([]byte, error) {
+		return h.executeSlots(ctx, req)
+	})
+}
+
+// executeSlots рассчитывает доступные слоты и возвращает их в JSON
+func (h *Handler) executeSlots(ctx context.Context, req *getAvailableSlots.Request) ([]byte, error) {
+	result, err := h.useCase.Execute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(getAvailableSlotsHandler.FromUseCaseResponse(result))
+}
+
+// mapError логирует ошибку расчета слотов и возвращает ошибку API по каталогу
+func (h *Handler) mapError(ctx context.Context, err error, req *getAvailableSlots.Request) *apierror.Error {
+	return handlers.ServiceError(ctx, h.logger, fmt.Sprintf("%s - Failed to get slots: company_id=%d, address_id=%d, service_id=%d",
+		logPrefix, req.CompanyID, req.AddressID, req.ServiceID), err)
+}
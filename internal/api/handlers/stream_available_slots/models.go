@@ -0,0 +1,71 @@
+package stream_available_slots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+)
+
+// Типы событий потока
+const (
+	eventSlots = "slots" // Доступные слоты (данные как у GET .../available-slots)
+	eventError = "error" // Ошибка пересчета слотов, после нее поток закрывается
+)
+
+// ContentType MIME тип потока Server-Sent Events
+const ContentType = "text/event-stream"
+
+// eventWriter записывает события Server-Sent Events
+// Перед каждой записью продлевает дедлайн: медленный клиент, не читающий поток,
+// отключается по таймауту вместо бесконечного накопления данных в буфере соединения
+type eventWriter struct {
+	w            http.ResponseWriter
+	rc           *http.ResponseController
+	writeTimeout time.Duration
+}
+
+func newEventWriter(w http.ResponseWriter, writeTimeout time.Duration) *eventWriter {
+	return &eventWriter{
+		w:            w,
+		rc:           http.NewResponseController(w),
+		writeTimeout: writeTimeout,
+	}
+}
+
+// retry передает клиенту интервал переподключения
+func (ew *eventWriter) retry(interval time.Duration) error {
+	return ew.write(fmt.Sprintf("retry: %d\n\n", interval.Milliseconds()))
+}
+
+// event отправляет событие с JSON данными (JSON без переводов строк - одна строка data)
+func (ew *eventWriter) event(name string, data []byte) error {
+	return ew.write(fmt.Sprintf("event: %s\ndata: %s\n\n", name, data))
+}
+
+// errorEvent отправляет событие ошибки (данные - problem+json, как тело ошибки в ответах API)
+func (ew *eventWriter) errorEvent(e *apierror.Error) error {
+	data, err := json.Marshal(e.Problem())
+	if err != nil {
+		return err
+	}
+	return ew.event(eventError, data)
+}
+
+// comment отправляет комментарий (keep-alive, клиент его игнорирует)
+func (ew *eventWriter) comment(text string) error {
+	return ew.write(": " + text + "\n\n")
+}
+
+func (ew *eventWriter) write(s string) error {
+	if err := ew.rc.SetWriteDeadline(time.Now().Add(ew.writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err := ew.w.Write([]byte(s)); err != nil {
+		return err
+	}
+	return ew.rc.Flush()
+}
@@ -0,0 +1,24 @@
+package stream_company_bookings
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/infra/bookingevents"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+type BookingService interface {
+	GetCompanySnapshot(ctx context.Context, req *models.GetCompanySnapshotRequest) (*models.CompanySnapshotResponse, error)
+	CheckCompanyBookingsAccess(ctx context.Context, companyID, userID int64) error
+}
+
+// BookingEventSubscriber подписка на события бронирований компании
+type BookingEventSubscriber interface {
+	Subscribe(companyID int64, buffer int) *bookingevents.Subscription
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,387 @@
+package stream_company_bookings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	"github.com/m04kA/SMC-BookingService/internal/infra/bookingevents"
+)
+
+const (
+	msgInvalidCompanyID   = "некорректный ID компании"
+	msgMissingUserID      = "отсутствует ID пользователя"
+	msgInvalidParams      = "некорректные параметры запроса"
+	msgInvalidMessage     = "некорректное сообщение"
+	msgTooManyConnections = "превышено количество подключений к потоку бронирований, повторите позже"
+)
+
+const (
+	writeWait        = 10 * time.Second // Таймаут записи одного сообщения
+	maxMessageSize   = 4096             // Максимальный размер сообщения клиента (байт)
+	retryAfter       = 5 * time.Second  // Через сколько повторить подключение при превышении лимита
+	closeSlowClient  = "slow consumer"
+	closeShutdown    = "server shutting down"
+	closeAccessLost  = "access denied"
+	closeServerError = "internal error"
+	logPrefix        = "GET /companies/{id}/bookings/stream"
+)
+
+// Config настройки потока
+type Config struct {
+	HeartbeatInterval time.Duration // Интервал ping; клиент, не ответивший pong за два интервала, отключается
+	SendBuffer        int           // Сколько событий может ожидать отправки, прежде чем клиент будет отключен
+	MaxConnections    int           // Максимальное количество одновременных соединений (0 - без ограничения)
+	SnapshotLimit     int           // Максимум бронирований в снимке
+	AllowedOrigins    []string      // Разрешенные Origin ("*" - любые, пусто - только тот же хост)
+	AccessCheck       time.Duration // Интервал повторной проверки прав менеджера (0 - только при загрузке снимка)
+}
+
+type Handler struct {
+	service    BookingService
+	subscriber BookingEventSubscriber
+	config     Config
+	upgrader   websocket.Upgrader
+	active     atomic.Int64
+	logger     Logger
+}
+
+func NewHandler(service BookingService, subscriber BookingEventSubscriber, config Config, logger Logger) *Handler {
+	h := &Handler{
+		service:    service,
+		subscriber: subscriber,
+		config:     config,
+		logger:     logger,
+	}
+	h.upgrader = websocket.Upgrader{
+		HandshakeTimeout: writeWait,
+		CheckOrigin:      h.checkOrigin,
+	}
+	return h
+}
+
+// Handle GET /api/v1/companies/{companyId}/bookings/stream (WebSocket)
+// Query params: addressId (опционально, можно несколько), date (опционально, YYYY-MM-DD, по умолчанию сегодня)
+// Поток бронирований компании для менеджеров: при подключении - снимок бронирований на дату,
+// затем события бронирований адресов из фильтра. Доступ - как у GET /companies/{companyId}/bookings
+// Ошибки до установки соединения возвращаются как обычные JSON ответы
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	companyID, err := strconv.ParseInt(mux.Vars(r)["companyId"], 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid company ID: %v", logPrefix, err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Получаем userID из контекста (через middleware Auth)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "%s - Missing user ID", logPrefix)
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	filter, err := ParseFilter(r.URL.Query(), today())
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "%s - Invalid parameters: %v", logPrefix, err)
+		handlers.RespondInvalidParams(w, msgInvalidParams, err)
+		return
+	}
+
+	// Ограничиваем количество одновременных соединений
+	if active := h.active.Add(1); h.config.MaxConnections > 0 && active > int64(h.config.MaxConnections) {
+		h.active.Add(-1)
+		h.logger.WarnContext(r.Context(), "%s - Too many connections: limit=%d", logPrefix, h.config.MaxConnections)
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		handlers.RespondProblem(w, apierror.New(http.StatusServiceUnavailable, apierror.CodeServiceUnavailable, msgTooManyConnections))
+		return
+	}
+	defer h.active.Add(-1)
+
+	// Подписываемся до снимка, чтобы не пропустить изменение между снимком и подпиской
+	sub := h.subscriber.Subscribe(companyID, h.config.SendBuffer)
+	defer sub.Cancel()
+
+	// Снимок заодно проверяет права менеджера
+	ctx := r.Context()
+	snapshot, err := h.service.GetCompanySnapshot(ctx, filter.ToServiceRequest(companyID, userID, h.config.SnapshotLimit))
+	if err != nil {
+		handlers.RespondProblem(w, h.mapError(r.Context(), err, companyID, userID))
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrader уже ответил клиенту
+		h.logger.WarnContext(ctx, "%s - Upgrade failed: company_id=%d, user_id=%d: %v", logPrefix, companyID, userID, err)
+		return
+	}
+	defer conn.Close()
+
+	h.logger.InfoContext(ctx, "%s - Stream started: company_id=%d, user_id=%d, addresses=%v, date=%s",
+		logPrefix, companyID, userID, filter.AddressIDs, snapshot.Date)
+
+	c := &connection{
+		handler:   h,
+		conn:      conn,
+		sub:       sub,
+		companyID: companyID,
+		userID:    userID,
+		filter:    filter,
+		locale:    i18n.FromContext(r.Context()),
+	}
+	if err := c.write(SnapshotMessage(snapshot)); err != nil {
+		h.logger.WarnContext(ctx, "%s - Failed to send snapshot: %v", logPrefix, err)
+		return
+	}
+	c.run(ctx)
+}
+
+// connection соединение с менеджером
+// Запись выполняется только из run, чтение сообщений клиента - из readLoop
+type connection struct {
+	handler   *Handler
+	conn      *websocket.Conn
+	sub       *bookingevents.Subscription
+	companyID int64
+	userID    int64
+	filter    Filter
+	locale    i18n.Locale
+	sent      int
+}
+
+// run отправляет события, снимки и ping до отключения клиента или завершения подписки
+func (c *connection) run(ctx context.Context) {
+	h := c.handler
+	pongWait := 2 * h.config.HeartbeatInterval
+
+	messages := make(chan ClientMessage)
+	readDone := make(chan struct{})
+	go c.readLoop(ctx, pongWait, messages, readDone)
+
+	ping := time.NewTicker(h.config.HeartbeatInterval)
+	defer ping.Stop()
+
+	// Права менеджера могут быть отозваны во время соединения: проверяем их периодически
+	var accessCheck <-chan time.Time
+	if h.config.AccessCheck > 0 {
+		ticker := time.NewTicker(h.config.AccessCheck)
+		defer ticker.Stop()
+		accessCheck = ticker.C
+	}
+
+	for {
+		select {
+		case <-readDone:
+			h.logger.InfoContext(ctx, "%s - Client disconnected: company_id=%d, user_id=%d, events=%d",
+				logPrefix, c.companyID, c.userID, c.sent)
+			return
+
+		case <-c.sub.Done():
+			c.closeOnSubscriptionEnd(ctx)
+			return
+
+		case event := <-c.sub.Events():
+			if !c.filter.Matches(event.Booking) {
+				continue
+			}
+			if err := c.write(FromBookingEvent(event)); err != nil {
+				h.logger.WarnContext(ctx, "%s - Failed to send event: %v", logPrefix, err)
+				return
+			}
+			c.sent++
+
+		case <-c.sub.Resync():
+			// События других реплик могли быть пропущены - отправляем актуальный снимок
+			if !c.sendSnapshot(ctx) {
+				return
+			}
+
+		case msg := <-messages:
+			filter, err := msg.Apply(c.filter)
+			if err != nil {
+				h.logger.WarnContext(ctx, "%s - Invalid client message: company_id=%d, user_id=%d: %v",
+					logPrefix, c.companyID, c.userID, err)
+				if err := c.write(ErrorMessage(handlers.InvalidParams(msgInvalidMessage, err), c.locale)); err != nil {
+					return
+				}
+				continue
+			}
+			c.filter = filter
+			if !c.sendSnapshot(ctx) {
+				return
+			}
+
+		case <-accessCheck:
+			if !c.checkAccess(ctx) {
+				return
+			}
+
+		case <-ping.C:
+			deadline := time.Now().Add(writeWait)
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				h.logger.WarnContext(ctx, "%s - Failed to send ping: %v", logPrefix, err)
+				return
+			}
+		}
+	}
+}
+
+// readLoop читает сообщения клиента и продлевает таймаут чтения при каждом pong
+// Закрывает done при ошибке чтения (отключение клиента, истечение таймаута pong)
+func (c *connection) readLoop(ctx context.Context, pongWait time.Duration, messages chan<- ClientMessage, done chan<- struct{}) {
+	defer close(done)
+
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.handler.logger.WarnContext(ctx, "%s - Read failed: company_id=%d, user_id=%d: %v",
+					logPrefix, c.companyID, c.userID, err)
+			}
+			return
+		}
+
+		var msg ClientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			msg = ClientMessage{Type: "invalid"}
+		}
+
+		select {
+		case messages <- msg:
+		case <-c.sub.Done():
+			return
+		}
+	}
+}
+
+// sendSnapshot загружает и отправляет снимок по текущему фильтру
+// Права проверяются заново: менеджер, лишенный доступа, отключается
+// Возвращает false, если соединение нужно закрыть
+func (c *connection) sendSnapshot(ctx context.Context) bool {
+	h := c.handler
+
+	snapshot, err := h.service.GetCompanySnapshot(ctx, c.filter.ToServiceRequest(c.companyID, c.userID, h.config.SnapshotLimit))
+	if err != nil {
+		apiErr := h.mapError(ctx, err, c.companyID, c.userID)
+		if apiErr.Status == http.StatusBadRequest {
+			return c.write(ErrorMessage(apiErr, c.locale)) == nil
+		}
+
+		reason, code := closeServerError, websocket.CloseInternalServerErr
+		if apiErr.Status == http.StatusForbidden || apiErr.Status == http.StatusNotFound {
+			reason, code = closeAccessLost, websocket.ClosePolicyViolation
+		}
+		c.close(code, reason)
+		return false
+	}
+
+	if err := c.write(SnapshotMessage(snapshot)); err != nil {
+		h.logger.WarnContext(ctx, "%s - Failed to send snapshot: %v", logPrefix, err)
+		return false
+	}
+	return true
+}
+
+// checkAccess повторно проверяет права менеджера на просмотр бронирований компании
+// Менеджер, лишенный доступа, отключается; при временной ошибке проверки соединение сохраняется
+// Возвращает false, если соединение нужно закрыть
+func (c *connection) checkAccess(ctx context.Context) bool {
+	h := c.handler
+
+	err := h.service.CheckCompanyBookingsAccess(ctx, c.companyID, c.userID)
+	if err == nil {
+		return true
+	}
+
+	apiErr := handlers.ServiceError(ctx, h.logger, fmt.Sprintf("%s - Failed to check access: company_id=%d, user_id=%d",
+		logPrefix, c.companyID, c.userID), err)
+	if apiErr.Status == http.StatusForbidden || apiErr.Status == http.StatusNotFound {
+		h.logger.WarnContext(ctx, "%s - Access revoked, disconnecting: company_id=%d, user_id=%d",
+			logPrefix, c.companyID, c.userID)
+		c.close(websocket.ClosePolicyViolation, closeAccessLost)
+		return false
+	}
+	return true
+}
+
+// closeOnSubscriptionEnd закрывает соединение по причине завершения подписки
+func (c *connection) closeOnSubscriptionEnd(ctx context.Context) {
+	err := c.sub.Err()
+	switch {
+	case errors.Is(err, bookingevents.ErrSlowConsumer):
+		// Клиент не успевает читать события: переподключившись, он получит актуальный снимок
+		c.handler.logger.WarnContext(ctx, "%s - Slow consumer disconnected: company_id=%d, user_id=%d",
+			logPrefix, c.companyID, c.userID)
+		c.close(websocket.CloseTryAgainLater, closeSlowClient)
+
+	default:
+		c.close(websocket.CloseGoingAway, closeShutdown)
+	}
+}
+
+// write отправляет сообщение с таймаутом записи
+func (c *connection) write(msg *ServerMessage) error {
+	if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(msg)
+}
+
+// close отправляет клиенту кадр закрытия с кодом и причиной
+func (c *connection) close(code int, reason string) {
+	deadline := time.Now().Add(writeWait)
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline)
+}
+
+// mapError логирует ошибку получения снимка и возвращает ошибку API по каталогу
+func (h *Handler) mapError(ctx context.Context, err error, companyID, userID int64) *apierror.Error {
+	return handlers.ServiceError(ctx, h.logger, fmt.Sprintf("%s - Failed to get snapshot: company_id=%d, user_id=%d",
+		logPrefix, companyID, userID), err)
+}
+
+// checkOrigin проверяет Origin запроса на установку соединения
+// Без Origin (не браузерный клиент) соединение разрешено
+func (h *Handler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if len(h.config.AllowedOrigins) == 0 {
+		u, err := url.Parse(origin)
+		return err == nil && u.Host == r.Host
+	}
+
+	for _, allowed := range h.config.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// today текущая дата (UTC, без времени)
+func today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,163 @@
+package stream_company_bookings
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+// Типы сообщений сервера
+const (
+	MessageSnapshot = "snapshot" // Бронирования на дату (при подключении, смене фильтра, после пропуска событий)
+	MessageError    = "error"    // Ошибка обработки сообщения клиента
+	// Событие бронирования: "booking." + тип события (created, cancelled, status_changed, reassigned)
+	messageBookingPrefix = "booking."
+)
+
+// Типы сообщений клиента
+const (
+	ClientMessageSubscribe = "subscribe" // Сменить фильтр адресов и дату снимка
+)
+
+// maxAddressFilter максимальное количество адресов в фильтре
+const maxAddressFilter = 100
+
+const (
+	msgInvalidAddressID = "некорректный ID адреса"
+	msgInvalidDate      = "некорректный формат даты, ожидается YYYY-MM-DD"
+	msgTooManyAddresses = "слишком много адресов в фильтре, максимум 100" // См. maxAddressFilter
+	msgUnknownMessage   = "неизвестный тип сообщения"
+)
+
+// errInvalidFilter некорректный фильтр потока (детали - в ошибке поля)
+var errInvalidFilter = errors.New("invalid filter")
+
+// ServerMessage сообщение сервера
+type ServerMessage struct {
+	Type     string                          `json:"type"`
+	Snapshot *models.CompanySnapshotResponse `json:"snapshot,omitempty"`
+	Booking  *models.BookingResponse         `json:"booking,omitempty"`
+	Error    *apierror.Problem               `json:"error,omitempty"`
+}
+
+// ClientMessage сообщение клиента
+type ClientMessage struct {
+	Type       string  `json:"type"`
+	AddressIDs []int64 `json:"addressIds"` // Пусто - все адреса компании
+	Date       *string `json:"date"`       // YYYY-MM-DD, не указана - текущая дата снимка
+}
+
+// Filter фильтр потока: адреса событий и снимка, дата снимка
+type Filter struct {
+	AddressIDs []int64
+	Date       time.Time
+}
+
+// Matches проверяет, относится ли бронирование к адресам фильтра
+// События передаются за любые даты: новое бронирование на завтра тоже интересно менеджеру
+func (f Filter) Matches(b *domain.Booking) bool {
+	if len(f.AddressIDs) == 0 {
+		return true
+	}
+	for _, id := range f.AddressIDs {
+		if id == b.AddressID {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFilter разбирает фильтр из query параметров: addressId (можно несколько), date (по умолчанию сегодня)
+func ParseFilter(query url.Values, today time.Time) (Filter, error) {
+	filter := Filter{Date: today}
+
+	for _, raw := range query["addressId"] {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || id <= 0 {
+			return filter, domain.InvalidField(fmt.Errorf("%w: addressId %q", errInvalidFilter, raw), "addressId", msgInvalidAddressID)
+		}
+		filter.AddressIDs = append(filter.AddressIDs, id)
+	}
+
+	if raw := query.Get("date"); raw != "" {
+		date, err := time.Parse(domain.DateFormat, raw)
+		if err != nil {
+			return filter, domain.InvalidField(fmt.Errorf("%w: date %q", errInvalidFilter, raw), "date", msgInvalidDate)
+		}
+		filter.Date = date
+	}
+
+	return filter, filter.validate("addressId")
+}
+
+// Apply возвращает фильтр, измененный сообщением subscribe
+func (m *ClientMessage) Apply(current Filter) (Filter, error) {
+	if m.Type != ClientMessageSubscribe {
+		return current, domain.InvalidField(fmt.Errorf("%w: message type %q", errInvalidFilter, m.Type), "type", msgUnknownMessage)
+	}
+
+	filter := Filter{AddressIDs: m.AddressIDs, Date: current.Date}
+	for _, id := range filter.AddressIDs {
+		if id <= 0 {
+			return current, domain.InvalidField(fmt.Errorf("%w: addressId %d", errInvalidFilter, id), "addressIds", msgInvalidAddressID)
+		}
+	}
+
+	if m.Date != nil {
+		date, err := time.Parse(domain.DateFormat, *m.Date)
+		if err != nil {
+			return current, domain.InvalidField(fmt.Errorf("%w: date %q", errInvalidFilter, *m.Date), "date", msgInvalidDate)
+		}
+		filter.Date = date
+	}
+
+	return filter, filter.validate("addressIds")
+}
+
+// validate проверяет размер фильтра, field - имя поля адресов в запросе
+func (f Filter) validate(field string) error {
+	if len(f.AddressIDs) > maxAddressFilter {
+		return domain.InvalidField(fmt.Errorf("%w: too many addresses", errInvalidFilter), field, msgTooManyAddresses)
+	}
+	return nil
+}
+
+// ToServiceRequest формирует запрос снимка
+func (f Filter) ToServiceRequest(companyID, userID int64, limit int) *models.GetCompanySnapshotRequest {
+	return &models.GetCompanySnapshotRequest{
+		UserID:     userID,
+		CompanyID:  companyID,
+		AddressIDs: f.AddressIDs,
+		Date:       f.Date,
+		Limit:      limit,
+	}
+}
+
+// FromBookingEvent конвертирует событие бронирования в сообщение
+func FromBookingEvent(event domain.BookingEvent) *ServerMessage {
+	return &ServerMessage{
+		Type:    messageBookingPrefix + string(event.Type),
+		Booking: models.FromDomainBooking(event.Booking),
+	}
+}
+
+// SnapshotMessage сообщение со снимком
+func SnapshotMessage(snapshot *models.CompanySnapshotResponse) *ServerMessage {
+	return &ServerMessage{Type: MessageSnapshot, Snapshot: snapshot}
+}
+
+// ErrorMessage сообщение об ошибке на языке l (тело как у ответов API в формате problem+json)
+func ErrorMessage(e *apierror.Error, l i18n.Locale) *ServerMessage {
+	problem := e.Localize(l).Problem()
+	return &ServerMessage{
+		Type:  MessageError,
+		Error: &problem,
+	}
+}
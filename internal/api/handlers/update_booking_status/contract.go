@@ -0,0 +1,17 @@
+package update_booking_status
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+type BookingService interface {
+	UpdateStatus(ctx context.Context, bookingID int64, req *models.UpdateStatusRequest) error
+}
+
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
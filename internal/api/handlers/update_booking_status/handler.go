@@ -0,0 +1,73 @@
+package update_booking_status
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+)
+
+const (
+	msgInvalidBookingID   = "некорректный ID бронирования"
+	msgInvalidRequestBody = "некорректное тело запроса"
+	msgMissingUserID      = "отсутствует ID пользователя"
+)
+
+type Handler struct {
+	service BookingService
+	logger  Logger
+}
+
+func NewHandler(service BookingService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle PATCH /api/v1/bookings/{bookingId}/status
+// Доступно менеджерам и операторам компании, администраторам платформы
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	// Извлекаем bookingId из URL
+	vars := mux.Vars(r)
+	bookingIDStr := vars["bookingId"]
+
+	bookingID, err := strconv.ParseInt(bookingIDStr, 10, 64)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "PATCH /bookings/{id}/status - Invalid booking ID: %v", err)
+		handlers.RespondFieldError(w, "bookingId", msgInvalidBookingID)
+		return
+	}
+
+	// Получаем userID из контекста (через middleware Auth)
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		h.logger.WarnContext(r.Context(), "PATCH /bookings/{id}/status - Missing user ID")
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	// Декодируем body
+	var req UpdateBookingStatusRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "PATCH /bookings/{id}/status - Invalid request body: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	// Обновляем статус (сервис сам проверит права доступа)
+	err = h.service.UpdateStatus(r.Context(), bookingID, req.ToServiceRequest(userID))
+	if err != nil {
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("PATCH /bookings/{id}/status - Failed to update status: booking_id=%d", bookingID), err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "PATCH /bookings/{id}/status - Status updated successfully: booking_id=%d, status=%s, user_id=%d",
+		bookingID, req.Status, userID)
+	handlers.RespondJSON(w, http.StatusOK, nil)
+}
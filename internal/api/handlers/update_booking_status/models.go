@@ -0,0 +1,19 @@
+package update_booking_status
+
+import (
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+// UpdateBookingStatusRequest HTTP request model
+type UpdateBookingStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// ToServiceRequest конвертирует HTTP request в модель сервиса
+// userID - ID авторизованного пользователя
+func (r *UpdateBookingStatusRequest) ToServiceRequest(userID int64) *models.UpdateStatusRequest {
+	return &models.UpdateStatusRequest{
+		UserID: userID,
+		Status: r.Status,
+	}
+}
@@ -9,10 +9,11 @@ import (
 type ConfigService interface {
 	GetWithHierarchy(ctx context.Context, req *models.GetConfigRequest) (*models.ConfigResponse, error)
 	Update(ctx context.Context, id int64, req *models.UpdateConfigRequest) (*models.ConfigResponse, error)
+	PreviewUpdate(ctx context.Context, id int64, req *models.UpdateConfigRequest) (*models.ConfigImpactResponse, error)
 }
 
 type Logger interface {
-	Info(format string, v ...interface{})
-	Warn(format string, v ...interface{})
-	Error(format string, v ...interface{})
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
 }
@@ -1,22 +1,19 @@
 package update_company_config
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"github.com/m04kA/SMC-BookingService/internal/api/handlers"
-	"github.com/m04kA/SMC-BookingService/internal/service/config"
 )
 
 const (
+	msgInvalidBool        = "ожидается true или false"
 	msgInvalidCompanyID   = "некорректный ID компании"
 	msgInvalidRequestBody = "некорректное тело запроса"
-	msgNotFound           = "конфигурация не найдена"
-	msgForbidden          = "доступ запрещен"
-	msgInvalidData        = "некорректные данные конфигурации"
 )
 
 type Handler struct {
@@ -32,6 +29,8 @@ func NewHandler(service ConfigService, logger Logger) *Handler {
 }
 
 // Handle PUT /api/v1/companies/{companyId}/config
+// Query params: dryRun (опционально) - только оценить влияние на бронирования без сохранения,
+// force (опционально) - применить изменения несмотря на конфликты
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	// Извлекаем companyId из URL
 	vars := mux.Vars(r)
@@ -39,16 +38,38 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
 	if err != nil {
-		h.logger.Warn("PUT /companies/{id}/config - Invalid company ID: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		h.logger.WarnContext(r.Context(), "PUT /companies/{id}/config - Invalid company ID: %v", err)
+		handlers.RespondFieldError(w, "companyId", msgInvalidCompanyID)
+		return
+	}
+
+	// Получаем опциональные query параметры
+	dryRun, err := handlers.ParseBoolQuery(r.URL.Query().Get("dryRun"))
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "PUT /companies/{id}/config - Invalid dryRun value: %v", err)
+		handlers.RespondFieldError(w, "dryRun", msgInvalidBool)
+		return
+	}
+	force, err := handlers.ParseBoolQuery(r.URL.Query().Get("force"))
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "PUT /companies/{id}/config - Invalid force value: %v", err)
+		handlers.RespondFieldError(w, "force", msgInvalidBool)
 		return
 	}
 
 	// Декодируем body
 	var req UpdateCompanyConfigRequest
 	if err := handlers.DecodeJSON(r, &req); err != nil {
-		h.logger.Warn("PUT /companies/{id}/config - Invalid request body: %v", err)
-		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		h.logger.WarnContext(r.Context(), "PUT /companies/{id}/config - Invalid request body: %v", err)
+		handlers.RespondInvalidBody(w, msgInvalidRequestBody, err)
+		return
+	}
+
+	// Определяем пользователя по авторизации (userId в теле должен совпадать с ней)
+	userID, err := handlers.ResolveUserID(r.Context(), req.UserID)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "PUT /companies/{id}/config - Invalid user identity: company_id=%d, error=%v", companyID, err)
+		handlers.RespondProblem(w, handlers.MapError(err))
 		return
 	}
 
@@ -56,50 +77,41 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	getReq := ToGetConfigRequest(companyID, req.AddressID, req.ServiceID)
 	existingConfig, err := h.service.GetWithHierarchy(r.Context(), getReq)
 	if err != nil {
-		if errors.Is(err, config.ErrConfigNotFound) {
-			h.logger.Warn("PUT /companies/{id}/config - Config not found: company_id=%d, address_id=%v, service_id=%v",
-				companyID, req.AddressID, req.ServiceID)
-			handlers.RespondNotFound(w, msgNotFound)
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("PUT /companies/{id}/config - Failed to get config: company_id=%d, address_id=%v, service_id=%v",
+				companyID, req.AddressID, req.ServiceID), err)
+		return
+	}
+
+	// Конвертируем в модель сервиса для обновления
+	updateReq := req.ToServiceRequest(userID, force)
+
+	// Режим dry-run: только оцениваем влияние изменений на бронирования
+	if dryRun {
+		impact, err := h.service.PreviewUpdate(r.Context(), existingConfig.ID, updateReq)
+		if err != nil {
+			handlers.RespondServiceError(r.Context(), w, h.logger,
+				fmt.Sprintf("PUT /companies/{id}/config - Failed to preview update: company_id=%d, config_id=%d",
+					companyID, existingConfig.ID), err)
 			return
 		}
 
-		h.logger.Error("PUT /companies/{id}/config - Failed to get config: company_id=%d, error=%v",
-			companyID, err)
-		handlers.RespondInternalError(w)
+		h.logger.InfoContext(r.Context(), "PUT /companies/{id}/config - Dry-run completed: company_id=%d, config_id=%d, conflicts=%d",
+			companyID, existingConfig.ID, len(impact.ConflictingBookings))
+		handlers.RespondJSON(w, http.StatusOK, impact)
 		return
 	}
 
-	// Конвертируем в модель сервиса для обновления
-	updateReq := req.ToServiceRequest()
-
 	// Обновляем конфигурацию (сервис сам проверит права менеджера)
 	result, err := h.service.Update(r.Context(), existingConfig.ID, updateReq)
 	if err != nil {
-		switch {
-		case errors.Is(err, config.ErrConfigNotFound):
-			h.logger.Warn("PUT /companies/{id}/config - Config not found during update: config_id=%d",
-				existingConfig.ID)
-			handlers.RespondNotFound(w, msgNotFound)
-
-		case errors.Is(err, config.ErrAccessDenied):
-			h.logger.Warn("PUT /companies/{id}/config - Access denied: company_id=%d, user_id=%d",
-				companyID, req.UserID)
-			handlers.RespondForbidden(w, msgForbidden)
-
-		case errors.Is(err, config.ErrInvalidInput):
-			h.logger.Warn("PUT /companies/{id}/config - Invalid data: company_id=%d, error=%v",
-				companyID, err)
-			handlers.RespondBadRequest(w, msgInvalidData)
-
-		default:
-			h.logger.Error("PUT /companies/{id}/config - Failed to update config: company_id=%d, error=%v",
-				companyID, err)
-			handlers.RespondInternalError(w)
-		}
+		handlers.RespondServiceError(r.Context(), w, h.logger,
+			fmt.Sprintf("PUT /companies/{id}/config - Failed to update config: company_id=%d, config_id=%d",
+				companyID, existingConfig.ID), err)
 		return
 	}
 
-	h.logger.Info("PUT /companies/{id}/config - Config updated successfully: company_id=%d, config_id=%d",
+	h.logger.InfoContext(r.Context(), "PUT /companies/{id}/config - Config updated successfully: company_id=%d, config_id=%d",
 		companyID, result.ID)
 	handlers.RespondJSON(w, http.StatusOK, result)
 }
@@ -5,8 +5,9 @@ import (
 )
 
 // UpdateCompanyConfigRequest HTTP request model
+// UserID опционален: пользователь определяется по авторизации, при указании должен совпадать с ней
 type UpdateCompanyConfigRequest struct {
-	UserID                  int64  `json:"userId"`
+	UserID                  int64  `json:"userId,omitempty"`
 	AddressID               *int64 `json:"addressId,omitempty"`
 	ServiceID               *int64 `json:"serviceId,omitempty"`
 	SlotDurationMinutes     *int   `json:"slotDurationMinutes,omitempty"`
@@ -16,13 +17,16 @@ type UpdateCompanyConfigRequest struct {
 }
 
 // ToServiceRequest конвертирует HTTP request в модель сервиса
-func (r *UpdateCompanyConfigRequest) ToServiceRequest() *models.UpdateConfigRequest {
+// userID - ID авторизованного пользователя
+// force - применить изменения несмотря на конфликты с существующими бронированиями
+func (r *UpdateCompanyConfigRequest) ToServiceRequest(userID int64, force bool) *models.UpdateConfigRequest {
 	return &models.UpdateConfigRequest{
-		UserID:                  r.UserID,
+		UserID:                  userID,
 		SlotDurationMinutes:     r.SlotDurationMinutes,
 		MaxConcurrentBookings:   r.MaxConcurrentBookings,
 		AdvanceBookingDays:      r.AdvanceBookingDays,
 		MinBookingNoticeMinutes: r.MinBookingNoticeMinutes,
+		Force:                   force,
 	}
 }
 
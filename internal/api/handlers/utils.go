@@ -3,13 +3,9 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
-)
 
-// ErrorResponse структура для ответа с ошибкой
-type ErrorResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+)
 
 // RespondJSON отправляет JSON ответ
 func RespondJSON(w http.ResponseWriter, status int, payload interface{}) {
@@ -20,12 +16,9 @@ func RespondJSON(w http.ResponseWriter, status int, payload interface{}) {
 	}
 }
 
-// RespondError отправляет ошибку в формате JSON
-func RespondError(w http.ResponseWriter, status int, message string) {
-	RespondJSON(w, status, ErrorResponse{
-		Code:    status,
-		Message: message,
-	})
+// RespondProblem отправляет ошибку в формате application/problem+json (RFC 7807)
+func RespondProblem(w http.ResponseWriter, e *apierror.Error) {
+	apierror.Write(w, e)
 }
 
 // DecodeJSON парсит JSON из request body
@@ -33,27 +26,27 @@ func DecodeJSON(r *http.Request, v interface{}) error {
 	return json.NewDecoder(r.Body).Decode(v)
 }
 
-// RespondBadRequest отправляет ошибку 400
+// RespondBadRequest отправляет ошибку 400 BAD_REQUEST
 func RespondBadRequest(w http.ResponseWriter, message string) {
-	RespondError(w, http.StatusBadRequest, message)
+	RespondProblem(w, apierror.New(http.StatusBadRequest, apierror.CodeBadRequest, message))
 }
 
-// RespondUnauthorized отправляет ошибку 401
+// RespondUnauthorized отправляет ошибку 401 UNAUTHENTICATED
 func RespondUnauthorized(w http.ResponseWriter, message string) {
-	RespondError(w, http.StatusUnauthorized, message)
+	RespondProblem(w, apierror.New(http.StatusUnauthorized, apierror.CodeUnauthenticated, message))
 }
 
-// RespondForbidden отправляет ошибку 403
+// RespondForbidden отправляет ошибку 403 ACCESS_DENIED
 func RespondForbidden(w http.ResponseWriter, message string) {
-	RespondError(w, http.StatusForbidden, message)
+	RespondProblem(w, apierror.New(http.StatusForbidden, apierror.CodeAccessDenied, message))
 }
 
-// RespondNotFound отправляет ошибку 404
+// RespondNotFound отправляет ошибку 404 NOT_FOUND
 func RespondNotFound(w http.ResponseWriter, message string) {
-	RespondError(w, http.StatusNotFound, message)
+	RespondProblem(w, apierror.New(http.StatusNotFound, apierror.CodeNotFound, message))
 }
 
-// RespondInternalError отправляет ошибку 500
+// RespondInternalError отправляет ошибку 500 INTERNAL_ERROR
 func RespondInternalError(w http.ResponseWriter) {
-	RespondError(w, http.StatusInternalServerError, "internal server error")
+	RespondProblem(w, apierror.Internal())
 }
@@ -0,0 +1,92 @@
+// Package i18n язык ответов API: выбор языка по Accept-Language и общие переводы
+//
+// Исходные сообщения сервиса написаны на русском (Source), переводы на другие языки
+// хранятся в каталогах рядом с местом использования (apierror, выгрузки, календарь).
+package i18n
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Locale язык ответа (основной подтег языкового тега: ru, en, kk)
+type Locale string
+
+// Поддерживаемые языки
+const (
+	RU Locale = "ru"
+	EN Locale = "en"
+	KK Locale = "kk"
+)
+
+// Source язык исходных сообщений (константы msg* в обработчиках и сервисах)
+const Source = RU
+
+var supported = map[Locale]bool{
+	RU: true,
+	EN: true,
+	KK: true,
+}
+
+// Text сообщение на поддерживаемых языках
+type Text map[Locale]string
+
+// In возвращает сообщение на языке l
+func (t Text) In(l Locale) (string, bool) {
+	value, ok := t[l]
+	return value, ok && value != ""
+}
+
+// Parse разбирает языковой тег (ru, en-US, kk-KZ) в поддерживаемый язык
+func Parse(tag string) (Locale, bool) {
+	primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	l := Locale(strings.ToLower(primary))
+	return l, supported[l]
+}
+
+// Negotiate выбирает язык по заголовку Accept-Language: поддерживаемый язык с наибольшим весом q
+// При равных весах побеждает указанный раньше, без подходящего языка возвращается fallback
+func Negotiate(acceptLanguage string, fallback Locale) Locale {
+	best, bestQ := fallback, 0.0
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		l, ok := Parse(tag)
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				parsed, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					parsed = 0
+				}
+				q = parsed
+			}
+		}
+
+		if q > bestQ {
+			best, bestQ = l, q
+		}
+	}
+
+	return best
+}
+
+type contextKey struct{}
+
+// WithLocale сохраняет язык ответа в контекст
+func WithLocale(ctx context.Context, l Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext возвращает язык ответа из контекста (по умолчанию Source)
+func FromContext(ctx context.Context) Locale {
+	if l, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return l
+	}
+	return Source
+}
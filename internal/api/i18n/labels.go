@@ -0,0 +1,46 @@
+package i18n
+
+import "time"
+
+// statusNames названия статусов бронирований (выгрузки, календарь)
+var statusNames = map[string]Text{
+	"pending":              {RU: "Ожидает подтверждения", EN: "Pending", KK: "Растауды күтуде"},
+	"confirmed":            {RU: "Подтверждено", EN: "Confirmed", KK: "Расталды"},
+	"in_progress":          {RU: "Выполняется", EN: "In progress", KK: "Орындалуда"},
+	"completed":            {RU: "Выполнено", EN: "Completed", KK: "Орындалды"},
+	"cancelled_by_user":    {RU: "Отменено клиентом", EN: "Cancelled by customer", KK: "Клиент бас тартты"},
+	"cancelled_by_company": {RU: "Отменено компанией", EN: "Cancelled by company", KK: "Компания бас тартты"},
+	"no_show":              {RU: "Неявка", EN: "No-show", KK: "Келмеді"},
+}
+
+// StatusName возвращает название статуса бронирования на языке l (неизвестный статус - как есть)
+func StatusName(l Locale, status string) string {
+	if name, ok := statusNames[status].In(l); ok {
+		return name
+	}
+	if name, ok := statusNames[status].In(Source); ok {
+		return name
+	}
+	return status
+}
+
+// dateLayouts форматы дат, принятые в языке
+var dateLayouts = map[Locale]string{
+	RU: "02.01.2006",
+	EN: "2006-01-02",
+	KK: "02.01.2006",
+}
+
+// FormatDate форматирует дату в формате языка l
+func FormatDate(l Locale, t time.Time) string {
+	layout, ok := dateLayouts[l]
+	if !ok {
+		layout = dateLayouts[Source]
+	}
+	return t.Format(layout)
+}
+
+// FormatDateTime форматирует дату и время (с секундами) в формате языка l
+func FormatDateTime(l Locale, t time.Time) string {
+	return FormatDate(l, t) + t.Format(" 15:04:05")
+}
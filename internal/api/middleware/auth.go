@@ -2,39 +2,78 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
-	"strconv"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/auth"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
+	"github.com/m04kA/SMC-BookingService/pkg/logger"
 )
 
 type contextKey string
 
+const (
+	msgMissingCredentials = "отсутствуют учетные данные"
+	msgInvalidCredentials = "некорректные учетные данные"
+	msgTokenExpired       = "срок действия токена истек"
+	msgForbidden          = "доступ запрещен"
+)
+
 const (
 	UserIDKey   contextKey = "user_id"
 	UserRoleKey contextKey = "user_role"
 )
 
-// Auth извлекает заголовки аутентификации и сохраняет их в контекст
-func Auth(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userIDStr := r.Header.Get("X-User-ID")
-		// userRole := r.Header.Get("X-User-Role")
+// Authenticator проверяет учетные данные запроса
+type Authenticator interface {
+	Authenticate(r *http.Request) (*auth.Identity, error)
+}
 
-		if userIDStr == "" { // || userRole == ""
-			http.Error(w, "missing authentication headers", http.StatusUnauthorized)
-			return
-		}
+// Logger интерфейс логгера middleware
+type Logger interface {
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
 
-		userID, err := strconv.ParseInt(userIDStr, 10, 64)
-		if err != nil {
-			http.Error(w, "invalid user ID", http.StatusBadRequest)
-			return
-		}
+// Auth проверяет учетные данные запроса и сохраняет пользователя и роль в контекст
+// Роль также передается сервисам через policy.WithActor для проверки прав доступа
+func Auth(authenticator Authenticator, log Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, err := authenticator.Authenticate(r)
+			if err != nil {
+				log.WarnContext(r.Context(), "Auth: %s %s - authentication failed: %v", r.Method, r.URL.Path, err)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="bookingservice"`)
+				apierror.Write(w, AuthError(err))
+				return
+			}
 
-		ctx := context.WithValue(r.Context(), UserIDKey, userID)
-		// ctx = context.WithValue(ctx, UserRoleKey, userRole)
+			next.ServeHTTP(w, r.WithContext(WithIdentity(r.Context(), identity)))
+		})
+	}
+}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// AuthError ошибка API для неудачной аутентификации (используется и gRPC транспортом)
+func AuthError(err error) *apierror.Error {
+	switch {
+	case errors.Is(err, auth.ErrMissingCredentials):
+		return apierror.New(http.StatusUnauthorized, apierror.CodeUnauthenticated, msgMissingCredentials)
+	case errors.Is(err, auth.ErrTokenExpired):
+		return apierror.New(http.StatusUnauthorized, apierror.CodeTokenExpired, msgTokenExpired)
+	default:
+		return apierror.New(http.StatusUnauthorized, apierror.CodeUnauthenticated, msgInvalidCredentials)
+	}
+}
+
+// WithIdentity сохраняет аутентифицированного пользователя и роль в контекст и в поля логов
+// (используется и HTTP, и gRPC транспортом, чтобы сервисы получали одинаковый контекст)
+func WithIdentity(ctx context.Context, identity *auth.Identity) context.Context {
+	role := policy.ParseRole(identity.Role)
+	ctx = context.WithValue(ctx, UserIDKey, identity.UserID)
+	ctx = context.WithValue(ctx, UserRoleKey, string(role))
+	ctx = logger.WithUserID(ctx, identity.UserID)
+	return policy.WithActor(ctx, policy.Actor{UserID: identity.UserID, Role: role})
 }
 
 // GetUserID извлекает user ID из контекста
@@ -48,3 +87,26 @@ func GetUserRole(ctx context.Context) (string, bool) {
 	userRole, ok := ctx.Value(UserRoleKey).(string)
 	return userRole, ok
 }
+
+// IsAdmin проверяет, что авторизованный пользователь - администратор платформы
+func IsAdmin(ctx context.Context) bool {
+	userRole, ok := GetUserRole(ctx)
+	return ok && policy.Role(userRole) == policy.RolePlatformAdmin
+}
+
+// RequireRole пропускает только пользователей с одной из указанных ролей
+// Должен подключаться после Auth
+func RequireRole(roles ...policy.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userRole, _ := GetUserRole(r.Context())
+			for _, role := range roles {
+				if policy.Role(userRole) == role {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			apierror.Write(w, apierror.New(http.StatusForbidden, apierror.CodeAccessDenied, msgForbidden))
+		})
+	}
+}
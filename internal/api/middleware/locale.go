@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/i18n"
+)
+
+// Locale выбирает язык ответа по заголовку Accept-Language (без подходящего языка - defaultLocale)
+// Язык сохраняется в контекст и в заголовок Content-Language: по нему ошибки API
+// переводятся при отправке (apierror.Write), поэтому middleware подключается первым
+func Locale(defaultLocale i18n.Locale) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			locale := i18n.Negotiate(r.Header.Get("Accept-Language"), defaultLocale)
+
+			w.Header().Set("Content-Language", string(locale))
+			w.Header().Add("Vary", "Accept-Language")
+
+			next.ServeHTTP(w, r.WithContext(i18n.WithLocale(r.Context(), locale)))
+		})
+	}
+}
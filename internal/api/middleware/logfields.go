@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/m04kA/SMC-BookingService/pkg/logger"
+)
+
+// LogFields добавляет ID компании и бронирования из пути запроса в поля логов контекста
+// Подключается к роутеру с маршрутами: переменные пути известны после сопоставления маршрута
+func LogFields() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			vars := mux.Vars(r)
+
+			if id, err := strconv.ParseInt(vars["companyId"], 10, 64); err == nil {
+				ctx = logger.WithCompanyID(ctx, id)
+			}
+			if id, err := strconv.ParseInt(vars["bookingId"], 10, 64); err == nil {
+				ctx = logger.WithBookingID(ctx, id)
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
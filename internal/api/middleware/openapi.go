@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/openapi"
+)
+
+// ValidateRequest проверяет параметры и тело запроса по спецификации OpenAPI
+// Несоответствие возвращается как 400 с ошибками полей, запросы вне спецификации пропускаются
+func ValidateRequest(validator *openapi.Validator, log Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, ok := validator.FindRoute(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := validator.ValidateRequest(r, route); err != nil {
+				log.WarnContext(r.Context(), "%s %s - Request does not match OpenAPI spec: %v", r.Method, r.URL.Path, err)
+				apierror.Write(w, openapi.RequestError(err))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ValidateResponse проверяет JSON ответы обработчиков по спецификации OpenAPI (режим для тестов)
+// Ответ, расходящийся со спецификацией, заменяется ошибкой 500 RESPONSE_VALIDATION_FAILED с причиной.
+// Потоковые ответы (SSE, WebSocket, выгрузки, календари) передаются без буферизации и не проверяются.
+func ValidateResponse(validator *openapi.Validator, log Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, ok := validator.FindRoute(r)
+			if !ok {
+				log.WarnContext(r.Context(), "%s %s - Route is not described in OpenAPI spec", r.Method, r.URL.Path)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedResponse{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			if rec.passthrough {
+				return
+			}
+			if !rec.wroteHeader {
+				rec.status = http.StatusOK
+			}
+
+			err := validator.ValidateResponse(r.Context(), r, route, rec.status, w.Header(), rec.body.Bytes())
+			if err != nil {
+				log.ErrorContext(r.Context(), "%s %s - Response does not match OpenAPI spec: status=%d, error=%v", r.Method, r.URL.Path, rec.status, err)
+				apierror.Write(w, apierror.New(http.StatusInternalServerError, apierror.CodeResponseValidationFailed,
+					fmt.Sprintf("response %d does not match OpenAPI spec: %v", rec.status, err)))
+				return
+			}
+
+			w.WriteHeader(rec.status)
+			w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+// bufferedResponse буферизует JSON ответ до проверки
+// Ответ другого типа (и соединение после Hijack) передается клиенту напрямую
+type bufferedResponse struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	passthrough bool
+	body        bytes.Buffer
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.wroteHeader {
+		return
+	}
+	b.wroteHeader = true
+	b.status = status
+
+	if !isJSON(b.Header().Get("Content-Type")) {
+		b.passthrough = true
+		b.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	if !b.wroteHeader {
+		b.WriteHeader(http.StatusOK)
+	}
+	if b.passthrough {
+		return b.ResponseWriter.Write(p)
+	}
+	return b.body.Write(p)
+}
+
+// Flush нужен потоковым ответам (SSE), буферизованный ответ отправляется после проверки
+func (b *bufferedResponse) Flush() {
+	if !b.passthrough {
+		return
+	}
+	if flusher, ok := b.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack нужен для WebSocket соединений
+func (b *bufferedResponse) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := b.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	b.passthrough = true
+	return hijacker.Hijack()
+}
+
+// Unwrap возвращает исходный ResponseWriter (http.ResponseController)
+func (b *bufferedResponse) Unwrap() http.ResponseWriter {
+	return b.ResponseWriter
+}
+
+// isJSON проверяет, что тип ответа - JSON (application/json, application/problem+json)
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || mediaType == apierror.ContentType
+}
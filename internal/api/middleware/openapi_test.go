@@ -0,0 +1,176 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers/create_booking"
+	"github.com/m04kA/SMC-BookingService/internal/api/handlers/get_booking"
+	"github.com/m04kA/SMC-BookingService/internal/api/middleware"
+	"github.com/m04kA/SMC-BookingService/internal/api/openapi"
+	"github.com/m04kA/SMC-BookingService/internal/auth"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	createBooking "github.com/m04kA/SMC-BookingService/internal/usecase/create_booking"
+)
+
+// specPath спецификация API, по которой работает сервис
+const specPath = "../../../schemas/schema.yaml"
+
+type nopLogger struct{}
+
+func (nopLogger) InfoContext(context.Context, string, ...interface{})  {}
+func (nopLogger) WarnContext(context.Context, string, ...interface{})  {}
+func (nopLogger) ErrorContext(context.Context, string, ...interface{}) {}
+
+// fakeBookingService отдает заданное бронирование
+type fakeBookingService struct {
+	booking *models.BookingResponse
+}
+
+func (s *fakeBookingService) GetByID(_ context.Context, id int64, _ int64) (*models.BookingResponse, error) {
+	booking := *s.booking
+	booking.ID = id
+	return &booking, nil
+}
+
+// fakeCreateBooking считает вызовы: запрос, не прошедший проверку, не должен дойти до use case
+type fakeCreateBooking struct {
+	calls int
+}
+
+func (u *fakeCreateBooking) Execute(context.Context, *createBooking.Request) (*createBooking.Response, error) {
+	u.calls++
+	return nil, createBooking.ErrInternal
+}
+
+// newTestRouter собирает /api/v1 с проверкой запросов и ответов по спецификации, как в cmd/main.go
+func newTestRouter(t *testing.T, booking *models.BookingResponse, useCase *fakeCreateBooking) http.Handler {
+	t.Helper()
+
+	validator, err := openapi.Load(specPath)
+	require.NoError(t, err)
+
+	r := mux.NewRouter()
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := middleware.WithIdentity(r.Context(), &auth.Identity{UserID: 987654321})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	api.Use(middleware.ValidateRequest(validator, nopLogger{}))
+	api.Use(middleware.ValidateResponse(validator, nopLogger{}))
+
+	api.HandleFunc("/bookings/{bookingId}", get_booking.NewHandler(&fakeBookingService{booking: booking}, nopLogger{}).Handle).
+		Methods(http.MethodGet)
+	api.HandleFunc("/bookings", create_booking.NewHandler(useCase, nopLogger{}).Handle).
+		Methods(http.MethodPost)
+	return r
+}
+
+func validBooking() *models.BookingResponse {
+	return &models.BookingResponse{
+		UserID:          987654321,
+		CompanyID:       1,
+		AddressID:       100,
+		ServiceID:       10,
+		BookingDate:     "2025-10-15",
+		StartTime:       "10:00",
+		DurationMinutes: 60,
+		Status:          "confirmed",
+		ServiceName:     "Мойка",
+		ServicePrice:    1500,
+		CreatedAt:       time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+		UpdatedAt:       time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) apierror.Problem {
+	t.Helper()
+	var problem apierror.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	return problem
+}
+
+func TestOpenAPI_ValidResponse(t *testing.T) {
+	router := newTestRouter(t, validBooking(), &fakeCreateBooking{})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/bookings/12345", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var booking models.BookingResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &booking))
+	assert.Equal(t, int64(12345), booking.ID)
+}
+
+func TestOpenAPI_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		body      string
+		wantField string
+	}{
+		{"path parameter is not an integer", http.MethodGet, "/api/v1/bookings/abc", "", "bookingId"},
+		{"body field has wrong type", http.MethodPost, "/api/v1/bookings",
+			`{"companyId": "one", "addressId": 100, "serviceId": 10, "bookingDate": "2025-10-15", "startTime": "10:00"}`, "companyId"},
+		{"required body field is missing", http.MethodPost, "/api/v1/bookings",
+			`{"addressId": 100, "serviceId": 10, "bookingDate": "2025-10-15", "startTime": "10:00"}`, "companyId"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := &fakeCreateBooking{}
+			router := newTestRouter(t, validBooking(), useCase)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+			problem := decodeProblem(t, rec)
+			assert.Equal(t, apierror.CodeValidationFailed, problem.Code)
+			require.NotEmpty(t, problem.Errors)
+			assert.Equal(t, tt.wantField, problem.Errors[0].Field)
+			assert.Zero(t, useCase.calls, "запрос не должен дойти до обработчика")
+		})
+	}
+}
+
+func TestOpenAPI_ResponseDrift(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(b *models.BookingResponse)
+	}{
+		{"status outside enum", func(b *models.BookingResponse) { b.Status = "archived" }},
+		{"invalid date format", func(b *models.BookingResponse) { b.BookingDate = "15.10.2025" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := validBooking()
+			tt.mutate(booking)
+			router := newTestRouter(t, booking, &fakeCreateBooking{})
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/bookings/12345", nil))
+
+			require.Equal(t, http.StatusInternalServerError, rec.Code, rec.Body.String())
+			assert.Equal(t, apierror.CodeResponseValidationFailed, decodeProblem(t, rec).Code)
+		})
+	}
+}
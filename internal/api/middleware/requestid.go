@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
+)
+
+// RequestID принимает идентификатор запроса из заголовка X-Request-ID или генерирует новый
+// Идентификатор сохраняется в контекст (строки логов, вызовы UserService и SellerService)
+// и возвращается в заголовке X-Request-ID ответа и в теле ошибок (requestId)
+func RequestID() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(requestid.Header)
+			if !requestid.Valid(id) {
+				id = requestid.New()
+			}
+
+			w.Header().Set(requestid.Header, id)
+
+			next.ServeHTTP(w, r.WithContext(requestid.WithContext(r.Context(), id)))
+		})
+	}
+}
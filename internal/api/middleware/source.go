@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMC-BookingService/pkg/metrics"
+)
+
+// Source сохраняет источник запроса в контекст (метка source бизнес метрик)
+func Source(source string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(metrics.WithSource(r.Context(), source)))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
+)
+
+// Tracing создает спан входящего запроса: "GET /api/v1/companies/{companyId}/bookings"
+// Контекст трассы принимается из заголовка traceparent (W3C), спан продолжает трассу вызывающего.
+// Подключается к роутеру после RequestID: идентификатор запроса попадает в атрибуты спана.
+func Tracing(serviceName string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		annotated := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			span := trace.SpanFromContext(r.Context())
+			span.SetAttributes(attribute.String("http.route", routeTemplate(r)))
+			if id := requestid.FromContext(r.Context()); id != "" {
+				span.SetAttributes(attribute.String("request_id", id))
+			}
+			next.ServeHTTP(w, r)
+		})
+
+		return otelhttp.NewHandler(annotated, serviceName,
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return r.Method + " " + routeTemplate(r)
+			}),
+		)
+	}
+}
+
+// routeTemplate шаблон сопоставленного маршрута (без ID в пути), иначе путь запроса
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
+}
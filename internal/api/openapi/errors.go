@@ -0,0 +1,133 @@
+package openapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+
+	"github.com/m04kA/SMC-BookingService/internal/api/apierror"
+)
+
+const (
+	msgInvalidRequestBody = "некорректное тело запроса"
+	msgRequired           = "обязательное поле"
+	msgInvalidType        = "некорректный тип значения"
+	msgInvalidEnum        = "недопустимое значение"
+	msgOutOfRange         = "значение вне допустимого диапазона"
+	msgInvalidFormat      = "некорректный формат значения"
+	msgUnknownField       = "неизвестное поле"
+	msgInvalidValue       = "некорректное значение"
+)
+
+// bodyField имя поля для ошибки всего тела запроса (не объекта, пустого тела)
+const bodyField = "body"
+
+// RequestError ошибка API для несоответствия запроса спецификации
+// Ошибки параметров и полей тела возвращаются как 400 VALIDATION_FAILED с полями,
+// тело, которое не удалось разобрать, - как 400 BAD_REQUEST
+func RequestError(err error) *apierror.Error {
+	var fields []apierror.FieldError
+	malformed := false
+
+	var walk func(err error)
+	walk = func(err error) {
+		// RequestError раскрывается в MultiError ошибок схемы, поэтому проверяется первой
+		var reqErr *openapi3filter.RequestError
+		if !errors.As(err, &reqErr) {
+			var multi openapi3.MultiError
+			if errors.As(err, &multi) {
+				for _, inner := range multi {
+					walk(inner)
+				}
+			}
+			// Проверка учетных данных отключена (NoopAuthenticationFunc), других ошибок не ожидается
+			return
+		}
+
+		switch {
+		case reqErr.Parameter != nil:
+			fields = append(fields, apierror.Field(reqErr.Parameter.Name, parameterMessage(reqErr.Err)))
+
+		case reqErr.RequestBody != nil:
+			schemaFields := schemaErrors(reqErr.Err)
+			if errors.Is(reqErr.Err, openapi3filter.ErrInvalidRequired) {
+				schemaFields = append(schemaFields, apierror.Field(bodyField, msgRequired))
+			}
+			if len(schemaFields) == 0 {
+				malformed = true
+			}
+			fields = append(fields, schemaFields...)
+		}
+	}
+	walk(err)
+
+	if malformed && len(fields) == 0 {
+		return apierror.New(http.StatusBadRequest, apierror.CodeBadRequest, msgInvalidRequestBody)
+	}
+	return apierror.Validation(fields...)
+}
+
+// parameterMessage сообщение для ошибки параметра
+func parameterMessage(err error) string {
+	if errors.Is(err, openapi3filter.ErrInvalidRequired) || errors.Is(err, openapi3filter.ErrInvalidEmptyValue) {
+		return msgRequired
+	}
+
+	var parseErr *openapi3filter.ParseError
+	if errors.As(err, &parseErr) {
+		return msgInvalidType
+	}
+
+	if fields := schemaErrors(err); len(fields) > 0 {
+		return fields[0].Message
+	}
+	return msgInvalidValue
+}
+
+// schemaErrors собирает ошибки схемы с путями полей: car.id, addressIds.0
+func schemaErrors(err error) []apierror.FieldError {
+	var result []apierror.FieldError
+
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		for _, inner := range multi {
+			result = append(result, schemaErrors(inner)...)
+		}
+		return result
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return nil
+	}
+
+	field := strings.Join(schemaErr.JSONPointer(), ".")
+	if field == "" {
+		field = bodyField
+	}
+	return append(result, apierror.Field(field, schemaMessage(schemaErr.SchemaField)))
+}
+
+// schemaMessage сообщение для нарушенного ключевого слова схемы
+func schemaMessage(keyword string) string {
+	switch keyword {
+	case "required":
+		return msgRequired
+	case "type", "nullable":
+		return msgInvalidType
+	case "enum":
+		return msgInvalidEnum
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf",
+		"minLength", "maxLength", "minItems", "maxItems", "uniqueItems":
+		return msgOutOfRange
+	case "pattern", "format":
+		return msgInvalidFormat
+	case "properties", "additionalProperties":
+		return msgUnknownField
+	default:
+		return msgInvalidValue
+	}
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Chain перебирает аутентификаторы по порядку и возвращает первый успешный результат
+// Если учетные данные есть, но ни один аутентификатор их не принял, возвращается
+// ошибка первого аутентификатора, который их обнаружил
+type Chain struct {
+	authenticators []Authenticator
+}
+
+// NewChain создает цепочку аутентификаторов
+func NewChain(authenticators ...Authenticator) *Chain {
+	return &Chain{authenticators: authenticators}
+}
+
+// Authenticate аутентифицирует запрос первым подходящим аутентификатором
+func (c *Chain) Authenticate(r *http.Request) (*Identity, error) {
+	var firstErr error
+	for _, a := range c.authenticators {
+		identity, err := a.Authenticate(r)
+		if err == nil {
+			return identity, nil
+		}
+		if firstErr == nil && !errors.Is(err, ErrMissingCredentials) {
+			firstErr = err
+		}
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return nil, ErrMissingCredentials
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "net/http"
+
+// Identity аутентифицированный пользователь
+type Identity struct {
+	UserID int64  // Telegram ID пользователя
+	Role   string // Роль пользователя (пустая строка, если не передана)
+}
+
+// Authenticator извлекает и проверяет учетные данные запроса
+// Возвращает ErrMissingCredentials, если в запросе нет учетных данных этого типа,
+// и ErrInvalidCredentials/ErrTokenExpired, если они есть, но не прошли проверку
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
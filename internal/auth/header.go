@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// HeaderAuthenticator доверяет заголовкам X-User-ID и X-User-Role без проверки подписи
+// Только для локальной разработки: любой клиент может выдать себя за другого пользователя
+type HeaderAuthenticator struct{}
+
+// NewHeaderAuthenticator создает аутентификатор по заголовкам
+func NewHeaderAuthenticator() *HeaderAuthenticator {
+	return &HeaderAuthenticator{}
+}
+
+// Authenticate извлекает пользователя из заголовков X-User-ID и X-User-Role
+func (a *HeaderAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	userIDStr := r.Header.Get("X-User-ID")
+	if userIDStr == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, fmt.Errorf("%w: invalid X-User-ID header", ErrInvalidCredentials)
+	}
+
+	return &Identity{
+		UserID: userID,
+		Role:   r.Header.Get("X-User-Role"),
+	}, nil
+}
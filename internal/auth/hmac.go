@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// HMACAuthenticator проверяет токены API gateway, подписанные общим секретом (JWT HS256/HS384/HS512)
+type HMACAuthenticator struct {
+	verifier *tokenVerifier
+}
+
+// NewHMACAuthenticator создает аутентификатор HMAC-токенов
+func NewHMACAuthenticator(secret []byte, opts TokenOptions) *HMACAuthenticator {
+	keyFunc := func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}
+
+	methods := []string{
+		jwt.SigningMethodHS256.Alg(),
+		jwt.SigningMethodHS384.Alg(),
+		jwt.SigningMethodHS512.Alg(),
+	}
+
+	return &HMACAuthenticator{
+		verifier: newTokenVerifier(methods, keyFunc, opts),
+	}
+}
+
+// Authenticate проверяет Bearer токен из заголовка Authorization
+func (a *HMACAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	return a.verifier.authenticate(r)
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("test-secret")
+
+func testOptions() TokenOptions {
+	return TokenOptions{
+		Issuer:      "gateway",
+		Audience:    "booking",
+		UserIDClaim: "sub",
+		RoleClaim:   "role",
+	}
+}
+
+// validClaims claims токена, которые проходят проверку с testOptions
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":  "gateway",
+		"aud":  "booking",
+		"sub":  "123456789",
+		"role": "manager",
+		"exp":  time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, claims jwt.MapClaims, key interface{}, kid string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	raw, err := token.SignedString(key)
+	require.NoError(t, err)
+	return raw
+}
+
+func bearerRequest(token string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r
+}
+
+func TestHMACAuthenticator(t *testing.T) {
+	with := func(mutate func(jwt.MapClaims)) jwt.MapClaims {
+		claims := validClaims()
+		mutate(claims)
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   func(t *testing.T) string
+		opts    func(*TokenOptions)
+		want    *Identity
+		wantErr error
+	}{
+		{
+			name:  "valid token",
+			token: func(t *testing.T) string { return sign(t, jwt.SigningMethodHS256, validClaims(), testSecret, "") },
+			want:  &Identity{UserID: 123456789, Role: "manager"},
+		},
+		{
+			name: "numeric user id and no role",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS512, with(func(c jwt.MapClaims) {
+					c["sub"] = 42
+					delete(c, "role")
+				}), testSecret, "")
+			},
+			want: &Identity{UserID: 42},
+		},
+		{
+			name:    "no authorization header",
+			token:   func(*testing.T) string { return "" },
+			wantErr: ErrMissingCredentials,
+		},
+		{
+			name: "expired token",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) {
+					c["exp"] = time.Now().Add(-time.Minute).Unix()
+				}), testSecret, "")
+			},
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name: "expired token within leeway",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) {
+					c["exp"] = time.Now().Add(-time.Minute).Unix()
+				}), testSecret, "")
+			},
+			opts: func(o *TokenOptions) { o.Leeway = 2 * time.Minute },
+			want: &Identity{UserID: 123456789, Role: "manager"},
+		},
+		{
+			name: "token without exp",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) { delete(c, "exp") }), testSecret, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "token not yet valid",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) {
+					c["nbf"] = time.Now().Add(time.Hour).Unix()
+				}), testSecret, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name:    "wrong secret",
+			token:   func(t *testing.T) string { return sign(t, jwt.SigningMethodHS256, validClaims(), []byte("other"), "") },
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "unsigned token",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodNone, validClaims(), jwt.UnsafeAllowNoneSignatureType, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "wrong issuer",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) { c["iss"] = "other" }), testSecret, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "wrong audience",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) { c["aud"] = "other" }), testSecret, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "invalid user id",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) { c["sub"] = "-1" }), testSecret, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name: "role is not a string",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, with(func(c jwt.MapClaims) { c["role"] = 1 }), testSecret, "")
+			},
+			wantErr: ErrInvalidCredentials,
+		},
+		{
+			name:    "malformed token",
+			token:   func(*testing.T) string { return "not-a-jwt" },
+			wantErr: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testOptions()
+			if tt.opts != nil {
+				tt.opts(&opts)
+			}
+			a := NewHMACAuthenticator(testSecret, opts)
+
+			identity, err := a.Authenticate(bearerRequest(tt.token(t)))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, identity)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, identity)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTAuthenticator проверяет JWT, подписанные асимметричными ключами из JWKS файла (RS*, PS*, ES*)
+type JWTAuthenticator struct {
+	keys     []publicKey
+	verifier *tokenVerifier
+}
+
+// NewJWTAuthenticator создает аутентификатор JWT с ключами из локального JWKS файла
+// Файл читается один раз при создании, для ротации ключей нужен перезапуск сервиса
+func NewJWTAuthenticator(jwksFile string, opts TokenOptions) (*JWTAuthenticator, error) {
+	keys, err := loadJWKSFile(jwksFile)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &JWTAuthenticator{keys: keys}
+
+	methods := []string{
+		jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg(),
+		jwt.SigningMethodPS256.Alg(), jwt.SigningMethodPS384.Alg(), jwt.SigningMethodPS512.Alg(),
+		jwt.SigningMethodES256.Alg(), jwt.SigningMethodES384.Alg(), jwt.SigningMethodES512.Alg(),
+	}
+	a.verifier = newTokenVerifier(methods, a.keyFunc, opts)
+
+	return a, nil
+}
+
+// Authenticate проверяет Bearer токен из заголовка Authorization
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	return a.verifier.authenticate(r)
+}
+
+// keyFunc выбирает ключ проверки по kid из заголовка токена
+// Токен без kid допускается, только если в JWKS один ключ
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	alg := token.Method.Alg()
+
+	var key *publicKey
+	if kid == "" {
+		if len(a.keys) != 1 {
+			return nil, fmt.Errorf("token has no kid")
+		}
+		key = &a.keys[0]
+	} else {
+		for i := range a.keys {
+			if a.keys[i].kid == kid {
+				key = &a.keys[i]
+				break
+			}
+		}
+		if key == nil {
+			return nil, fmt.Errorf("unknown kid %q", kid)
+		}
+	}
+
+	if key.alg != "" && key.alg != alg {
+		return nil, fmt.Errorf("key %q does not allow algorithm %s", key.kid, alg)
+	}
+
+	// Ключ должен соответствовать семейству алгоритма
+	switch key.key.(type) {
+	case *rsa.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); ok {
+			return key.key, nil
+		}
+		if _, ok := token.Method.(*jwt.SigningMethodRSAPSS); ok {
+			return key.key, nil
+		}
+	case *ecdsa.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+			return key.key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key %q does not match algorithm %s", key.kid, alg)
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func rsaJWK(kid, alg string, key *rsa.PublicKey) jwk {
+	return jwk{Kty: "RSA", Kid: kid, Use: "sig", Alg: alg, N: encodeBigInt(key.N), E: encodeBigInt(big.NewInt(int64(key.E)))}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) jwk {
+	return jwk{Kty: "EC", Kid: kid, Crv: key.Curve.Params().Name, X: encodeBigInt(key.X), Y: encodeBigInt(key.Y)}
+}
+
+// writeJWKS сохраняет набор ключей во временный файл и возвращает путь
+func writeJWKS(t *testing.T, keys ...jwk) string {
+	t.Helper()
+	data, err := json.Marshal(jwkSet{Keys: keys})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	a, err := NewJWTAuthenticator(writeJWKS(t,
+		rsaJWK("rsa-1", "RS256", &rsaKey.PublicKey),
+		ecJWK("ec-1", &ecKey.PublicKey),
+	), testOptions())
+	require.NoError(t, err)
+
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "rsa token", token: sign(t, jwt.SigningMethodRS256, validClaims(), rsaKey, "rsa-1")},
+		{name: "ec token", token: sign(t, jwt.SigningMethodES256, validClaims(), ecKey, "ec-1")},
+		{name: "expired token", token: sign(t, jwt.SigningMethodRS256, expired, rsaKey, "rsa-1"), wantErr: ErrTokenExpired},
+		{name: "unknown kid", token: sign(t, jwt.SigningMethodRS256, validClaims(), rsaKey, "rsa-2"), wantErr: ErrInvalidCredentials},
+		{name: "no kid with several keys", token: sign(t, jwt.SigningMethodRS256, validClaims(), rsaKey, ""), wantErr: ErrInvalidCredentials},
+		{name: "signed by another key", token: sign(t, jwt.SigningMethodRS256, validClaims(), otherKey, "rsa-1"), wantErr: ErrInvalidCredentials},
+		{name: "algorithm not allowed for key", token: sign(t, jwt.SigningMethodRS512, validClaims(), rsaKey, "rsa-1"), wantErr: ErrInvalidCredentials},
+		{name: "key of another family", token: sign(t, jwt.SigningMethodRS256, validClaims(), rsaKey, "ec-1"), wantErr: ErrInvalidCredentials},
+		{
+			// Подпись HMAC открытым ключом не должна приниматься (подмена алгоритма)
+			name:    "hmac token",
+			token:   sign(t, jwt.SigningMethodHS256, validClaims(), []byte(encodeBigInt(rsaKey.N)), "rsa-1"),
+			wantErr: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := a.Authenticate(bearerRequest(tt.token))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &Identity{UserID: 123456789, Role: "manager"}, identity)
+		})
+	}
+}
+
+func TestJWTAuthenticator_SingleKeyWithoutKid(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	a, err := NewJWTAuthenticator(writeJWKS(t, rsaJWK("only", "", &key.PublicKey)), testOptions())
+	require.NoError(t, err)
+
+	identity, err := a.Authenticate(bearerRequest(sign(t, jwt.SigningMethodPS256, validClaims(), key, "")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456789), identity.UserID)
+}
+
+func TestLoadJWKSFile(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("encryption keys are skipped", func(t *testing.T) {
+		enc := rsaJWK("enc", "", &rsaKey.PublicKey)
+		enc.Use = "enc"
+		keys, err := loadJWKSFile(writeJWKS(t, enc, ecJWK("sig", &ecKey.PublicKey)))
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "sig", keys[0].kid)
+	})
+
+	invalid := []struct {
+		name string
+		key  func() jwk
+	}{
+		{"only encryption keys", func() jwk {
+			k := rsaJWK("enc", "", &rsaKey.PublicKey)
+			k.Use = "enc"
+			return k
+		}},
+		{"unsupported key type", func() jwk { return jwk{Kty: "oct", Kid: "hmac"} }},
+		{"unsupported curve", func() jwk {
+			k := ecJWK("ec", &ecKey.PublicKey)
+			k.Crv = "secp256k1"
+			return k
+		}},
+		{"point not on curve", func() jwk {
+			k := ecJWK("ec", &ecKey.PublicKey)
+			k.Y = encodeBigInt(new(big.Int).Add(ecKey.Y, big.NewInt(1)))
+			return k
+		}},
+		{"invalid exponent", func() jwk {
+			k := rsaJWK("rsa", "", &rsaKey.PublicKey)
+			k.E = encodeBigInt(big.NewInt(1))
+			return k
+		}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadJWKSFile(writeJWKS(t, tt.key()))
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := loadJWKSFile(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+	})
+}
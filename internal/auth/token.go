@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenOptions общие параметры проверки подписанных токенов
+type TokenOptions struct {
+	Issuer      string        // Ожидаемый iss (пусто - не проверяется)
+	Audience    string        // Ожидаемый aud (пусто - не проверяется)
+	Leeway      time.Duration // Допустимое расхождение часов для exp/nbf/iat
+	UserIDClaim string        // Claim с Telegram ID пользователя
+	RoleClaim   string        // Claim с ролью пользователя
+}
+
+// tokenVerifier проверяет подпись и стандартные claims токена и извлекает Identity
+type tokenVerifier struct {
+	parser  *jwt.Parser
+	keyFunc jwt.Keyfunc
+	opts    TokenOptions
+}
+
+// newTokenVerifier создает проверку токенов для заданных алгоритмов подписи
+// Токен обязан содержать exp
+func newTokenVerifier(methods []string, keyFunc jwt.Keyfunc, opts TokenOptions) *tokenVerifier {
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(opts.Leeway),
+		jwt.WithJSONNumber(),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	return &tokenVerifier{
+		parser:  jwt.NewParser(parserOpts...),
+		keyFunc: keyFunc,
+		opts:    opts,
+	}
+}
+
+// authenticate проверяет Bearer токен из заголовка Authorization
+func (v *tokenVerifier) authenticate(r *http.Request) (*Identity, error) {
+	raw, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrMissingCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(raw, claims, v.keyFunc); err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	userID, err := claimInt64(claims, v.opts.UserIDClaim)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	role, err := claimString(claims, v.opts.RoleClaim)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	return &Identity{UserID: userID, Role: role}, nil
+}
+
+// bearerToken извлекает токен из заголовка "Authorization: Bearer <token>"
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// claimInt64 извлекает положительный ID из claim (число или строка с числом)
+func claimInt64(claims jwt.MapClaims, name string) (int64, error) {
+	value, ok := claims[name]
+	if !ok {
+		return 0, fmt.Errorf("claim %q is missing", name)
+	}
+
+	var id int64
+	var err error
+	switch v := value.(type) {
+	case json.Number:
+		id, err = v.Int64()
+	case string:
+		id, err = strconv.ParseInt(v, 10, 64)
+	default:
+		err = fmt.Errorf("unexpected type %T", value)
+	}
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("claim %q is not a valid user ID", name)
+	}
+
+	return id, nil
+}
+
+// claimString извлекает необязательный строковый claim
+func claimString(claims jwt.MapClaims, name string) (string, error) {
+	value, ok := claims[name]
+	if !ok || value == nil {
+		return "", nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("claim %q must be a string", name)
+	}
+	return s, nil
+}
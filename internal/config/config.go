@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 )
@@ -12,16 +13,32 @@ import (
 type Config struct {
 	Logs          LogsConfig          `toml:"logs"`
 	Server        ServerConfig        `toml:"server"`
+	GRPC          GRPCConfig          `toml:"grpc"`
+	Locale        LocaleConfig        `toml:"locale"`
+	OpenAPI       OpenAPIConfig       `toml:"openapi"`
 	Database      DatabaseConfig      `toml:"database"`
 	Metrics       MetricsConfig       `toml:"metrics"`
+	Tracing       TracingConfig       `toml:"tracing"`
+	Health        HealthConfig        `toml:"health"`
 	UserService   IntegrationConfig   `toml:"userservice"`
 	SellerService IntegrationConfig   `toml:"sellerservice"`
+	CarEnrichment CarEnrichmentConfig `toml:"car_enrichment"`
+	Auth          AuthConfig          `toml:"auth"`
+	Calendar      CalendarConfig      `toml:"calendar"`
+	SlotStream    SlotStreamConfig    `toml:"slot_stream"`
+	BookingStream BookingStreamConfig `toml:"booking_stream"`
+	Events        EventsConfig        `toml:"events"`
 }
 
 // LogsConfig содержит настройки логирования
 type LogsConfig struct {
-	Level string `toml:"level"`
-	File  string `toml:"file"`
+	Level      string `toml:"level"`
+	Format     string `toml:"format"` // Формат записей: json, logfmt
+	File       string `toml:"file"`
+	MaxSizeMB  int    `toml:"max_size_mb"`  // Размер файла логов, после которого он ротируется
+	MaxBackups int    `toml:"max_backups"`  // Сколько ротированных файлов хранить
+	MaxAgeDays int    `toml:"max_age_days"` // Сколько дней хранить ротированные файлы (0 - бессрочно)
+	Compress   bool   `toml:"compress"`     // Сжимать ротированные файлы (gzip)
 }
 
 // ServerConfig содержит настройки HTTP сервера
@@ -33,30 +50,176 @@ type ServerConfig struct {
 	ShutdownTimeout int `toml:"shutdown_timeout"`
 }
 
+// GRPCConfig содержит настройки gRPC сервера для внутренних сервисов
+type GRPCConfig struct {
+	Enabled    bool `toml:"enabled"`
+	Port       int  `toml:"port"`       // Порт gRPC сервера (отдельный от HTTP)
+	Reflection bool `toml:"reflection"` // Регистрировать server reflection (grpcurl)
+}
+
+// LocaleConfig содержит настройки языка ответов API
+type LocaleConfig struct {
+	Default string `toml:"default"` // Язык, если Accept-Language не указан или не поддерживается: ru, en, kk
+}
+
+// OpenAPIConfig содержит настройки проверки запросов и ответов по спецификации OpenAPI
+type OpenAPIConfig struct {
+	SpecPath          string `toml:"spec_path"`          // Путь к спецификации (schemas/schema.yaml)
+	ValidateRequests  bool   `toml:"validate_requests"`  // Проверять запросы до обработчиков (400 при несоответствии)
+	ValidateResponses bool   `toml:"validate_responses"` // Проверять ответы обработчиков (500 при несоответствии), для тестов
+}
+
 // DatabaseConfig содержит настройки подключения к PostgreSQL
 type DatabaseConfig struct {
-	Host            string `toml:"host"`
-	Port            int    `toml:"port"`
-	User            string `toml:"user"`
-	Password        string `toml:"password"`
-	DBName          string `toml:"dbname"`
-	SSLMode         string `toml:"sslmode"`
-	MaxOpenConns    int    `toml:"max_open_conns"`
-	MaxIdleConns    int    `toml:"max_idle_conns"`
-	ConnMaxLifetime int    `toml:"conn_max_lifetime"`
+	Host            string      `toml:"host"`
+	Port            int         `toml:"port"`
+	User            string      `toml:"user"`
+	Password        string      `toml:"password"`
+	DBName          string      `toml:"dbname"`
+	SSLMode         string      `toml:"sslmode"`
+	MaxOpenConns    int         `toml:"max_open_conns"`
+	MaxIdleConns    int         `toml:"max_idle_conns"`
+	ConnMaxLifetime int         `toml:"conn_max_lifetime"`
+	TxRetry         RetryConfig `toml:"tx_retry"` // Повторы транзакций при конфликте сериализации (40001) и deadlock (40P01)
 }
 
 // MetricsConfig содержит настройки метрик Prometheus
 type MetricsConfig struct {
-	Enabled     bool   `toml:"enabled"`
-	Path        string `toml:"path"`
-	ServiceName string `toml:"service_name"`
+	Enabled     bool                  `toml:"enabled"`
+	Path        string                `toml:"path"`
+	ServiceName string                `toml:"service_name"`
+	Business    BusinessMetricsConfig `toml:"business"`
+}
+
+// BusinessMetricsConfig содержит настройки бизнес метрик бронирований
+type BusinessMetricsConfig struct {
+	CompanyLabel      string  `toml:"company_label"`      // Метка company: id (все ID), allowlist (ID из списка, остальные - other), aggregate (all)
+	CompanyAllowlist  []int64 `toml:"company_allowlist"`  // Компании с собственной меткой в режиме allowlist
+	OccupancyInterval int     `toml:"occupancy_interval"` // Интервал расчета загрузки слотов (секунды)
+}
+
+// TracingConfig содержит настройки трассировки OpenTelemetry
+type TracingConfig struct {
+	Enabled     bool    `toml:"enabled"`
+	Exporter    string  `toml:"exporter"`     // Куда отправлять спаны: otlp (коллектор), file (локальный файл)
+	Endpoint    string  `toml:"endpoint"`     // host:port OTLP/HTTP коллектора
+	Insecure    bool    `toml:"insecure"`     // OTLP без TLS
+	FilePath    string  `toml:"file_path"`    // Файл спанов для экспортера file
+	SampleRatio float64 `toml:"sample_ratio"` // Доля трассируемых запросов (0..1], входящий traceparent соблюдается
+}
+
+// HealthConfig содержит настройки проверки готовности (/readyz) и завершения работы
+type HealthConfig struct {
+	Timeout        int    `toml:"timeout"`         // Таймаут проверки одной зависимости (секунды)
+	CacheTTL       int    `toml:"cache_ttl"`       // Время, в течение которого результат проверки переиспользуется (секунды)
+	MigrationsPath string `toml:"migrations_path"` // Каталог миграций: последняя версия сравнивается с версией БД
+	DrainDelay     int    `toml:"drain_delay"`     // Пауза между провалом /readyz и остановкой сервера (секунды)
 }
 
 // IntegrationConfig содержит настройки интеграции с внешним сервисом
 type IntegrationConfig struct {
-	URL     string `toml:"url"`
-	Timeout int    `toml:"timeout"`
+	URL            string               `toml:"url"`
+	Timeout        int                  `toml:"timeout"`     // Таймаут одной попытки (секунды)
+	HealthPath     string               `toml:"health_path"` // Путь, который опрашивает проверка готовности (/readyz)
+	Retry          RetryConfig          `toml:"retry"`
+	CircuitBreaker CircuitBreakerConfig `toml:"circuit_breaker"`
+	Cache          CacheConfig          `toml:"cache"`
+}
+
+// RetryConfig содержит настройки повторов идемпотентных запросов к внешнему сервису или транзакций БД
+type RetryConfig struct {
+	MaxAttempts      int `toml:"max_attempts"`       // Общее количество попыток, включая первую
+	InitialBackoffMs int `toml:"initial_backoff_ms"` // Базовая задержка перед повтором (миллисекунды)
+	MaxBackoffMs     int `toml:"max_backoff_ms"`     // Максимальная задержка перед повтором (миллисекунды)
+}
+
+// CircuitBreakerConfig содержит настройки circuit breaker для внешнего сервиса
+type CircuitBreakerConfig struct {
+	Enabled             bool `toml:"enabled"`
+	FailureThreshold    int  `toml:"failure_threshold"`      // Количество неудач подряд для размыкания
+	OpenTimeout         int  `toml:"open_timeout"`           // Время в разомкнутом состоянии (секунды)
+	HalfOpenMaxRequests int  `toml:"half_open_max_requests"` // Количество пробных запросов
+}
+
+// CacheConfig содержит настройки кеширования ответов внешнего сервиса
+type CacheConfig struct {
+	Enabled      bool `toml:"enabled"`
+	CompanyTTL   int  `toml:"company_ttl"`   // Время жизни компании в кеше (секунды)
+	ServiceTTL   int  `toml:"service_ttl"`   // Время жизни услуги в кеше (секунды)
+	NegativeTTL  int  `toml:"negative_ttl"`  // Время жизни ответа 404 (секунды)
+	MaxEntries   int  `toml:"max_entries"`   // Максимальное количество записей
+	FetchTimeout int  `toml:"fetch_timeout"` // Ограничение общего запроса при промахе кеша (секунды)
+}
+
+// CarEnrichmentConfig содержит настройки фоновой задачи дозаполнения данных автомобиля
+type CarEnrichmentConfig struct {
+	Enabled   bool `toml:"enabled"`
+	Interval  int  `toml:"interval"`   // Интервал запуска (секунды)
+	BatchSize int  `toml:"batch_size"` // Количество бронирований за один запуск
+}
+
+// CalendarConfig содержит настройки подписки на календарь бронирований (iCalendar)
+type CalendarConfig struct {
+	PublicURL  string `toml:"public_url"`  // Внешний адрес сервиса для ссылок на календарь (пусто - относительные ссылки)
+	PastDays   int    `toml:"past_days"`   // Сколько дней до сегодняшнего включать в календарь
+	FutureDays int    `toml:"future_days"` // Сколько дней после сегодняшнего включать в календарь
+	MaxEvents  int    `toml:"max_events"`  // Максимальное количество событий в календаре
+	TimeZone   string `toml:"timezone"`    // Часовой пояс времени бронирований, например Europe/Moscow (пусто - не указывается)
+}
+
+// EventsConfig содержит настройки доставки событий бронирований между репликами
+type EventsConfig struct {
+	NotifyBridge bool   `toml:"notify_bridge"` // Пересылать события между репликами через PostgreSQL LISTEN/NOTIFY
+	Channel      string `toml:"channel"`       // Канал LISTEN/NOTIFY
+}
+
+// SlotStreamConfig содержит настройки потока доступности слотов (Server-Sent Events)
+type SlotStreamConfig struct {
+	Enabled           bool `toml:"enabled"`
+	HeartbeatInterval int  `toml:"heartbeat_interval"` // Интервал keep-alive комментариев (секунды)
+	MaxDuration       int  `toml:"max_duration"`       // Максимальная длительность соединения, после - клиент переподключается (секунды)
+	MaxConnections    int  `toml:"max_connections"`    // Максимальное количество одновременных соединений на экземпляр
+}
+
+// BookingStreamConfig содержит настройки потока бронирований компании для менеджеров (WebSocket)
+type BookingStreamConfig struct {
+	Enabled           bool     `toml:"enabled"`
+	HeartbeatInterval int      `toml:"heartbeat_interval"` // Интервал ping, клиент без pong за два интервала отключается (секунды)
+	SendBuffer        int      `toml:"send_buffer"`        // Сколько событий может ожидать отправки до отключения медленного клиента
+	MaxConnections    int      `toml:"max_connections"`    // Максимальное количество одновременных соединений на экземпляр
+	SnapshotLimit     int      `toml:"snapshot_limit"`     // Максимум бронирований в снимке
+	AllowedOrigins    []string `toml:"allowed_origins"`    // Разрешенные Origin браузерных клиентов ("*" - любые, пусто - тот же хост)
+	AccessCheck       int      `toml:"access_check"`       // Интервал повторной проверки прав менеджера, лишенный доступа отключается (секунды)
+}
+
+// Типы аутентификаторов
+const (
+	AuthenticatorHeader = "header" // Заголовки X-User-ID/X-User-Role без проверки (только для разработки)
+	AuthenticatorHMAC   = "hmac"   // Токены API gateway, подписанные общим секретом
+	AuthenticatorJWT    = "jwt"    // JWT, подписанные ключами из JWKS файла
+)
+
+// AuthConfig содержит настройки аутентификации запросов
+type AuthConfig struct {
+	Authenticators []string       `toml:"authenticators"`  // Аутентификаторы в порядке проверки
+	DevHeaderAuth  bool           `toml:"dev_header_auth"` // Разрешить аутентификатор header (только для разработки)
+	Issuer         string         `toml:"issuer"`          // Ожидаемый iss токена (пусто - не проверяется)
+	Audience       string         `toml:"audience"`        // Ожидаемый aud токена (пусто - не проверяется)
+	Leeway         int            `toml:"leeway"`          // Допустимое расхождение часов (секунды)
+	UserIDClaim    string         `toml:"user_id_claim"`   // Claim с Telegram ID пользователя
+	RoleClaim      string         `toml:"role_claim"`      // Claim с ролью пользователя
+	HMAC           AuthHMACConfig `toml:"hmac"`
+	JWT            AuthJWTConfig  `toml:"jwt"`
+}
+
+// AuthHMACConfig содержит настройки проверки HMAC-токенов API gateway
+type AuthHMACConfig struct {
+	Secret string `toml:"secret"` // Общий секрет подписи (переопределяется через AUTH_HMAC_SECRET)
+}
+
+// AuthJWTConfig содержит настройки проверки JWT
+type AuthJWTConfig struct {
+	JWKSFile string `toml:"jwks_file"` // Путь к JWKS файлу с открытыми ключами
 }
 
 // DSN формирует строку подключения к PostgreSQL
@@ -118,6 +281,18 @@ func overrideFromEnv(cfg *Config) {
 		}
 	}
 
+	// gRPC
+	if v := os.Getenv("GRPC_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.GRPC.Enabled = enabled
+		}
+	}
+	if v := os.Getenv("GRPC_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			cfg.GRPC.Port = port
+		}
+	}
+
 	// Logs
 	if v := os.Getenv("LOG_LEVEL"); v != "" {
 		cfg.Logs.Level = v
@@ -125,6 +300,9 @@ func overrideFromEnv(cfg *Config) {
 	if v := os.Getenv("LOG_FILE"); v != "" {
 		cfg.Logs.File = v
 	}
+	if v := os.Getenv("LOG_FORMAT"); v != "" {
+		cfg.Logs.Format = v
+	}
 
 	// Metrics
 	if v := os.Getenv("METRICS_ENABLED"); v != "" {
@@ -138,6 +316,27 @@ func overrideFromEnv(cfg *Config) {
 	if v := os.Getenv("METRICS_SERVICE_NAME"); v != "" {
 		cfg.Metrics.ServiceName = v
 	}
+	if v := os.Getenv("METRICS_COMPANY_LABEL"); v != "" {
+		cfg.Metrics.Business.CompanyLabel = v
+	}
+
+	// Tracing
+	if v := os.Getenv("TRACING_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.Tracing.Enabled = enabled
+		}
+	}
+	if v := os.Getenv("TRACING_EXPORTER"); v != "" {
+		cfg.Tracing.Exporter = v
+	}
+	if v := os.Getenv("TRACING_ENDPOINT"); v != "" {
+		cfg.Tracing.Endpoint = v
+	}
+	if v := os.Getenv("TRACING_SAMPLE_RATIO"); v != "" {
+		if ratio, err := strconv.ParseFloat(v, 64); err == nil {
+			cfg.Tracing.SampleRatio = ratio
+		}
+	}
 
 	// UserService integration
 	if v := os.Getenv("USERSERVICE_URL"); v != "" {
@@ -158,6 +357,85 @@ func overrideFromEnv(cfg *Config) {
 			cfg.SellerService.Timeout = timeout
 		}
 	}
+	if v := os.Getenv("SELLERSERVICE_CACHE_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.SellerService.Cache.Enabled = enabled
+		}
+	}
+
+	// Car enrichment worker
+	if v := os.Getenv("CAR_ENRICHMENT_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.CarEnrichment.Enabled = enabled
+		}
+	}
+
+	// Locale
+	if v := os.Getenv("LOCALE_DEFAULT"); v != "" {
+		cfg.Locale.Default = v
+	}
+
+	// OpenAPI
+	if v := os.Getenv("OPENAPI_SPEC_PATH"); v != "" {
+		cfg.OpenAPI.SpecPath = v
+	}
+	if v := os.Getenv("OPENAPI_VALIDATE_REQUESTS"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.OpenAPI.ValidateRequests = enabled
+		}
+	}
+	if v := os.Getenv("OPENAPI_VALIDATE_RESPONSES"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.OpenAPI.ValidateResponses = enabled
+		}
+	}
+
+	// Calendar
+	if v := os.Getenv("CALENDAR_PUBLIC_URL"); v != "" {
+		cfg.Calendar.PublicURL = v
+	}
+
+	// Slot stream
+	if v := os.Getenv("SLOT_STREAM_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.SlotStream.Enabled = enabled
+		}
+	}
+
+	// Booking stream
+	if v := os.Getenv("BOOKING_STREAM_ENABLED"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.BookingStream.Enabled = enabled
+		}
+	}
+
+	// Events
+	if v := os.Getenv("EVENTS_NOTIFY_BRIDGE"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.Events.NotifyBridge = enabled
+		}
+	}
+
+	// Auth
+	if v := os.Getenv("AUTH_AUTHENTICATORS"); v != "" {
+		cfg.Auth.Authenticators = nil
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.Auth.Authenticators = append(cfg.Auth.Authenticators, name)
+			}
+		}
+	}
+	if v := os.Getenv("AUTH_DEV_HEADER_AUTH"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			cfg.Auth.DevHeaderAuth = enabled
+		}
+	}
+	if v := os.Getenv("AUTH_HMAC_SECRET"); v != "" {
+		cfg.Auth.HMAC.Secret = v
+	}
+	if v := os.Getenv("AUTH_JWKS_FILE"); v != "" {
+		cfg.Auth.JWT.JWKSFile = v
+	}
 }
 
 // validate проверяет корректность конфигурации
@@ -181,6 +459,32 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("HTTP port must be between 1 and 65535")
 	}
 
+	// gRPC validation and defaults
+	if cfg.GRPC.Port == 0 {
+		cfg.GRPC.Port = 9083
+	}
+	if cfg.GRPC.Port < 0 || cfg.GRPC.Port > 65535 {
+		return fmt.Errorf("gRPC port must be between 1 and 65535")
+	}
+	if cfg.GRPC.Enabled && cfg.GRPC.Port == cfg.Server.HTTPPort {
+		return fmt.Errorf("gRPC port must differ from HTTP port")
+	}
+
+	// Locale validation and defaults
+	if cfg.Locale.Default == "" {
+		cfg.Locale.Default = "ru"
+	}
+	switch cfg.Locale.Default {
+	case "ru", "en", "kk":
+	default:
+		return fmt.Errorf("locale default must be one of ru, en, kk")
+	}
+
+	// OpenAPI defaults
+	if cfg.OpenAPI.SpecPath == "" {
+		cfg.OpenAPI.SpecPath = "./schemas/schema.yaml"
+	}
+
 	// Logs validation
 	if cfg.Logs.Level == "" {
 		cfg.Logs.Level = "info" // default
@@ -188,6 +492,18 @@ func validate(cfg *Config) error {
 	if cfg.Logs.File == "" {
 		cfg.Logs.File = "./logs/app.log" // default
 	}
+	if cfg.Logs.Format == "" {
+		cfg.Logs.Format = "json" // default
+	}
+	if cfg.Logs.Format != "json" && cfg.Logs.Format != "logfmt" {
+		return fmt.Errorf("logs format must be json or logfmt")
+	}
+	if cfg.Logs.MaxSizeMB == 0 {
+		cfg.Logs.MaxSizeMB = 100 // default
+	}
+	if cfg.Logs.MaxSizeMB < 0 || cfg.Logs.MaxBackups < 0 || cfg.Logs.MaxAgeDays < 0 {
+		return fmt.Errorf("logs rotation settings must not be negative")
+	}
 
 	// Set defaults for timeouts if not specified
 	if cfg.Server.ReadTimeout == 0 {
@@ -213,6 +529,18 @@ func validate(cfg *Config) error {
 	if cfg.Database.ConnMaxLifetime == 0 {
 		cfg.Database.ConnMaxLifetime = 300 // 5 minutes
 	}
+	if cfg.Database.TxRetry.MaxAttempts == 0 {
+		cfg.Database.TxRetry.MaxAttempts = 3
+	}
+	if cfg.Database.TxRetry.InitialBackoffMs == 0 {
+		cfg.Database.TxRetry.InitialBackoffMs = 20
+	}
+	if cfg.Database.TxRetry.MaxBackoffMs == 0 {
+		cfg.Database.TxRetry.MaxBackoffMs = 200
+	}
+	if cfg.Database.TxRetry.MaxAttempts < 0 || cfg.Database.TxRetry.InitialBackoffMs < 0 || cfg.Database.TxRetry.MaxBackoffMs < 0 {
+		return fmt.Errorf("database tx_retry max_attempts and backoff must not be negative")
+	}
 
 	// Metrics validation and defaults
 	if cfg.Metrics.Path == "" {
@@ -221,6 +549,57 @@ func validate(cfg *Config) error {
 	if cfg.Metrics.ServiceName == "" {
 		cfg.Metrics.ServiceName = "bookingservice"
 	}
+	if cfg.Metrics.Business.CompanyLabel == "" {
+		cfg.Metrics.Business.CompanyLabel = "id" // default
+	}
+	switch cfg.Metrics.Business.CompanyLabel {
+	case "id", "allowlist", "aggregate":
+	default:
+		return fmt.Errorf("metrics business company_label must be id, allowlist or aggregate, got %q", cfg.Metrics.Business.CompanyLabel)
+	}
+	if cfg.Metrics.Business.OccupancyInterval == 0 {
+		cfg.Metrics.Business.OccupancyInterval = 300 // default 5 minutes
+	}
+	if cfg.Metrics.Business.OccupancyInterval < 0 {
+		return fmt.Errorf("metrics business occupancy_interval must be positive")
+	}
+
+	// Health defaults
+	if cfg.Health.Timeout == 0 {
+		cfg.Health.Timeout = 2 // default 2 seconds
+	}
+	if cfg.Health.CacheTTL == 0 {
+		cfg.Health.CacheTTL = 10 // default 10 seconds
+	}
+	if cfg.Health.MigrationsPath == "" {
+		cfg.Health.MigrationsPath = "./migrations"
+	}
+	if cfg.Health.DrainDelay == 0 {
+		cfg.Health.DrainDelay = 5 // default 5 seconds
+	}
+	if cfg.Health.Timeout < 0 || cfg.Health.CacheTTL < 0 || cfg.Health.DrainDelay < 0 {
+		return fmt.Errorf("health timeout, cache_ttl and drain_delay must not be negative")
+	}
+
+	// Tracing validation and defaults
+	if cfg.Tracing.Exporter == "" {
+		cfg.Tracing.Exporter = "otlp" // default
+	}
+	if cfg.Tracing.Exporter != "otlp" && cfg.Tracing.Exporter != "file" {
+		return fmt.Errorf("tracing exporter must be otlp or file, got %q", cfg.Tracing.Exporter)
+	}
+	if cfg.Tracing.Endpoint == "" {
+		cfg.Tracing.Endpoint = "localhost:4318" // default
+	}
+	if cfg.Tracing.FilePath == "" {
+		cfg.Tracing.FilePath = "./logs/traces.json" // default
+	}
+	if cfg.Tracing.SampleRatio == 0 {
+		cfg.Tracing.SampleRatio = 1 // default: трассировать все запросы
+	}
+	if cfg.Tracing.SampleRatio < 0 || cfg.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample_ratio must be in (0, 1], got %v", cfg.Tracing.SampleRatio)
+	}
 
 	// UserService integration validation
 	if cfg.UserService.URL == "" {
@@ -229,6 +608,7 @@ func validate(cfg *Config) error {
 	if cfg.UserService.Timeout == 0 {
 		cfg.UserService.Timeout = 10 // default 10 seconds
 	}
+	setIntegrationDefaults(&cfg.UserService)
 
 	// SellerService integration validation
 	if cfg.SellerService.URL == "" {
@@ -237,6 +617,152 @@ func validate(cfg *Config) error {
 	if cfg.SellerService.Timeout == 0 {
 		cfg.SellerService.Timeout = 10 // default 10 seconds
 	}
+	setIntegrationDefaults(&cfg.SellerService)
+	if cfg.SellerService.Cache.CompanyTTL == 0 {
+		cfg.SellerService.Cache.CompanyTTL = 60 // default 1 minute
+	}
+	if cfg.SellerService.Cache.ServiceTTL == 0 {
+		cfg.SellerService.Cache.ServiceTTL = 300 // default 5 minutes
+	}
+	if cfg.SellerService.Cache.NegativeTTL == 0 {
+		cfg.SellerService.Cache.NegativeTTL = 30 // default 30 seconds
+	}
+	if cfg.SellerService.Cache.MaxEntries == 0 {
+		cfg.SellerService.Cache.MaxEntries = 10000
+	}
+	if cfg.SellerService.Cache.FetchTimeout == 0 {
+		cfg.SellerService.Cache.FetchTimeout = 30 // default 30 seconds
+	}
+
+	// Car enrichment worker defaults
+	if cfg.CarEnrichment.Interval == 0 {
+		cfg.CarEnrichment.Interval = 60 // default 1 minute
+	}
+	if cfg.CarEnrichment.BatchSize == 0 {
+		cfg.CarEnrichment.BatchSize = 100
+	}
+
+	// Calendar defaults
+	if cfg.Calendar.PastDays == 0 {
+		cfg.Calendar.PastDays = 30
+	}
+	if cfg.Calendar.FutureDays == 0 {
+		cfg.Calendar.FutureDays = 90
+	}
+	if cfg.Calendar.MaxEvents == 0 {
+		cfg.Calendar.MaxEvents = 500
+	}
+	if cfg.Calendar.PastDays < 0 || cfg.Calendar.FutureDays < 0 || cfg.Calendar.MaxEvents < 0 {
+		return fmt.Errorf("calendar past_days, future_days and max_events must not be negative")
+	}
+	cfg.Calendar.PublicURL = strings.TrimRight(cfg.Calendar.PublicURL, "/")
+
+	// Events defaults
+	if cfg.Events.Channel == "" {
+		cfg.Events.Channel = "booking_events"
+	}
+
+	// Slot stream defaults
+	if cfg.SlotStream.HeartbeatInterval == 0 {
+		cfg.SlotStream.HeartbeatInterval = 15
+	}
+	if cfg.SlotStream.MaxDuration == 0 {
+		cfg.SlotStream.MaxDuration = 1800 // default 30 minutes
+	}
+	if cfg.SlotStream.MaxConnections == 0 {
+		cfg.SlotStream.MaxConnections = 1000
+	}
+	if cfg.SlotStream.HeartbeatInterval < 0 || cfg.SlotStream.MaxDuration < 0 || cfg.SlotStream.MaxConnections < 0 {
+		return fmt.Errorf("slot_stream heartbeat_interval, max_duration and max_connections must not be negative")
+	}
+
+	// Booking stream defaults
+	if cfg.BookingStream.HeartbeatInterval == 0 {
+		cfg.BookingStream.HeartbeatInterval = 30
+	}
+	if cfg.BookingStream.SendBuffer == 0 {
+		cfg.BookingStream.SendBuffer = 256
+	}
+	if cfg.BookingStream.MaxConnections == 0 {
+		cfg.BookingStream.MaxConnections = 500
+	}
+	if cfg.BookingStream.SnapshotLimit == 0 {
+		cfg.BookingStream.SnapshotLimit = 500
+	}
+	if cfg.BookingStream.AccessCheck == 0 {
+		cfg.BookingStream.AccessCheck = 60
+	}
+	if cfg.BookingStream.HeartbeatInterval < 0 || cfg.BookingStream.SendBuffer < 0 ||
+		cfg.BookingStream.MaxConnections < 0 || cfg.BookingStream.SnapshotLimit < 0 || cfg.BookingStream.AccessCheck < 0 {
+		return fmt.Errorf("booking_stream heartbeat_interval, send_buffer, max_connections, snapshot_limit and access_check must not be negative")
+	}
+
+	// Auth validation and defaults
+	if err := validateAuth(&cfg.Auth); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// validateAuth проверяет настройки аутентификации и устанавливает значения по умолчанию
+func validateAuth(a *AuthConfig) error {
+	if len(a.Authenticators) == 0 {
+		return fmt.Errorf("at least one authenticator is required in auth.authenticators")
+	}
+	for _, name := range a.Authenticators {
+		switch name {
+		case AuthenticatorHeader:
+			if !a.DevHeaderAuth {
+				return fmt.Errorf("header authenticator trusts X-User-ID without verification and requires auth.dev_header_auth = true (development only)")
+			}
+		case AuthenticatorHMAC:
+			if a.HMAC.Secret == "" {
+				return fmt.Errorf("auth HMAC secret is required for hmac authenticator")
+			}
+		case AuthenticatorJWT:
+			if a.JWT.JWKSFile == "" {
+				return fmt.Errorf("auth JWKS file is required for jwt authenticator")
+			}
+		default:
+			return fmt.Errorf("unknown authenticator %q", name)
+		}
+	}
+
+	if a.Leeway == 0 {
+		a.Leeway = 30 // default 30 seconds
+	}
+	if a.UserIDClaim == "" {
+		a.UserIDClaim = "sub"
+	}
+	if a.RoleClaim == "" {
+		a.RoleClaim = "role"
+	}
+
+	return nil
+}
+
+// setIntegrationDefaults устанавливает значения по умолчанию для повторов и circuit breaker
+func setIntegrationDefaults(ic *IntegrationConfig) {
+	if ic.HealthPath == "" {
+		ic.HealthPath = "/health"
+	}
+	if ic.Retry.MaxAttempts == 0 {
+		ic.Retry.MaxAttempts = 3
+	}
+	if ic.Retry.InitialBackoffMs == 0 {
+		ic.Retry.InitialBackoffMs = 100
+	}
+	if ic.Retry.MaxBackoffMs == 0 {
+		ic.Retry.MaxBackoffMs = 1000
+	}
+	if ic.CircuitBreaker.FailureThreshold == 0 {
+		ic.CircuitBreaker.FailureThreshold = 5
+	}
+	if ic.CircuitBreaker.OpenTimeout == 0 {
+		ic.CircuitBreaker.OpenTimeout = 30
+	}
+	if ic.CircuitBreaker.HalfOpenMaxRequests == 0 {
+		ic.CircuitBreaker.HalfOpenMaxRequests = 1
+	}
+}
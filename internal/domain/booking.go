@@ -26,7 +26,7 @@ type Booking struct {
 	CompanyID       int64
 	AddressID       int64 // ID адреса компании (компания может иметь несколько точек обслуживания)
 	ServiceID       int64
-	CarID           int64
+	CarID           *int64 // NULL, если данные автомобиля ещё не получены из UserService
 	BookingDate     time.Time
 	StartTime       types.TimeString
 	DurationMinutes int
@@ -40,6 +40,10 @@ type Booking struct {
 	CarLicensePlate *string
 	Notes           *string
 
+	// CarDetailsPending данные автомобиля не получены при создании (UserService был недоступен)
+	// и будут дозаполнены фоновой задачей
+	CarDetailsPending bool
+
 	CancellationReason *string
 	CancelledAt        *time.Time
 
@@ -47,6 +51,14 @@ type Booking struct {
 	UpdatedAt time.Time
 }
 
+// CarDetails denormalized car data stored with a booking
+type CarDetails struct {
+	CarID        *int64
+	Brand        *string
+	Model        *string
+	LicensePlate *string
+}
+
 // IsActive returns true if the booking is in an active state
 func (b *Booking) IsActive() bool {
 	return b.Status != StatusCancelledByUser &&
@@ -76,10 +88,26 @@ func (b *Booking) IsCompleted() bool {
 
 // CompanyBookingsFilter фильтр для получения бронирований компании
 type CompanyBookingsFilter struct {
-	CompanyID       int64          // Обязательный параметр
-	AddressID       *int64         // Фильтр по адресу (опционально, если nil - все адреса)
-	StartDate       *time.Time     // Начало периода (опционально, если nil - без ограничения)
-	EndDate         *time.Time     // Конец периода (опционально, если nil - без ограничения)
-	Status          *BookingStatus // Фильтр по статусу (опционально)
-	IncludeInactive bool           // Включать ли неактивные бронирования (отмененные, no-show)
+	CompanyID       int64             // Обязательный параметр
+	AddressID       *int64            // Фильтр по адресу (опционально, если nil - все адреса)
+	AddressIDs      []int64           // Фильтр по нескольким адресам (опционально, любой из перечисленных)
+	ServiceID       *int64            // Фильтр по услуге (опционально)
+	UserID          *int64            // Фильтр по клиенту (опционально)
+	StartDate       *time.Time        // Начало периода (опционально, если nil - без ограничения)
+	EndDate         *time.Time        // Конец периода (опционально, если nil - без ограничения)
+	StartTimeFrom   *types.TimeString // Время начала не раньше (опционально, включительно)
+	StartTimeTo     *types.TimeString // Время начала раньше (опционально, не включительно)
+	Statuses        []BookingStatus   // Фильтр по статусам (опционально, любой из перечисленных)
+	LicensePlate    *string           // Часть госномера без учета регистра (опционально)
+	IncludeInactive bool              // Включать ли неактивные бронирования (отмененные, no-show), если статусы не указаны
+	Page            BookingPage       // Пагинация (нулевое значение - все записи)
+}
+
+// UserBookingsFilter фильтр для получения бронирований пользователя
+type UserBookingsFilter struct {
+	UserID    int64          // Обязательный параметр
+	Status    *BookingStatus // Фильтр по статусу (опционально)
+	StartDate *time.Time     // Начало периода (опционально)
+	EndDate   *time.Time     // Конец периода (опционально)
+	Page      BookingPage    // Пагинация (нулевое значение - все записи)
 }
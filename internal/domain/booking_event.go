@@ -0,0 +1,23 @@
+package domain
+
+// BookingEventType тип события бронирования
+type BookingEventType string
+
+// Типы событий бронирования
+const (
+	BookingEventCreated       BookingEventType = "created"        // Создано
+	BookingEventCancelled     BookingEventType = "cancelled"      // Отменено клиентом, компанией или администратором
+	BookingEventStatusChanged BookingEventType = "status_changed" // Изменен статус (в том числе восстановлено после отмены)
+	BookingEventReassigned    BookingEventType = "reassigned"     // Передано другому пользователю
+)
+
+// BookingEvent изменение бронирования (публикуется после сохранения изменений)
+type BookingEvent struct {
+	Type    BookingEventType
+	Booking *Booking // Состояние бронирования после изменения
+}
+
+// ChangesSlots возвращает true, если событие может изменить доступность слотов
+func (e BookingEvent) ChangesSlots() bool {
+	return e.Type != BookingEventReassigned
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// CalendarFeedScope область подписки на календарь
+type CalendarFeedScope string
+
+const (
+	CalendarFeedScopeUser    CalendarFeedScope = "user"    // Бронирования пользователя
+	CalendarFeedScopeAddress CalendarFeedScope = "address" // Расписание адреса компании
+)
+
+// CalendarFeedTarget владелец подписки на календарь
+// Для ScopeUser заполняется UserID, для ScopeAddress - CompanyID и AddressID
+type CalendarFeedTarget struct {
+	Scope     CalendarFeedScope
+	UserID    *int64
+	CompanyID *int64
+	AddressID *int64
+}
+
+// UserCalendarFeed возвращает владельца подписки на бронирования пользователя
+func UserCalendarFeed(userID int64) CalendarFeedTarget {
+	return CalendarFeedTarget{Scope: CalendarFeedScopeUser, UserID: &userID}
+}
+
+// AddressCalendarFeed возвращает владельца подписки на расписание адреса компании
+func AddressCalendarFeed(companyID, addressID int64) CalendarFeedTarget {
+	return CalendarFeedTarget{Scope: CalendarFeedScopeAddress, CompanyID: &companyID, AddressID: &addressID}
+}
+
+// CalendarFeed токен подписки на календарь (iCalendar, только чтение)
+// Хранится только хеш токена, сам токен возвращается владельцу один раз при выпуске
+type CalendarFeed struct {
+	ID            int64
+	TokenHash     string
+	Target        CalendarFeedTarget
+	CreatedBy     int64
+	CreatedByRole string // Роль выпустившего токен (policy.Role): доступ к адресу проверяется при каждом запросе
+	CreatedAt     time.Time
+	RevokedAt     *time.Time
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Действия администратора, записываемые в историю бронирования
+const (
+	AuditActionAdminForceStatus = "admin_force_status"
+	AuditActionAdminRestore     = "admin_restore"
+	AuditActionAdminReassign    = "admin_reassign"
+)
+
+// AuditContext контекст изменения бронирования для записи в историю
+type AuditContext struct {
+	Action    string
+	ActorID   int64
+	ActorRole string
+	Reason    string
+}
+
+// BookingHistoryEntry запись истории изменений бронирования
+type BookingHistoryEntry struct {
+	ID        int64
+	BookingID int64
+	Operation string // INSERT, UPDATE, DELETE
+	Action    *string
+	ActorID   *int64
+	ActorRole *string
+	Reason    *string
+	Changes   json.RawMessage // INSERT/DELETE: строка целиком, UPDATE: {"столбец": {"old": ..., "new": ...}}
+	CreatedAt time.Time
+}
+
+// AdminBookingsFilter фильтр поиска бронирований по всем компаниям
+type AdminBookingsFilter struct {
+	CompanyID    *int64
+	UserID       *int64
+	AddressID    *int64
+	ServiceID    *int64
+	Statuses     []BookingStatus // Пустой список - любые статусы
+	StartDate    *time.Time
+	EndDate      *time.Time
+	LicensePlate *string // Частичное совпадение без учета регистра
+	Limit        int
+	Offset       int
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// AddressLoad загрузка адреса компании на дату
+type AddressLoad struct {
+	CompanyID     int64
+	AddressID     int64
+	Date          time.Time
+	Bookings      int // Количество активных бронирований
+	BookedMinutes int // Суммарная длительность активных бронирований
+}
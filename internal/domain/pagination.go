@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/pkg/types"
+)
+
+// SortDirection направление сортировки списков бронирований
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+// Ограничения размера страницы списков бронирований
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 200
+)
+
+// BookingCursor позиция бронирования в списке, отсортированном по (booking_date, start_time, id)
+type BookingCursor struct {
+	BookingDate time.Time
+	StartTime   types.TimeString
+	ID          int64
+}
+
+// CursorOf возвращает позицию бронирования для keyset-пагинации
+func CursorOf(b *Booking) BookingCursor {
+	return BookingCursor{
+		BookingDate: b.BookingDate,
+		StartTime:   b.StartTime,
+		ID:          b.ID,
+	}
+}
+
+// BookingPage параметры keyset-пагинации по (booking_date, start_time, id)
+type BookingPage struct {
+	Limit     int            // Максимум записей (0 - без ограничения)
+	After     *BookingCursor // Позиция последней записи предыдущей страницы (nil - первая страница)
+	Direction SortDirection  // Направление сортировки (пустое - по умолчанию для запроса)
+}
+
+// TrimPage обрезает выборку, запрошенную с лимитом limit+1, до limit записей
+// Возвращает курсор следующей страницы или nil, если страница последняя
+func TrimPage(bookings []*Booking, limit int) ([]*Booking, *BookingCursor) {
+	if limit <= 0 || len(bookings) <= limit {
+		return bookings, nil
+	}
+
+	bookings = bookings[:limit]
+	next := CursorOf(bookings[limit-1])
+	return bookings, &next
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SlotsChangedEvent доступность слотов адреса компании на дату изменилась
+// (бронирование создано, отменено, восстановлено или изменило статус)
+type SlotsChangedEvent struct {
+	CompanyID int64
+	AddressID int64
+	Date      time.Time // Дата без времени
+}
+
+// SlotsChangedFor возвращает событие изменения слотов для бронирования
+func SlotsChangedFor(b *Booking) SlotsChangedEvent {
+	return SlotsChangedEvent{
+		CompanyID: b.CompanyID,
+		AddressID: b.AddressID,
+		Date:      b.BookingDate,
+	}
+}
+
+// Key ключ подписки на изменения слотов: компания, адрес и дата
+func (e SlotsChangedEvent) Key() string {
+	return fmt.Sprintf("%d:%d:%s", e.CompanyID, e.AddressID, e.Date.Format(DateFormat))
+}
+
+// ConfigChangedEvent конфигурация слотов компании изменилась (создание, изменение, удаление, импорт)
+// Конфигурация наследуется по уровням, поэтому может измениться доступность слотов любого адреса компании
+type ConfigChangedEvent struct {
+	CompanyID int64
+}
+
+// KeyPrefix префикс ключей подписок (SlotsChangedEvent.Key) на слоты адресов компании
+func (e ConfigChangedEvent) KeyPrefix() string {
+	return fmt.Sprintf("%d:", e.CompanyID)
+}
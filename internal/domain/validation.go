@@ -0,0 +1,22 @@
+package domain
+
+import "fmt"
+
+// FieldError ошибка валидации конкретного поля запроса
+// API возвращает клиенту имя поля и сообщение в деталях ответа 400
+type FieldError struct {
+	Field   string // Имя поля как в запросе API: reason, slotDurationMinutes, car.id
+	Message string // Сообщение клиенту
+}
+
+// Error реализует error
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// InvalidField оборачивает ошибку сервиса ошибкой поля (errors.Is и errors.As находят обе)
+//
+//	return domain.InvalidField(ErrInvalidInput, "reason", "причина обязательна")
+func InvalidField(err error, field, message string) error {
+	return fmt.Errorf("%w: %w", err, &FieldError{Field: field, Message: message})
+}
@@ -0,0 +1,208 @@
+package bookingevents
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
+)
+
+// BookingRepository интерфейс репозитория бронирований
+type BookingRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Booking, error)
+}
+
+// Параметры переподключения и проверки соединения LISTEN
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	pingInterval         = 90 * time.Second
+)
+
+// DBExecutor интерфейс для выполнения запросов (pg_notify)
+type DBExecutor = dbmetrics.DBExecutor
+
+// loadTimeout таймаут загрузки бронирования по уведомлению другой реплики
+const loadTimeout = 5 * time.Second
+
+// notificationConfigChanged тип уведомления об изменении конфигурации слотов компании
+const notificationConfigChanged = "config_changed"
+
+// notification полезная нагрузка NOTIFY (короткие ключи: лимит payload - 8000 байт)
+// Бронирование не передается целиком: получатель загружает актуальное состояние из БД
+type notification struct {
+	Instance  string `json:"i"`
+	Type      string `json:"t"`
+	BookingID int64  `json:"b,omitempty"`
+	CompanyID int64  `json:"c,omitempty"` // Только для config_changed
+}
+
+// Bridge пересылает события бронирований между репликами через PostgreSQL LISTEN/NOTIFY
+//
+// Forward отправляет NOTIFY в канал, Run слушает канал отдельным соединением и передает
+// события других реплик слушателям Publisher. Собственные уведомления отбрасываются по идентификатору
+// экземпляра: локальные слушатели уже получили событие от Publisher напрямую
+type Bridge struct {
+	db          DBExecutor
+	bookingRepo BookingRepository
+	dsn         string
+	channel     string
+	instance    string
+	publisher   *Publisher
+	logger      Logger
+}
+
+// NewBridge создает мост событий между репликами
+// dsn используется для отдельного соединения LISTEN (вне пула db)
+func NewBridge(
+	db DBExecutor,
+	bookingRepo BookingRepository,
+	dsn, channel string,
+	publisher *Publisher,
+	logger Logger,
+) (*Bridge, error) {
+	instance, err := newInstanceID()
+	if err != nil {
+		return nil, fmt.Errorf("bookingevents: generate instance id: %w", err)
+	}
+
+	return &Bridge{
+		db:          db,
+		bookingRepo: bookingRepo,
+		dsn:         dsn,
+		channel:     channel,
+		instance:    instance,
+		publisher:   publisher,
+		logger:      logger,
+	}, nil
+}
+
+// Forward отправляет событие другим репликам
+func (b *Bridge) Forward(ctx context.Context, event domain.BookingEvent) error {
+	return b.notify(ctx, notification{
+		Instance:  b.instance,
+		Type:      string(event.Type),
+		BookingID: event.Booking.ID,
+	})
+}
+
+// ForwardConfigChanged отправляет другим репликам событие изменения конфигурации
+func (b *Bridge) ForwardConfigChanged(ctx context.Context, event domain.ConfigChangedEvent) error {
+	return b.notify(ctx, notification{
+		Instance:  b.instance,
+		Type:      notificationConfigChanged,
+		CompanyID: event.CompanyID,
+	})
+}
+
+// notify отправляет уведомление в канал
+func (b *Bridge) notify(ctx context.Context, n notification) error {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+
+	// Не через dbmetrics.GetExecutor: NOTIFY в транзакции доставляется только после её фиксации,
+	// а событие публикуется уже после сохранения изменений
+	if _, err := b.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", b.channel, string(payload)); err != nil {
+		return fmt.Errorf("pg_notify: %w", err)
+	}
+	return nil
+}
+
+// Run слушает канал и блокируется до отмены контекста
+// Соединение переподключается автоматически; после переподключения слушатели
+// получают OnResync, так как события за время разрыва потеряны
+func (b *Bridge) Run(ctx context.Context) {
+	listener := pq.NewListener(b.dsn, minReconnectInterval, maxReconnectInterval, b.onListenerEvent)
+	defer listener.Close()
+
+	if err := listener.Listen(b.channel); err != nil {
+		// Соединение будет установлено при переподключении, подписка на канал восстановится
+		b.logger.Warn("BookingEvents: failed to listen channel %q: %v", b.channel, err)
+	}
+
+	b.logger.Info("BookingEvents: bridge started (channel=%s)", b.channel)
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.logger.Info("BookingEvents: bridge stopped")
+			return
+
+		case n := <-listener.NotificationChannel():
+			if n == nil {
+				// Соединение восстановлено после разрыва
+				b.publisher.Resync()
+				continue
+			}
+			b.handle(ctx, n.Extra)
+
+		case <-ticker.C:
+			if err := listener.Ping(); err != nil {
+				b.logger.Warn("BookingEvents: listener ping failed: %v", err)
+			}
+		}
+	}
+}
+
+// handle передает слушателям событие другой реплики
+func (b *Bridge) handle(ctx context.Context, payload string) {
+	var n notification
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		b.logger.Warn("BookingEvents: invalid notification payload %q: %v", payload, err)
+		return
+	}
+	if n.Instance == b.instance {
+		return
+	}
+
+	if n.Type == notificationConfigChanged {
+		b.publisher.PublishConfigLocal(domain.ConfigChangedEvent{CompanyID: n.CompanyID})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, loadTimeout)
+	defer cancel()
+
+	booking, err := b.bookingRepo.GetByID(ctx, n.BookingID)
+	if err != nil {
+		b.logger.Error("BookingEvents: failed to load booking id=%d for %s event: %v", n.BookingID, n.Type, err)
+		return
+	}
+
+	b.publisher.PublishLocal(domain.BookingEvent{
+		Type:    domain.BookingEventType(n.Type),
+		Booking: booking,
+	})
+}
+
+func (b *Bridge) onListenerEvent(event pq.ListenerEventType, err error) {
+	switch event {
+	case pq.ListenerEventDisconnected:
+		b.logger.Warn("BookingEvents: listener disconnected: %v", err)
+	case pq.ListenerEventReconnected:
+		b.logger.Info("BookingEvents: listener reconnected")
+	case pq.ListenerEventConnectionAttemptFailed:
+		b.logger.Warn("BookingEvents: listener connection attempt failed: %v", err)
+	}
+}
+
+// newInstanceID случайный идентификатор экземпляра сервиса
+func newInstanceID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
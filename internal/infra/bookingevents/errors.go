@@ -0,0 +1,11 @@
+package bookingevents
+
+import "errors"
+
+var (
+	// ErrSlowConsumer подписка закрыта: подписчик не успевал читать события и буфер переполнился
+	ErrSlowConsumer = errors.New("bookingevents: subscriber is too slow")
+
+	// ErrHubClosed подписка закрыта при остановке сервера
+	ErrHubClosed = errors.New("bookingevents: hub closed")
+)
@@ -0,0 +1,161 @@
+package bookingevents
+
+import (
+	"sync"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+)
+
+// Hub подписки на события бронирований компаний (поток бронирований для менеджеров)
+//
+// События не блокируют публикацию: у каждой подписки свой буфер, и подписка, буфер которой
+// переполнен, закрывается с ErrSlowConsumer (клиент переподключается и получает снимок заново)
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[*Subscription]struct{}
+	closed      bool
+}
+
+// NewHub создает хаб подписок на события бронирований компаний
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[int64]map[*Subscription]struct{}),
+	}
+}
+
+// Subscription подписка на события бронирований компании
+type Subscription struct {
+	hub       *Hub
+	companyID int64
+	events    chan domain.BookingEvent
+	resync    chan struct{}
+	done      chan struct{}
+	err       error
+}
+
+// Events события бронирований компании в порядке публикации
+func (s *Subscription) Events() <-chan domain.BookingEvent {
+	return s.events
+}
+
+// Resync получает сигнал, когда события могли быть пропущены и состояние нужно загрузить заново
+func (s *Subscription) Resync() <-chan struct{} {
+	return s.resync
+}
+
+// Done закрывается, когда подписка завершена (см. Err)
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err причина завершения подписки: ErrSlowConsumer, ErrHubClosed или nil после Cancel
+// Вызывается после закрытия Done
+func (s *Subscription) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	return s.err
+}
+
+// Cancel отменяет подписку (вызывается при отключении клиента, повторный вызов ничего не делает)
+func (s *Subscription) Cancel() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s, nil)
+}
+
+// Subscribe подписывается на события бронирований компании
+// buffer - сколько непрочитанных событий может накопиться до закрытия подписки
+func (h *Hub) Subscribe(companyID int64, buffer int) *Subscription {
+	sub := &Subscription{
+		hub:       h,
+		companyID: companyID,
+		events:    make(chan domain.BookingEvent, buffer),
+		resync:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		sub.err = ErrHubClosed
+		close(sub.done)
+		return sub
+	}
+
+	subs, ok := h.subscribers[companyID]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		h.subscribers[companyID] = subs
+	}
+	subs[sub] = struct{}{}
+
+	return sub
+}
+
+// OnBookingEvent передает событие подписчикам компании бронирования
+func (h *Hub) OnBookingEvent(event domain.BookingEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[event.Booking.CompanyID] {
+		select {
+		case sub.events <- event:
+		default:
+			h.remove(sub, ErrSlowConsumer)
+		}
+	}
+}
+
+// OnResync уведомляет все подписки о возможном пропуске событий
+func (h *Hub) OnResync() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			select {
+			case sub.resync <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// Close завершает все подписки с ErrHubClosed и запрещает новые (вызывается при остановке сервера)
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			h.remove(sub, ErrHubClosed)
+		}
+	}
+}
+
+// remove завершает подписку (вызывается под h.mu)
+func (h *Hub) remove(sub *Subscription, reason error) {
+	subs, ok := h.subscribers[sub.companyID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.companyID)
+	}
+
+	sub.err = reason
+	close(sub.done)
+}
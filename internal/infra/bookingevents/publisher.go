@@ -0,0 +1,127 @@
+// Package bookingevents доставка событий изменения бронирований потребителям в реальном времени
+//
+// Publisher получает события от сервисов после сохранения изменений и передает их слушателям
+// процесса (поток слотов, поток бронирований для менеджеров). Bridge пересылает события между
+// репликами через PostgreSQL LISTEN/NOTIFY. Hub - подписки на события бронирований компании.
+package bookingevents
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+)
+
+// Listener получатель событий бронирований внутри процесса
+// Методы вызываются синхронно из публикующего кода и не должны блокироваться
+type Listener interface {
+	OnBookingEvent(event domain.BookingEvent)
+	// OnResync вызывается, когда события могли быть пропущены (переподключение к PostgreSQL)
+	OnResync()
+}
+
+// ConfigListener получатель событий изменения конфигурации слотов
+// Необязательный интерфейс слушателя: события получают только реализующие его слушатели
+type ConfigListener interface {
+	OnConfigChanged(event domain.ConfigChangedEvent)
+}
+
+// Forwarder пересылает событие другим репликам сервиса
+type Forwarder interface {
+	Forward(ctx context.Context, event domain.BookingEvent) error
+	ForwardConfigChanged(ctx context.Context, event domain.ConfigChangedEvent) error
+}
+
+// Metrics учитывает события этой реплики в бизнес метриках
+type Metrics interface {
+	ObserveBookingEvent(ctx context.Context, event domain.BookingEvent)
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
+
+// Publisher публикация событий бронирований слушателям процесса и другим репликам
+type Publisher struct {
+	listeners []Listener
+	forwarder Forwarder
+	metrics   Metrics
+	logger    Logger
+}
+
+// NewPublisher создает публикатор событий бронирований
+func NewPublisher(logger Logger, listeners ...Listener) *Publisher {
+	return &Publisher{
+		listeners: listeners,
+		logger:    logger,
+	}
+}
+
+// SetForwarder включает пересылку публикуемых событий другим репликам
+// Вызывается при старте до начала обработки запросов
+func (p *Publisher) SetForwarder(forwarder Forwarder) {
+	p.forwarder = forwarder
+}
+
+// SetMetrics включает учет публикуемых событий в бизнес метриках
+// Вызывается при старте до начала обработки запросов
+func (p *Publisher) SetMetrics(metrics Metrics) {
+	p.metrics = metrics
+}
+
+// PublishBookingEvent уведомляет слушателей этого процесса и другие реплики
+// Ошибка пересылки только логируется: изменение бронирования уже сохранено
+func (p *Publisher) PublishBookingEvent(ctx context.Context, event domain.BookingEvent) {
+	p.PublishLocal(event)
+
+	if p.metrics != nil {
+		p.metrics.ObserveBookingEvent(ctx, event)
+	}
+
+	if p.forwarder == nil {
+		return
+	}
+	if err := p.forwarder.Forward(ctx, event); err != nil {
+		p.logger.Warn("bookingevents: failed to forward %s event of booking id=%d to other replicas: %v",
+			event.Type, event.Booking.ID, err)
+	}
+}
+
+// PublishLocal уведомляет только слушателей этого процесса
+func (p *Publisher) PublishLocal(event domain.BookingEvent) {
+	for _, l := range p.listeners {
+		l.OnBookingEvent(event)
+	}
+}
+
+// PublishConfigEvent уведомляет слушателей этого процесса и другие реплики об изменении конфигурации
+// Ошибка пересылки только логируется: конфигурация уже сохранена
+func (p *Publisher) PublishConfigEvent(ctx context.Context, event domain.ConfigChangedEvent) {
+	p.PublishConfigLocal(event)
+
+	if p.forwarder == nil {
+		return
+	}
+	if err := p.forwarder.ForwardConfigChanged(ctx, event); err != nil {
+		p.logger.Warn("bookingevents: failed to forward config change of company id=%d to other replicas: %v",
+			event.CompanyID, err)
+	}
+}
+
+// PublishConfigLocal уведомляет об изменении конфигурации только слушателей этого процесса
+func (p *Publisher) PublishConfigLocal(event domain.ConfigChangedEvent) {
+	for _, l := range p.listeners {
+		if cl, ok := l.(ConfigListener); ok {
+			cl.OnConfigChanged(event)
+		}
+	}
+}
+
+// Resync уведомляет слушателей о возможном пропуске событий
+func (p *Publisher) Resync() {
+	for _, l := range p.listeners {
+		l.OnResync()
+	}
+}
@@ -0,0 +1,52 @@
+package bookingmetrics
+
+import "strconv"
+
+// Дни расчета загрузки (значения метки day)
+const (
+	DayToday    = "today"
+	DayTomorrow = "tomorrow"
+)
+
+// OccupancySample загрузка адреса за день
+type OccupancySample struct {
+	CompanyID       int64
+	AddressID       int64
+	Day             string // today, tomorrow
+	BookedMinutes   int    // Суммарная длительность активных бронирований
+	CapacityMinutes int    // Время работы адреса, умноженное на число одновременных бронирований
+}
+
+type occupancyKey struct {
+	company string
+	address string
+	day     string
+}
+
+// RecordOccupancy заменяет значения загрузки слотов новым расчетом
+// Адреса компаний без собственной метки суммируются: метка address совпадает с меткой company
+func (r *Recorder) RecordOccupancy(samples []OccupancySample) {
+	booked := make(map[occupancyKey]int)
+	capacity := make(map[occupancyKey]int)
+
+	for _, sample := range samples {
+		if sample.CapacityMinutes <= 0 {
+			continue
+		}
+
+		company := r.companyLabel(sample.CompanyID)
+		address := company
+		if company == strconv.FormatInt(sample.CompanyID, 10) {
+			address = strconv.FormatInt(sample.AddressID, 10)
+		}
+
+		key := occupancyKey{company: company, address: address, day: sample.Day}
+		booked[key] += sample.BookedMinutes
+		capacity[key] += sample.CapacityMinutes
+	}
+
+	r.metrics.ResetSlotOccupancy()
+	for key, total := range capacity {
+		r.metrics.SetSlotOccupancy(r.serviceName, key.company, key.address, key.day, float64(booked[key])/float64(total))
+	}
+}
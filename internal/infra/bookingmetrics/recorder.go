@@ -0,0 +1,133 @@
+// Package bookingmetrics бизнес метрики бронирований
+//
+// Recorder учитывает созданные, отмененные бронирования и неявки (по событиям бронирований),
+// отказы в занятых слотах и загрузку слотов адресов. Метка company ограничивается настройкой
+// CompanyLabel: ID всех компаний, только компаний из списка (остальные - "other") или одно значение "all".
+package bookingmetrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/metrics"
+)
+
+// Режимы метки company
+const (
+	CompanyLabelID        = "id"        // ID каждой компании
+	CompanyLabelAllowlist = "allowlist" // ID компаний из CompanyAllowlist, остальные - "other"
+	CompanyLabelAggregate = "aggregate" // Все компании - "all"
+)
+
+// Значения меток вместо ID
+const (
+	labelOther = "other"
+	labelAll   = "all"
+)
+
+// Кто отменил бронирование (значения метки cancelled_by)
+const (
+	cancelledByUser    = "user"
+	cancelledByCompany = "company"
+)
+
+// Config настройки меток бизнес метрик
+type Config struct {
+	CompanyLabel     string  // id, allowlist, aggregate
+	CompanyAllowlist []int64 // Компании с собственной меткой в режиме allowlist
+}
+
+// Recorder записывает бизнес метрики бронирований
+type Recorder struct {
+	metrics     *metrics.Metrics
+	serviceName string
+	mode        string
+	allowlist   map[int64]struct{}
+	now         func() time.Time
+}
+
+// NewRecorder создает регистратор бизнес метрик
+func NewRecorder(m *metrics.Metrics, serviceName string, cfg Config) *Recorder {
+	allowlist := make(map[int64]struct{}, len(cfg.CompanyAllowlist))
+	for _, id := range cfg.CompanyAllowlist {
+		allowlist[id] = struct{}{}
+	}
+
+	return &Recorder{
+		metrics:     m,
+		serviceName: serviceName,
+		mode:        cfg.CompanyLabel,
+		allowlist:   allowlist,
+		now:         time.Now,
+	}
+}
+
+// ObserveBookingEvent учитывает событие бронирования: создание, отмену, неявку
+// Вызывается только для событий этой реплики, события других реплик учитывают они сами
+func (r *Recorder) ObserveBookingEvent(ctx context.Context, event domain.BookingEvent) {
+	booking := event.Booking
+	company := r.companyLabel(booking.CompanyID)
+	source := metrics.SourceFromContext(ctx)
+
+	switch event.Type {
+	case domain.BookingEventCreated:
+		r.metrics.RecordBookingCreated(r.serviceName, company, source, leadTime(booking, booking.CreatedAt))
+
+	case domain.BookingEventCancelled:
+		r.recordCancelled(booking, company, source)
+
+	case domain.BookingEventStatusChanged:
+		// Смена статуса на отмененный тоже учитывается как отмена
+		switch {
+		case booking.IsCancelled():
+			r.recordCancelled(booking, company, source)
+		case booking.Status == domain.StatusNoShow:
+			r.metrics.RecordBookingNoShow(r.serviceName, company, source)
+		}
+	}
+}
+
+// recordCancelled учитывает отмену бронирования клиентом или компанией
+func (r *Recorder) recordCancelled(booking *domain.Booking, company, source string) {
+	cancelledBy := cancelledByUser
+	if booking.Status == domain.StatusCancelledByCompany {
+		cancelledBy = cancelledByCompany
+	}
+	r.metrics.RecordBookingCancelled(r.serviceName, company, source, cancelledBy, leadTime(booking, r.now()))
+}
+
+// RecordSlotRejection учитывает отказ в бронировании: в слоте нет свободных мест
+func (r *Recorder) RecordSlotRejection(ctx context.Context, companyID int64) {
+	r.metrics.RecordSlotRejection(r.serviceName, r.companyLabel(companyID), metrics.SourceFromContext(ctx))
+}
+
+// companyLabel значение метки company с учетом режима
+func (r *Recorder) companyLabel(companyID int64) string {
+	switch r.mode {
+	case CompanyLabelAggregate:
+		return labelAll
+	case CompanyLabelAllowlist:
+		if _, ok := r.allowlist[companyID]; !ok {
+			return labelOther
+		}
+	}
+	return strconv.FormatInt(companyID, 10)
+}
+
+// leadTime время от момента at до начала бронирования в секундах (0, если бронирование уже началось)
+// Время начала - в часовом поясе сервиса, как при проверке времени бронирования
+func leadTime(booking *domain.Booking, at time.Time) float64 {
+	start, err := booking.StartTime.Parse(booking.BookingDate)
+	if err != nil {
+		return 0
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), 0, 0, time.Local)
+
+	seconds := start.Sub(at).Seconds()
+	if seconds < 0 {
+		return 0
+	}
+	return seconds
+}
@@ -0,0 +1,168 @@
+// Package health проверки готовности сервиса к обработке запросов (readiness)
+//
+// Checker выполняет проверки зависимостей параллельно и кеширует результат каждой на CacheTTL,
+// чтобы частые запросы балансировщика не нагружали PostgreSQL и внешние сервисы.
+// Некритичная зависимость (внешние сервисы с graceful degradation) при сбое переводит сервис
+// в состояние degraded, но не снимает его с балансировки.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Состояния сервиса и проверок
+const (
+	StatusOK           = "ok"
+	StatusDegraded     = "degraded"      // Недоступна некритичная зависимость, запросы принимаются
+	StatusUnavailable  = "unavailable"   // Недоступна критичная зависимость
+	StatusShuttingDown = "shutting_down" // Сервис завершает работу, новые запросы не принимаются
+)
+
+// Probe проверка зависимости, nil - зависимость доступна
+type Probe func(ctx context.Context) error
+
+// Check проверка одной зависимости
+type Check struct {
+	Name     string
+	Critical bool // Сбой снимает сервис с балансировки
+	Probe    Probe
+}
+
+// Result результат проверки зависимости
+type Result struct {
+	Status    string
+	Critical  bool
+	Error     string // Текст ошибки проверки: только для логов, клиентам не отдается
+	Latency   time.Duration
+	CheckedAt time.Time
+}
+
+// Report результат проверки готовности
+type Report struct {
+	Status string
+	Checks map[string]Result
+}
+
+// Ready возвращает true, если сервис может принимать запросы
+func (r Report) Ready() bool {
+	return r.Status == StatusOK || r.Status == StatusDegraded
+}
+
+// Config настройки проверок
+type Config struct {
+	Timeout  time.Duration // Таймаут одной проверки
+	CacheTTL time.Duration // Время, в течение которого результат проверки переиспользуется
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+}
+
+// Checker проверки готовности сервиса
+type Checker struct {
+	cfg          Config
+	checks       []*cachedCheck
+	shuttingDown atomic.Bool
+	logger       Logger
+}
+
+// cachedCheck проверка с последним результатом
+type cachedCheck struct {
+	Check
+	mu     sync.Mutex
+	result Result
+}
+
+// NewChecker создает проверки готовности
+func NewChecker(cfg Config, logger Logger, checks ...Check) *Checker {
+	cached := make([]*cachedCheck, len(checks))
+	for i, check := range checks {
+		cached[i] = &cachedCheck{Check: check}
+	}
+	return &Checker{cfg: cfg, checks: cached, logger: logger}
+}
+
+// SetShuttingDown переводит сервис в состояние завершения: проверка готовности больше не проходит
+// Вызывается до остановки HTTP сервера, чтобы балансировщик успел перестать направлять запросы
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check выполняет проверки (или берет результат из кеша) и возвращает состояние сервиса
+func (c *Checker) Check(ctx context.Context) Report {
+	results := make([]Result, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(c.checks))}
+	for i, check := range c.checks {
+		result := results[i]
+		report.Checks[check.Name] = result
+
+		if result.Status == StatusOK {
+			continue
+		}
+		if result.Critical {
+			report.Status = StatusUnavailable
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+
+	if c.shuttingDown.Load() {
+		report.Status = StatusShuttingDown
+	}
+	return report
+}
+
+// run выполняет проверку, если результат в кеше устарел
+// Одновременные запросы ждут одну проверку, а не запускают свои
+func (c *Checker) run(ctx context.Context, check *cachedCheck) Result {
+	check.mu.Lock()
+	defer check.mu.Unlock()
+
+	if !check.result.CheckedAt.IsZero() && time.Since(check.result.CheckedAt) < c.cfg.CacheTTL {
+		return check.result
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.cfg.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Probe(ctx)
+
+	result := Result{
+		Status:    StatusOK,
+		Critical:  check.Critical,
+		Latency:   time.Since(start),
+		CheckedAt: start,
+	}
+	if err != nil {
+		result.Status = StatusUnavailable
+		result.Error = err.Error()
+	}
+
+	// Ошибка логируется при каждой фактической проверке (не чаще раза в CacheTTL), восстановление - один раз
+	switch {
+	case err != nil:
+		c.logger.Warn("Health: %s check failed (critical=%t, latency=%s): %v", check.Name, check.Critical, result.Latency, err)
+	case check.result.Status == StatusUnavailable:
+		c.logger.Info("Health: %s check recovered", check.Name)
+	}
+
+	check.result = result
+	return result
+}
@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// PostgresProbe проверяет соединение с PostgreSQL
+func PostgresProbe(db *sql.DB) Probe {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+// MigrationsProbe проверяет, что миграции применены до версии expected и не остались в состоянии dirty
+// Версию хранит golang-migrate в таблице schema_migrations
+func MigrationsProbe(db *sql.DB, expected uint64) Probe {
+	return func(ctx context.Context) error {
+		var version uint64
+		var dirty bool
+		err := db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no migrations applied, expected version %d", expected)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read migration version: %w", err)
+		}
+
+		if dirty {
+			return fmt.Errorf("migration %d failed and left the database dirty", version)
+		}
+		if version < expected {
+			return fmt.Errorf("pending migrations: database version %d, expected %d", version, expected)
+		}
+		return nil
+	}
+}
+
+// HTTPProbe проверяет, что внешний сервис отвечает: любой ответ, кроме 5xx, считается доступностью
+// Запрос отправляется мимо устойчивого транспорта, чтобы не влиять на circuit breaker и повторы
+func HTTPProbe(client *http.Client, url string) Probe {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// LatestMigration возвращает версию последней миграции в каталоге (NNNNNN_name.up.sql)
+func LatestMigration(dir string) (uint64, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.up.sql"))
+	if err != nil {
+		return 0, err
+	}
+	if len(files) == 0 {
+		return 0, fmt.Errorf("no migrations found in %s", dir)
+	}
+
+	var latest uint64
+	for _, file := range files {
+		prefix, _, _ := strings.Cut(filepath.Base(file), "_")
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration file name %s: %w", file, err)
+		}
+		latest = max(latest, version)
+	}
+	return latest, nil
+}
@@ -0,0 +1,196 @@
+// Package slotevents доставка событий изменения доступности слотов подписчикам (SSE)
+//
+// Hub - подписки внутри процесса: получает события бронирований и изменения конфигурации
+// (bookingevents.Listener, bookingevents.ConfigListener), подписчики (каждое SSE соединение)
+// получают сигнал и пересчитывают слоты.
+package slotevents
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+)
+
+// Hub pub/sub событий изменения слотов внутри процесса
+//
+// Сигнал подписчику не блокирует публикацию: канал подписки вмещает один сигнал, и пока
+// подписчик не прочитал предыдущий, новые события по тому же ключу схлопываются в него
+//
+// Для каждого ключа с подписчиками хранится версия - значение общего счетчика на момент последнего
+// изменения. Подписчики одного ключа сравнивают версии, чтобы рассчитать слоты один раз на изменение
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*subscription]struct{}
+	versions    map[string]uint64
+	seq         uint64
+	count       int
+	closed      bool
+}
+
+type subscription struct {
+	ch chan struct{}
+}
+
+// NewHub создает pub/sub событий изменения слотов
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[string]map[*subscription]struct{}),
+		versions:    make(map[string]uint64),
+	}
+}
+
+// OnBookingEvent уведомляет подписчиков адреса и даты бронирования
+func (h *Hub) OnBookingEvent(event domain.BookingEvent) {
+	if !event.ChangesSlots() {
+		return
+	}
+	h.Publish(domain.SlotsChangedFor(event.Booking))
+}
+
+// Publish уведомляет подписчиков адреса и даты
+func (h *Hub) Publish(event domain.SlotsChangedEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.notifyKey(event.Key())
+}
+
+// OnConfigChanged уведомляет подписчиков всех адресов компании
+func (h *Hub) OnConfigChanged(event domain.ConfigChangedEvent) {
+	prefix := event.KeyPrefix()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key := range h.subscribers {
+		if strings.HasPrefix(key, prefix) {
+			h.notifyKey(key)
+		}
+	}
+}
+
+// OnResync уведомляет всех подписчиков (события других реплик могли быть пропущены)
+func (h *Hub) OnResync() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key := range h.subscribers {
+		h.notifyKey(key)
+	}
+}
+
+// Version возвращает версию слотов адреса компании на дату
+// Версия растет при каждом изменении; у ключа без подписчиков версия 0
+func (h *Hub) Version(companyID, addressID int64, date time.Time) uint64 {
+	key := domain.SlotsChangedEvent{CompanyID: companyID, AddressID: addressID, Date: date}.Key()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.versions[key]
+}
+
+// Subscribe подписывается на изменения слотов адреса компании на дату
+// Канал получает сигнал при каждом изменении (несколько изменений подряд могут прийти одним сигналом)
+// и закрывается при Close. cancel отменяет подписку и должен быть вызван при отключении клиента
+func (h *Hub) Subscribe(companyID, addressID int64, date time.Time) (<-chan struct{}, func()) {
+	key := domain.SlotsChangedEvent{CompanyID: companyID, AddressID: addressID, Date: date}.Key()
+	sub := &subscription{ch: make(chan struct{}, 1)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+
+	subs, ok := h.subscribers[key]
+	if !ok {
+		subs = make(map[*subscription]struct{})
+		h.subscribers[key] = subs
+		// Изменения без подписчиков не отслеживались: новая версия не совпадет ни с одной рассчитанной ранее
+		h.seq++
+		h.versions[key] = h.seq
+	}
+	subs[sub] = struct{}{}
+	h.count++
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() { h.unsubscribe(key, sub) })
+	}
+}
+
+// Subscribers возвращает количество активных подписок
+func (h *Hub) Subscribers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.count
+}
+
+// Close закрывает каналы всех подписок и запрещает новые (вызывается при остановке сервера,
+// чтобы SSE соединения завершились до таймаута graceful shutdown)
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for key, subs := range h.subscribers {
+		for sub := range subs {
+			close(sub.ch)
+		}
+		delete(h.subscribers, key)
+	}
+	clear(h.versions)
+	h.count = 0
+}
+
+func (h *Hub) unsubscribe(key string, sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subscribers[key]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, key)
+		delete(h.versions, key)
+	}
+	h.count--
+}
+
+// notifyKey повышает версию ключа и уведомляет его подписчиков (вызывается под h.mu)
+func (h *Hub) notifyKey(key string) {
+	subs, ok := h.subscribers[key]
+	if !ok {
+		return
+	}
+
+	h.seq++
+	h.versions[key] = h.seq
+	for sub := range subs {
+		notify(sub)
+	}
+}
+
+// notify отправляет сигнал без блокировки (сигнал уже ожидает - новый не нужен)
+func notify(sub *subscription) {
+	select {
+	case sub.ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,253 @@
+package booking
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
+	"github.com/m04kA/SMC-BookingService/pkg/psqlbuilder"
+)
+
+// Search ищет бронирования по всем компаниям (для администраторов платформы)
+// Сортировка: сначала новые (booking_date, start_time, id по убыванию)
+func (r *Repository) Search(ctx context.Context, filter domain.AdminBookingsFilter) ([]*domain.Booking, error) {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	selectBuilder := psqlbuilder.Select(
+		"id",
+		"user_id",
+		"company_id",
+		"address_id",
+		"service_id",
+		"car_id",
+		"booking_date",
+		"start_time",
+		"duration_minutes",
+		"status",
+		"service_name",
+		"service_price",
+		"car_brand",
+		"car_model",
+		"car_license_plate",
+		"notes",
+		"cancellation_reason",
+		"cancelled_at",
+		"car_details_pending",
+		"created_at",
+		"updated_at",
+	).
+		From("bookings")
+
+	if filter.CompanyID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"company_id": *filter.CompanyID})
+	}
+	if filter.UserID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"user_id": *filter.UserID})
+	}
+	if filter.AddressID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"address_id": *filter.AddressID})
+	}
+	if filter.ServiceID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"service_id": *filter.ServiceID})
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = string(s)
+		}
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"status": statuses})
+	}
+	if filter.StartDate != nil {
+		selectBuilder = selectBuilder.Where(squirrel.GtOrEq{"booking_date": *filter.StartDate})
+	}
+	if filter.EndDate != nil {
+		selectBuilder = selectBuilder.Where(squirrel.LtOrEq{"booking_date": *filter.EndDate})
+	}
+	if filter.LicensePlate != nil {
+		selectBuilder = selectBuilder.Where(squirrel.ILike{"car_license_plate": "%" + escapeLike(*filter.LicensePlate) + "%"})
+	}
+
+	selectBuilder = selectBuilder.
+		OrderBy("booking_date DESC", "start_time DESC", "id DESC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: Search - build select query: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Search - execute query: %w", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	return r.scanBookings(rows)
+}
+
+// Restore возвращает отмененное бронирование в активный статус и очищает данные отмены
+// Возвращает ErrBookingNotFound, если бронирование не найдено или уже не отменено
+func (r *Repository) Restore(ctx context.Context, id int64, status domain.BookingStatus) error {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Update("bookings").
+		Set("status", status).
+		Set("cancellation_reason", nil).
+		Set("cancelled_at", nil).
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Eq{"status": []string{
+			string(domain.StatusCancelledByUser),
+			string(domain.StatusCancelledByCompany),
+		}}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Restore - build update query: %w", ErrBuildQuery, err)
+	}
+
+	result, err := executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: Restore - execute update: %w", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: Restore - get rows affected: %w", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBookingNotFound
+	}
+
+	return nil
+}
+
+// Reassign передает бронирование другому пользователю
+func (r *Repository) Reassign(ctx context.Context, id int64, userID int64) error {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Update("bookings").
+		Set("user_id", userID).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Reassign - build update query: %w", ErrBuildQuery, err)
+	}
+
+	result, err := executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: Reassign - execute update: %w", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: Reassign - get rows affected: %w", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBookingNotFound
+	}
+
+	return nil
+}
+
+// SetAuditContext задает контекст изменений для записи в booking_history триггером
+// Действует до конца текущей транзакции, вне транзакции возвращает ErrTransaction
+func (r *Repository) SetAuditContext(ctx context.Context, audit domain.AuditContext) error {
+	if !dbmetrics.IsInTransaction(ctx) {
+		return fmt.Errorf("%w: SetAuditContext - audit context requires a transaction", ErrTransaction)
+	}
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query := `SELECT
+		set_config('bookingservice.action', $1, true),
+		set_config('bookingservice.actor_id', $2, true),
+		set_config('bookingservice.actor_role', $3, true),
+		set_config('bookingservice.reason', $4, true)`
+
+	_, err := executor.ExecContext(ctx, query,
+		audit.Action,
+		strconv.FormatInt(audit.ActorID, 10),
+		audit.ActorRole,
+		audit.Reason,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: SetAuditContext - execute: %w", ErrExecQuery, err)
+	}
+
+	return nil
+}
+
+// GetHistory получает историю изменений бронирования в хронологическом порядке
+func (r *Repository) GetHistory(ctx context.Context, bookingID int64) ([]*domain.BookingHistoryEntry, error) {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Select(
+		"id",
+		"booking_id",
+		"operation",
+		"action",
+		"actor_id",
+		"actor_role",
+		"reason",
+		"changes",
+		"created_at",
+	).
+		From("booking_history").
+		Where(squirrel.Eq{"booking_id": bookingID}).
+		OrderBy("id ASC").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetHistory - build select query: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetHistory - execute query: %w", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	entries := make([]*domain.BookingHistoryEntry, 0)
+	for rows.Next() {
+		var entry domain.BookingHistoryEntry
+		var changes []byte
+		var createdAt sql.NullTime
+
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.BookingID,
+			&entry.Operation,
+			&entry.Action,
+			&entry.ActorID,
+			&entry.ActorRole,
+			&entry.Reason,
+			&changes,
+			&createdAt,
+		); err != nil {
+			return nil, fmt.Errorf("%w: GetHistory - scan row: %w", ErrScanRow, err)
+		}
+
+		entry.Changes = changes
+		entry.CreatedAt = createdAt.Time
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: GetHistory - rows error: %w", ErrScanRow, err)
+	}
+
+	return entries, nil
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,39 @@
+package booking
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+)
+
+// applyPage добавляет к запросу keyset-пагинацию по (booking_date, start_time, id)
+// defaultDirection используется, если направление сортировки не указано
+// Для определения следующей страницы вызывающий код запрашивает на одну запись больше (см. domain.TrimPage)
+func applyPage(builder squirrel.SelectBuilder, page domain.BookingPage, defaultDirection domain.SortDirection) squirrel.SelectBuilder {
+	direction := page.Direction
+	if direction == "" {
+		direction = defaultDirection
+	}
+
+	if page.After != nil {
+		op := "<"
+		if direction == domain.SortAsc {
+			op = ">"
+		}
+		builder = builder.Where(
+			squirrel.Expr("(booking_date, start_time, id) "+op+" (?, ?, ?)",
+				page.After.BookingDate, page.After.StartTime, page.After.ID),
+		)
+	}
+
+	if direction == domain.SortAsc {
+		builder = builder.OrderBy("booking_date ASC, start_time ASC, id ASC")
+	} else {
+		builder = builder.OrderBy("booking_date DESC, start_time DESC, id DESC")
+	}
+
+	if page.Limit > 0 {
+		builder = builder.Limit(uint64(page.Limit))
+	}
+
+	return builder
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/m04kA/SMC-BookingService/internal/domain"
@@ -53,6 +54,7 @@ func (r *Repository) Create(ctx context.Context, booking *domain.Booking) (*doma
 			"car_model",
 			"car_license_plate",
 			"notes",
+			"car_details_pending",
 		).
 		Values(
 			booking.UserID,
@@ -70,12 +72,13 @@ func (r *Repository) Create(ctx context.Context, booking *domain.Booking) (*doma
 			booking.CarModel,
 			booking.CarLicensePlate,
 			booking.Notes,
+			booking.CarDetailsPending,
 		).
 		Suffix("RETURNING id, created_at, updated_at").
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: Create - build insert query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: Create - build insert query: %w", ErrBuildQuery, err)
 	}
 
 	var createdAt, updatedAt sql.NullTime
@@ -86,7 +89,7 @@ func (r *Repository) Create(ctx context.Context, booking *domain.Booking) (*doma
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: Create - execute insert: %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: Create - execute insert: %w", ErrExecQuery, err)
 	}
 
 	booking.CreatedAt = createdAt.Time
@@ -118,6 +121,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Booking, er
 		"notes",
 		"cancellation_reason",
 		"cancelled_at",
+		"car_details_pending",
 		"created_at",
 		"updated_at",
 	).
@@ -126,7 +130,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Booking, er
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByID - build select query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: GetByID - build select query: %w", ErrBuildQuery, err)
 	}
 
 	var booking domain.Booking
@@ -151,6 +155,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Booking, er
 		&booking.Notes,
 		&booking.CancellationReason,
 		&booking.CancelledAt,
+		&booking.CarDetailsPending,
 		&createdAt,
 		&updatedAt,
 	)
@@ -159,7 +164,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Booking, er
 		return nil, ErrBookingNotFound
 	}
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByID - scan booking: %v", ErrScanRow, err)
+		return nil, fmt.Errorf("%w: GetByID - scan booking: %w", ErrScanRow, err)
 	}
 
 	booking.CreatedAt = createdAt.Time
@@ -169,8 +174,9 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Booking, er
 }
 
 // GetByUserID получает список бронирований пользователя
-// Опционально фильтрует по статусу
-func (r *Repository) GetByUserID(ctx context.Context, userID int64, status *domain.BookingStatus) ([]*domain.Booking, error) {
+// Опционально фильтрует по статусу и периоду, поддерживает keyset-пагинацию
+// По умолчанию сортирует от новых к старым
+func (r *Repository) GetByUserID(ctx context.Context, filter domain.UserBookingsFilter) ([]*domain.Booking, error) {
 	executor := dbmetrics.GetExecutor(ctx, r.db)
 
 	selectBuilder := psqlbuilder.Select(
@@ -192,26 +198,36 @@ func (r *Repository) GetByUserID(ctx context.Context, userID int64, status *doma
 		"notes",
 		"cancellation_reason",
 		"cancelled_at",
+		"car_details_pending",
 		"created_at",
 		"updated_at",
 	).
 		From("bookings").
-		Where(squirrel.Eq{"user_id": userID}).
-		OrderBy("booking_date DESC, start_time DESC")
+		Where(squirrel.Eq{"user_id": filter.UserID})
 
 	// Фильтрация по статусу, если указан
-	if status != nil {
-		selectBuilder = selectBuilder.Where(squirrel.Eq{"status": *status})
+	if filter.Status != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"status": *filter.Status})
 	}
 
+	// Фильтрация по периоду
+	if filter.StartDate != nil {
+		selectBuilder = selectBuilder.Where(squirrel.GtOrEq{"booking_date": *filter.StartDate})
+	}
+	if filter.EndDate != nil {
+		selectBuilder = selectBuilder.Where(squirrel.LtOrEq{"booking_date": *filter.EndDate})
+	}
+
+	selectBuilder = applyPage(selectBuilder, filter.Page, domain.SortDesc)
+
 	query, args, err := selectBuilder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByUserID - build select query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: GetByUserID - build select query: %w", ErrBuildQuery, err)
 	}
 
 	rows, err := executor.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByUserID - execute query: %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: GetByUserID - execute query: %w", ErrExecQuery, err)
 	}
 	defer rows.Close()
 
@@ -220,9 +236,14 @@ func (r *Repository) GetByUserID(ctx context.Context, userID int64, status *doma
 
 // GetByCompanyWithFilter получает бронирования компании с гибкой фильтрацией
 // Поддерживает фильтрацию по:
+// - Адресу, услуге и клиенту (AddressID, ServiceID, UserID) - опционально
+// - Нескольким адресам (AddressIDs) - опционально
 // - Периоду (StartDate, EndDate) - опционально
-// - Статусу (Status) - опционально
+// - Времени начала в течение дня (StartTimeFrom, StartTimeTo) - опционально
+// - Статусам (Statuses) - опционально
+// - Части госномера без учета регистра (LicensePlate) - опционально
 // - Включению неактивных бронирований (IncludeInactive)
+// Поддерживает keyset-пагинацию (Page), без нее возвращает все записи
 //
 // Примеры использования:
 //
@@ -238,15 +259,72 @@ func (r *Repository) GetByUserID(ctx context.Context, userID int64, status *doma
 //    end := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
 //    filter := domain.CompanyBookingsFilter{CompanyID: 123, StartDate: &start, EndDate: &end}
 //
-// 4. Только подтвержденные бронирования:
-//    status := domain.StatusConfirmed
-//    filter := domain.CompanyBookingsFilter{CompanyID: 123, Status: &status}
+// 4. Только подтвержденные и ожидающие бронирования:
+//    statuses := []domain.BookingStatus{domain.StatusConfirmed, domain.StatusPending}
+//    filter := domain.CompanyBookingsFilter{CompanyID: 123, Statuses: statuses}
 //
 // 5. Все бронирования включая отменённые:
 //    filter := domain.CompanyBookingsFilter{CompanyID: 123, IncludeInactive: true}
 func (r *Repository) GetByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter) ([]*domain.Booking, error) {
 	executor := dbmetrics.GetExecutor(ctx, r.db)
 
+	selectBuilder := companyBookingsQuery(filter)
+
+	// Если используется транзакция, добавляем FOR UPDATE для блокировки
+	// (только для конкретной даты - для usecase создания бронирования)
+	if dbmetrics.IsInTransaction(ctx) && filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.Equal(*filter.EndDate) {
+		selectBuilder = selectBuilder.Suffix("FOR UPDATE")
+	}
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetByCompanyWithFilter - build select query: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetByCompanyWithFilter - execute query: %w", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	return r.scanBookings(rows)
+}
+
+// StreamByCompanyWithFilter построчно передает бронирования компании в fn без загрузки всей выборки в память
+// Фильтры и сортировка те же, что у GetByCompanyWithFilter. Ошибка fn прерывает чтение и возвращается как есть
+func (r *Repository) StreamByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter, fn func(*domain.Booking) error) error {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := companyBookingsQuery(filter).ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: StreamByCompanyWithFilter - build select query: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: StreamByCompanyWithFilter - execute query: %w", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		booking, err := r.scanBooking(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(booking); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: StreamByCompanyWithFilter - rows error: %w", ErrScanRow, err)
+	}
+
+	return nil
+}
+
+// companyBookingsQuery строит запрос бронирований компании по фильтру (с сортировкой и пагинацией)
+func companyBookingsQuery(filter domain.CompanyBookingsFilter) squirrel.SelectBuilder {
 	selectBuilder := psqlbuilder.Select(
 		"id",
 		"user_id",
@@ -266,16 +344,26 @@ func (r *Repository) GetByCompanyWithFilter(ctx context.Context, filter domain.C
 		"notes",
 		"cancellation_reason",
 		"cancelled_at",
+		"car_details_pending",
 		"created_at",
 		"updated_at",
 	).
 		From("bookings").
 		Where(squirrel.Eq{"company_id": filter.CompanyID})
 
-	// Фильтрация по адресу (если указан)
+	// Фильтрация по адресу, услуге и клиенту (если указаны)
 	if filter.AddressID != nil {
 		selectBuilder = selectBuilder.Where(squirrel.Eq{"address_id": *filter.AddressID})
 	}
+	if len(filter.AddressIDs) > 0 {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"address_id": filter.AddressIDs})
+	}
+	if filter.ServiceID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"service_id": *filter.ServiceID})
+	}
+	if filter.UserID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"user_id": *filter.UserID})
+	}
 
 	// Фильтрация по периоду
 	if filter.StartDate != nil {
@@ -285,11 +373,28 @@ func (r *Repository) GetByCompanyWithFilter(ctx context.Context, filter domain.C
 		selectBuilder = selectBuilder.Where(squirrel.LtOrEq{"booking_date": *filter.EndDate})
 	}
 
-	// Фильтрация по статусу
-	if filter.Status != nil {
-		selectBuilder = selectBuilder.Where(squirrel.Eq{"status": *filter.Status})
+	// Фильтрация по времени начала в течение дня
+	if filter.StartTimeFrom != nil {
+		selectBuilder = selectBuilder.Where(squirrel.GtOrEq{"start_time": *filter.StartTimeFrom})
+	}
+	if filter.StartTimeTo != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Lt{"start_time": *filter.StartTimeTo})
+	}
+
+	// Поиск по части госномера (индекс idx_bookings_license_plate_trgm)
+	if filter.LicensePlate != nil {
+		selectBuilder = selectBuilder.Where(squirrel.ILike{"car_license_plate": "%" + escapeLike(*filter.LicensePlate) + "%"})
+	}
+
+	// Фильтрация по статусам
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = string(s)
+		}
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"status": statuses})
 	} else if !filter.IncludeInactive {
-		// Если не указан конкретный статус и не нужны неактивные - исключаем их
+		// Если статусы не указаны и не нужны неактивные - исключаем их
 		inactiveStatusStrings := make([]string, len(domain.InactiveStatuses))
 		for i, s := range domain.InactiveStatuses {
 			inactiveStatusStrings[i] = string(s)
@@ -297,33 +402,15 @@ func (r *Repository) GetByCompanyWithFilter(ctx context.Context, filter domain.C
 		selectBuilder = selectBuilder.Where(squirrel.NotEq{"status": inactiveStatusStrings})
 	}
 
-	// Определяем сортировку в зависимости от фильтра
+	// Определяем сортировку по умолчанию в зависимости от фильтра
+	// Для конкретной даты - по времени начала (ASC), для периода или всех бронирований - сначала новые (DESC)
+	defaultDirection := domain.SortDesc
 	if filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.Equal(*filter.EndDate) {
-		// Для конкретной даты сортируем по времени начала (ASC)
-		selectBuilder = selectBuilder.OrderBy("start_time ASC")
-	} else {
-		// Для периода или всех бронирований сортируем по дате и времени (DESC - сначала новые)
-		selectBuilder = selectBuilder.OrderBy("booking_date DESC, start_time DESC")
-	}
-
-	// Если используется транзакция, добавляем FOR UPDATE для блокировки
-	// (только для конкретной даты - для usecase создания бронирования)
-	if dbmetrics.IsInTransaction(ctx) && filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.Equal(*filter.EndDate) {
-		selectBuilder = selectBuilder.Suffix("FOR UPDATE")
+		defaultDirection = domain.SortAsc
 	}
+	selectBuilder = applyPage(selectBuilder, filter.Page, defaultDirection)
 
-	query, args, err := selectBuilder.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("%w: GetByCompanyWithFilter - build select query: %v", ErrBuildQuery, err)
-	}
-
-	rows, err := executor.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("%w: GetByCompanyWithFilter - execute query: %v", ErrExecQuery, err)
-	}
-	defer rows.Close()
-
-	return r.scanBookings(rows)
+	return selectBuilder
 }
 
 // GetUserIDsByCompanyID получает список всех пользователей, которые когда-либо бронировали услуги компании
@@ -338,12 +425,12 @@ func (r *Repository) GetUserIDsByCompanyID(ctx context.Context, companyID int64)
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - build select query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - build select query: %w", ErrBuildQuery, err)
 	}
 
 	rows, err := executor.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - execute query: %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - execute query: %w", ErrExecQuery, err)
 	}
 	defer rows.Close()
 
@@ -351,18 +438,151 @@ func (r *Repository) GetUserIDsByCompanyID(ctx context.Context, companyID int64)
 	for rows.Next() {
 		var userID int64
 		if err := rows.Scan(&userID); err != nil {
-			return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - scan user_id: %v", ErrScanRow, err)
+			return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - scan user_id: %w", ErrScanRow, err)
 		}
 		userIDs = append(userIDs, userID)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - rows error: %v", ErrScanRow, err)
+		return nil, fmt.Errorf("%w: GetUserIDsByCompanyID - rows error: %w", ErrScanRow, err)
 	}
 
 	return userIDs, nil
 }
 
+// GetAddressLoad получает загрузку адресов по активным бронированиям за период (включительно)
+// Возвращает только адреса и даты, на которые есть активные бронирования
+func (r *Repository) GetAddressLoad(ctx context.Context, startDate, endDate time.Time) ([]domain.AddressLoad, error) {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	inactiveStatusStrings := make([]string, len(domain.InactiveStatuses))
+	for i, s := range domain.InactiveStatuses {
+		inactiveStatusStrings[i] = string(s)
+	}
+
+	query, args, err := psqlbuilder.Select(
+		"company_id",
+		"address_id",
+		"booking_date",
+		"COUNT(*)",
+		"COALESCE(SUM(duration_minutes), 0)",
+	).
+		From("bookings").
+		Where(squirrel.GtOrEq{"booking_date": startDate}).
+		Where(squirrel.LtOrEq{"booking_date": endDate}).
+		Where(squirrel.NotEq{"status": inactiveStatusStrings}).
+		GroupBy("company_id", "address_id", "booking_date").
+		OrderBy("company_id ASC", "address_id ASC", "booking_date ASC").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetAddressLoad - build select query: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetAddressLoad - execute query: %w", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	loads := make([]domain.AddressLoad, 0)
+	for rows.Next() {
+		var load domain.AddressLoad
+		if err := rows.Scan(&load.CompanyID, &load.AddressID, &load.Date, &load.Bookings, &load.BookedMinutes); err != nil {
+			return nil, fmt.Errorf("%w: GetAddressLoad - scan address load: %w", ErrScanRow, err)
+		}
+		loads = append(loads, load)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: GetAddressLoad - rows error: %w", ErrScanRow, err)
+	}
+
+	return loads, nil
+}
+
+// GetPendingCarDetails получает бронирования, ожидающие дозаполнения данных автомобиля
+// Возвращает не более limit самых старых бронирований
+func (r *Repository) GetPendingCarDetails(ctx context.Context, limit int) ([]*domain.Booking, error) {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Select(
+		"id",
+		"user_id",
+		"company_id",
+		"address_id",
+		"service_id",
+		"car_id",
+		"booking_date",
+		"start_time",
+		"duration_minutes",
+		"status",
+		"service_name",
+		"service_price",
+		"car_brand",
+		"car_model",
+		"car_license_plate",
+		"notes",
+		"cancellation_reason",
+		"cancelled_at",
+		"car_details_pending",
+		"created_at",
+		"updated_at",
+	).
+		From("bookings").
+		Where(squirrel.Eq{"car_details_pending": true}).
+		OrderBy("created_at ASC").
+		Limit(uint64(limit)).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetPendingCarDetails - build select query: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetPendingCarDetails - execute query: %w", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	return r.scanBookings(rows)
+}
+
+// UpdateCarDetails дозаполняет данные автомобиля и снимает флаг car_details_pending
+// Обновляет только бронирования, которые ещё ожидают дозаполнения
+func (r *Repository) UpdateCarDetails(ctx context.Context, id int64, details domain.CarDetails) error {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Update("bookings").
+		Set("car_id", details.CarID).
+		Set("car_brand", details.Brand).
+		Set("car_model", details.Model).
+		Set("car_license_plate", details.LicensePlate).
+		Set("car_details_pending", false).
+		Where(squirrel.Eq{"id": id, "car_details_pending": true}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: UpdateCarDetails - build update query: %w", ErrBuildQuery, err)
+	}
+
+	result, err := executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: UpdateCarDetails - execute update: %w", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: UpdateCarDetails - get rows affected: %w", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBookingNotFound
+	}
+
+	return nil
+}
+
 // UpdateStatus обновляет статус бронирования
 func (r *Repository) UpdateStatus(ctx context.Context, id int64, status domain.BookingStatus) error {
 	executor := dbmetrics.GetExecutor(ctx, r.db)
@@ -373,17 +593,17 @@ func (r *Repository) UpdateStatus(ctx context.Context, id int64, status domain.B
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("%w: UpdateStatus - build update query: %v", ErrBuildQuery, err)
+		return fmt.Errorf("%w: UpdateStatus - build update query: %w", ErrBuildQuery, err)
 	}
 
 	result, err := executor.ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%w: UpdateStatus - execute update: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: UpdateStatus - execute update: %w", ErrExecQuery, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: UpdateStatus - get rows affected: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: UpdateStatus - get rows affected: %w", ErrExecQuery, err)
 	}
 
 	if rowsAffected == 0 {
@@ -405,17 +625,17 @@ func (r *Repository) Cancel(ctx context.Context, id int64, status domain.Booking
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("%w: Cancel - build update query: %v", ErrBuildQuery, err)
+		return fmt.Errorf("%w: Cancel - build update query: %w", ErrBuildQuery, err)
 	}
 
 	result, err := executor.ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%w: Cancel - execute update: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: Cancel - execute update: %w", ErrExecQuery, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: Cancel - get rows affected: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: Cancel - get rows affected: %w", ErrExecQuery, err)
 	}
 
 	if rowsAffected == 0 {
@@ -435,17 +655,17 @@ func (r *Repository) Delete(ctx context.Context, id int64) error {
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("%w: Delete - build delete query: %v", ErrBuildQuery, err)
+		return fmt.Errorf("%w: Delete - build delete query: %w", ErrBuildQuery, err)
 	}
 
 	result, err := executor.ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%w: Delete - execute delete: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: Delete - execute delete: %w", ErrExecQuery, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: Delete - get rows affected: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: Delete - get rows affected: %w", ErrExecQuery, err)
 	}
 
 	if rowsAffected == 0 {
@@ -460,45 +680,55 @@ func (r *Repository) scanBookings(rows *sql.Rows) ([]*domain.Booking, error) {
 	bookings := make([]*domain.Booking, 0)
 
 	for rows.Next() {
-		var booking domain.Booking
-		var createdAt, updatedAt sql.NullTime
-
-		err := rows.Scan(
-			&booking.ID,
-			&booking.UserID,
-			&booking.CompanyID,
-			&booking.AddressID,
-			&booking.ServiceID,
-			&booking.CarID,
-			&booking.BookingDate,
-			&booking.StartTime,
-			&booking.DurationMinutes,
-			&booking.Status,
-			&booking.ServiceName,
-			&booking.ServicePrice,
-			&booking.CarBrand,
-			&booking.CarModel,
-			&booking.CarLicensePlate,
-			&booking.Notes,
-			&booking.CancellationReason,
-			&booking.CancelledAt,
-			&createdAt,
-			&updatedAt,
-		)
-
+		booking, err := r.scanBooking(rows)
 		if err != nil {
-			return nil, fmt.Errorf("%w: scanBookings - scan row: %v", ErrScanRow, err)
+			return nil, err
 		}
-
-		booking.CreatedAt = createdAt.Time
-		booking.UpdatedAt = updatedAt.Time
-
-		bookings = append(bookings, &booking)
+		bookings = append(bookings, booking)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%w: scanBookings - rows error: %v", ErrScanRow, err)
+		return nil, fmt.Errorf("%w: scanBookings - rows error: %w", ErrScanRow, err)
 	}
 
 	return bookings, nil
 }
+
+// scanBooking сканирует текущую строку результата в бронирование
+func (r *Repository) scanBooking(rows *sql.Rows) (*domain.Booking, error) {
+	var booking domain.Booking
+	var createdAt, updatedAt sql.NullTime
+
+	err := rows.Scan(
+		&booking.ID,
+		&booking.UserID,
+		&booking.CompanyID,
+		&booking.AddressID,
+		&booking.ServiceID,
+		&booking.CarID,
+		&booking.BookingDate,
+		&booking.StartTime,
+		&booking.DurationMinutes,
+		&booking.Status,
+		&booking.ServiceName,
+		&booking.ServicePrice,
+		&booking.CarBrand,
+		&booking.CarModel,
+		&booking.CarLicensePlate,
+		&booking.Notes,
+		&booking.CancellationReason,
+		&booking.CancelledAt,
+		&booking.CarDetailsPending,
+		&createdAt,
+		&updatedAt,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: scanBooking - scan row: %w", ErrScanRow, err)
+	}
+
+	booking.CreatedAt = createdAt.Time
+	booking.UpdatedAt = updatedAt.Time
+
+	return &booking, nil
+}
@@ -0,0 +1,8 @@
+package calendar
+
+import (
+	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
+)
+
+// Переиспользуем интерфейсы из dbmetrics для работы с БД
+type DBExecutor = dbmetrics.DBExecutor
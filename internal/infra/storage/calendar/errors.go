@@ -0,0 +1,20 @@
+package calendar
+
+import "errors"
+
+var (
+	// ErrFeedNotFound возвращается, когда действующий токен подписки не найден
+	ErrFeedNotFound = errors.New("calendar.repository: feed not found")
+
+	// ErrDuplicateFeed возвращается, когда у владельца уже есть действующий токен
+	ErrDuplicateFeed = errors.New("calendar.repository: active feed already exists")
+
+	// ErrBuildQuery возвращается при ошибке построения SQL запроса
+	ErrBuildQuery = errors.New("calendar.repository: failed to build query")
+
+	// ErrExecQuery возвращается при ошибке выполнения SQL запроса
+	ErrExecQuery = errors.New("calendar.repository: failed to execute query")
+
+	// ErrScanRow возвращается при ошибке сканирования результата запроса
+	ErrScanRow = errors.New("calendar.repository: failed to scan row")
+)
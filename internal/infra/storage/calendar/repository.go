@@ -0,0 +1,209 @@
+package calendar
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
+	"github.com/m04kA/SMC-BookingService/pkg/psqlbuilder"
+)
+
+// pgUniqueViolation код ошибки PostgreSQL при нарушении уникального индекса
+const pgUniqueViolation = "23505"
+
+// Repository репозиторий токенов подписки на календарь
+type Repository struct {
+	db DBExecutor
+}
+
+// NewRepository создает новый экземпляр репозитория подписок на календарь
+func NewRepository(db DBExecutor) *Repository {
+	return &Repository{db: db}
+}
+
+// Create сохраняет новый токен подписки
+// Если у владельца уже есть действующий токен, возвращает ErrDuplicateFeed
+func (r *Repository) Create(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Insert("calendar_feeds").
+		Columns(
+			"token_hash",
+			"scope",
+			"user_id",
+			"company_id",
+			"address_id",
+			"created_by",
+			"created_by_role",
+		).
+		Values(
+			feed.TokenHash,
+			feed.Target.Scope,
+			feed.Target.UserID,
+			feed.Target.CompanyID,
+			feed.Target.AddressID,
+			feed.CreatedBy,
+			feed.CreatedByRole,
+		).
+		Suffix("RETURNING id, created_at").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - build insert query: %w", ErrBuildQuery, err)
+	}
+
+	err = executor.QueryRowContext(ctx, query, args...).Scan(&feed.ID, &feed.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation {
+			return nil, ErrDuplicateFeed
+		}
+		return nil, fmt.Errorf("%w: Create - execute insert: %w", ErrExecQuery, err)
+	}
+
+	return feed, nil
+}
+
+// GetActiveByTokenHash получает действующий (не отозванный) токен подписки по хешу
+func (r *Repository) GetActiveByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error) {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Select(
+		"id",
+		"token_hash",
+		"scope",
+		"user_id",
+		"company_id",
+		"address_id",
+		"created_by",
+		"created_by_role",
+		"created_at",
+		"revoked_at",
+	).
+		From("calendar_feeds").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetActiveByTokenHash - build select query: %w", ErrBuildQuery, err)
+	}
+
+	var feed domain.CalendarFeed
+	var userID, companyID, addressID sql.NullInt64
+	var revokedAt sql.NullTime
+
+	err = executor.QueryRowContext(ctx, query, args...).Scan(
+		&feed.ID,
+		&feed.TokenHash,
+		&feed.Target.Scope,
+		&userID,
+		&companyID,
+		&addressID,
+		&feed.CreatedBy,
+		&feed.CreatedByRole,
+		&feed.CreatedAt,
+		&revokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("%w: GetActiveByTokenHash - scan row: %w", ErrScanRow, err)
+	}
+
+	if userID.Valid {
+		feed.Target.UserID = &userID.Int64
+	}
+	if companyID.Valid {
+		feed.Target.CompanyID = &companyID.Int64
+	}
+	if addressID.Valid {
+		feed.Target.AddressID = &addressID.Int64
+	}
+	if revokedAt.Valid {
+		feed.RevokedAt = &revokedAt.Time
+	}
+
+	return &feed, nil
+}
+
+// RevokeActive отзывает действующий токен владельца
+// Возвращает ErrFeedNotFound, если действующего токена нет
+func (r *Repository) RevokeActive(ctx context.Context, target domain.CalendarFeedTarget) error {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	updateBuilder := psqlbuilder.Update("calendar_feeds").
+		Set("revoked_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"scope": target.Scope}).
+		Where(squirrel.Eq{"revoked_at": nil})
+
+	switch target.Scope {
+	case domain.CalendarFeedScopeUser:
+		updateBuilder = updateBuilder.Where(squirrel.Eq{"user_id": target.UserID})
+	case domain.CalendarFeedScopeAddress:
+		updateBuilder = updateBuilder.
+			Where(squirrel.Eq{"company_id": target.CompanyID}).
+			Where(squirrel.Eq{"address_id": target.AddressID})
+	default:
+		return fmt.Errorf("%w: RevokeActive - unknown scope %q", ErrBuildQuery, target.Scope)
+	}
+
+	query, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: RevokeActive - build update query: %w", ErrBuildQuery, err)
+	}
+
+	result, err := executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: RevokeActive - execute update: %w", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: RevokeActive - get rows affected: %w", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrFeedNotFound
+	}
+
+	return nil
+}
+
+// Revoke отзывает токен по ID
+// Возвращает ErrFeedNotFound, если токен не найден или уже отозван
+func (r *Repository) Revoke(ctx context.Context, id int64) error {
+	executor := dbmetrics.GetExecutor(ctx, r.db)
+
+	query, args, err := psqlbuilder.Update("calendar_feeds").
+		Set("revoked_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Revoke - build update query: %w", ErrBuildQuery, err)
+	}
+
+	result, err := executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: Revoke - execute update: %w", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: Revoke - get rows affected: %w", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrFeedNotFound
+	}
+
+	return nil
+}
@@ -49,7 +49,7 @@ func (r *Repository) Create(ctx context.Context, config *domain.CompanySlotsConf
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: Create - build insert query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: Create - build insert query: %w", ErrBuildQuery, err)
 	}
 
 	var createdAt, updatedAt sql.NullTime
@@ -60,7 +60,7 @@ func (r *Repository) Create(ctx context.Context, config *domain.CompanySlotsConf
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: Create - execute insert: %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: Create - execute insert: %w", ErrExecQuery, err)
 	}
 
 	config.CreatedAt = createdAt.Time
@@ -90,7 +90,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.CompanySlot
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByID - build select query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: GetByID - build select query: %w", ErrBuildQuery, err)
 	}
 
 	var config domain.CompanySlotsConfig
@@ -113,7 +113,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.CompanySlot
 		return nil, ErrConfigNotFound
 	}
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByID - scan config: %v", ErrScanRow, err)
+		return nil, fmt.Errorf("%w: GetByID - scan config: %w", ErrScanRow, err)
 	}
 
 	config.CreatedAt = createdAt.Time
@@ -162,7 +162,7 @@ func (r *Repository) GetByCompanyAddressAndService(ctx context.Context, companyI
 
 	query, args, err := selectBuilder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByCompanyAddressAndService - build select query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: GetByCompanyAddressAndService - build select query: %w", ErrBuildQuery, err)
 	}
 
 	var config domain.CompanySlotsConfig
@@ -185,7 +185,7 @@ func (r *Repository) GetByCompanyAddressAndService(ctx context.Context, companyI
 		return nil, ErrConfigNotFound
 	}
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetByCompanyAddressAndService - scan config: %v", ErrScanRow, err)
+		return nil, fmt.Errorf("%w: GetByCompanyAddressAndService - scan config: %w", ErrScanRow, err)
 	}
 
 	config.CreatedAt = createdAt.Time
@@ -210,7 +210,7 @@ func (r *Repository) GetConfigWithHierarchy(ctx context.Context, companyID int64
 			return config, nil
 		}
 		if err != ErrConfigNotFound {
-			return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 1 (address+service): %v", ErrExecQuery, err)
+			return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 1 (address+service): %w", ErrExecQuery, err)
 		}
 	}
 
@@ -221,7 +221,7 @@ func (r *Repository) GetConfigWithHierarchy(ctx context.Context, companyID int64
 			return config, nil
 		}
 		if err != ErrConfigNotFound {
-			return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 2 (address only): %v", ErrExecQuery, err)
+			return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 2 (address only): %w", ErrExecQuery, err)
 		}
 	}
 
@@ -232,7 +232,7 @@ func (r *Repository) GetConfigWithHierarchy(ctx context.Context, companyID int64
 			return config, nil
 		}
 		if err != ErrConfigNotFound {
-			return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 3 (service only): %v", ErrExecQuery, err)
+			return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 3 (service only): %w", ErrExecQuery, err)
 		}
 	}
 
@@ -242,7 +242,7 @@ func (r *Repository) GetConfigWithHierarchy(ctx context.Context, companyID int64
 		return config, nil
 	}
 	if err != ErrConfigNotFound {
-		return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 4 (global): %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: GetConfigWithHierarchy - level 4 (global): %w", ErrExecQuery, err)
 	}
 
 	// Если конфигурация не найдена ни на одном уровне
@@ -271,12 +271,12 @@ func (r *Repository) GetAllByCompany(ctx context.Context, companyID int64) ([]*d
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetAllByCompany - build select query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: GetAllByCompany - build select query: %w", ErrBuildQuery, err)
 	}
 
 	rows, err := executor.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%w: GetAllByCompany - execute query: %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: GetAllByCompany - execute query: %w", ErrExecQuery, err)
 	}
 	defer rows.Close()
 
@@ -300,7 +300,7 @@ func (r *Repository) GetAllByCompany(ctx context.Context, companyID int64) ([]*d
 		)
 
 		if err != nil {
-			return nil, fmt.Errorf("%w: GetAllByCompany - scan row: %v", ErrScanRow, err)
+			return nil, fmt.Errorf("%w: GetAllByCompany - scan row: %w", ErrScanRow, err)
 		}
 
 		config.CreatedAt = createdAt.Time
@@ -310,7 +310,7 @@ func (r *Repository) GetAllByCompany(ctx context.Context, companyID int64) ([]*d
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%w: GetAllByCompany - rows error: %v", ErrScanRow, err)
+		return nil, fmt.Errorf("%w: GetAllByCompany - rows error: %w", ErrScanRow, err)
 	}
 
 	return configs, nil
@@ -330,7 +330,7 @@ func (r *Repository) Update(ctx context.Context, id int64, config *domain.Compan
 		ToSql()
 
 	if err != nil {
-		return nil, fmt.Errorf("%w: Update - build update query: %v", ErrBuildQuery, err)
+		return nil, fmt.Errorf("%w: Update - build update query: %w", ErrBuildQuery, err)
 	}
 
 	var createdAt, updatedAt sql.NullTime
@@ -340,7 +340,7 @@ func (r *Repository) Update(ctx context.Context, id int64, config *domain.Compan
 		return nil, ErrConfigNotFound
 	}
 	if err != nil {
-		return nil, fmt.Errorf("%w: Update - execute update: %v", ErrExecQuery, err)
+		return nil, fmt.Errorf("%w: Update - execute update: %w", ErrExecQuery, err)
 	}
 
 	config.ID = id
@@ -359,17 +359,17 @@ func (r *Repository) Delete(ctx context.Context, id int64) error {
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("%w: Delete - build delete query: %v", ErrBuildQuery, err)
+		return fmt.Errorf("%w: Delete - build delete query: %w", ErrBuildQuery, err)
 	}
 
 	result, err := executor.ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%w: Delete - execute delete: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: Delete - execute delete: %w", ErrExecQuery, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: Delete - get rows affected: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: Delete - get rows affected: %w", ErrExecQuery, err)
 	}
 
 	if rowsAffected == 0 {
@@ -402,17 +402,17 @@ func (r *Repository) DeleteByCompanyAddressAndService(ctx context.Context, compa
 
 	query, args, err := deleteBuilder.ToSql()
 	if err != nil {
-		return fmt.Errorf("%w: DeleteByCompanyAddressAndService - build delete query: %v", ErrBuildQuery, err)
+		return fmt.Errorf("%w: DeleteByCompanyAddressAndService - build delete query: %w", ErrBuildQuery, err)
 	}
 
 	result, err := executor.ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%w: DeleteByCompanyAddressAndService - execute delete: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: DeleteByCompanyAddressAndService - execute delete: %w", ErrExecQuery, err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("%w: DeleteByCompanyAddressAndService - get rows affected: %v", ErrExecQuery, err)
+		return fmt.Errorf("%w: DeleteByCompanyAddressAndService - get rows affected: %w", ErrExecQuery, err)
 	}
 
 	if rowsAffected == 0 {
@@ -430,7 +430,7 @@ func (r *Repository) BeginTx(ctx context.Context, opts *sql.TxOptions) (context.
 	if txBeginner, ok := r.db.(TxBeginner); ok {
 		tx, err := txBeginner.BeginTx(ctx, opts)
 		if err != nil {
-			return ctx, nil, fmt.Errorf("%w: BeginTx: %v", ErrTransaction, err)
+			return ctx, nil, fmt.Errorf("%w: BeginTx: %w", ErrTransaction, err)
 		}
 		return dbmetrics.WithTx(ctx, tx), tx, nil
 	}
@@ -439,7 +439,7 @@ func (r *Repository) BeginTx(ctx context.Context, opts *sql.TxOptions) (context.
 	if db, ok := r.db.(*sql.DB); ok {
 		tx, err := db.BeginTx(ctx, opts)
 		if err != nil {
-			return ctx, nil, fmt.Errorf("%w: BeginTx: %v", ErrTransaction, err)
+			return ctx, nil, fmt.Errorf("%w: BeginTx: %w", ErrTransaction, err)
 		}
 		wrappedTx := &dbmetrics.SqlTxWrapper{Tx: tx}
 		return dbmetrics.WithTx(ctx, wrappedTx), wrappedTx, nil
@@ -0,0 +1,283 @@
+package sellerservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Имена сущностей и результаты поиска в кеше (значения меток метрик)
+const (
+	cacheName = "sellerservice"
+
+	entityCompany = "company"
+	entityService = "service"
+
+	cacheResultHit         = "hit"
+	cacheResultNegativeHit = "negative_hit"
+	cacheResultMiss        = "miss"
+)
+
+// CacheConfig настройки кеширующего клиента
+type CacheConfig struct {
+	CompanyTTL   time.Duration // Время жизни компании в кеше
+	ServiceTTL   time.Duration // Время жизни услуги в кеше
+	NegativeTTL  time.Duration // Время жизни ответа 404 (компания/услуга не найдена)
+	MaxEntries   int           // Максимальное количество записей (0 = без ограничений)
+	FetchTimeout time.Duration // Ограничение общего запроса к SellerService при промахе (0 = без ограничения)
+}
+
+// cacheEntry запись кеша: значение или ошибка "не найдено" (negative caching)
+type cacheEntry struct {
+	value     interface{}
+	err       error
+	expiresAt time.Time
+}
+
+// serviceKey ключ кеша услуги
+type serviceKey struct {
+	companyID int64
+	serviceID int64
+}
+
+// CachedClient кеширующий декоратор клиента SellerService
+// Кеширует компании и услуги с отдельными TTL, объединяет одновременные промахи
+// по одному ключу в один HTTP запрос (singleflight) и кеширует ответы 404.
+// Остальные ошибки не кешируются. Вызывающий получает копию, общие данные кеша не меняются.
+// Общий запрос не отменяется вызывающими и ограничен FetchTimeout, при этом каждый вызывающий
+// перестает ждать его по своему дедлайну.
+//
+// Изменения в SellerService видны после истечения TTL или после инвалидации
+// (POST /api/v1/admin/cache/sellerservice/invalidate)
+type CachedClient struct {
+	upstream    Upstream
+	cfg         CacheConfig
+	metrics     CacheMetrics
+	serviceName string
+	log         Logger
+
+	mu        sync.RWMutex
+	companies map[int64]cacheEntry
+	services  map[serviceKey]cacheEntry
+
+	group singleflight.Group
+}
+
+// NewCachedClient создает кеширующий клиент поверх upstream
+// metrics может быть nil, если метрики отключены
+func NewCachedClient(upstream Upstream, cfg CacheConfig, metrics CacheMetrics, serviceName string, log Logger) *CachedClient {
+	return &CachedClient{
+		upstream:    upstream,
+		cfg:         cfg,
+		metrics:     metrics,
+		serviceName: serviceName,
+		log:         log,
+		companies:   make(map[int64]cacheEntry),
+		services:    make(map[serviceKey]cacheEntry),
+	}
+}
+
+// GetCompany получает компанию из кеша или из SellerService
+func (c *CachedClient) GetCompany(ctx context.Context, companyID int64) (*Company, error) {
+	c.mu.RLock()
+	entry, ok := c.companies[companyID]
+	c.mu.RUnlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		if entry.err != nil {
+			c.recordLookup(entityCompany, cacheResultNegativeHit)
+			return nil, entry.err
+		}
+		c.recordLookup(entityCompany, cacheResultHit)
+		return entry.value.(*Company).Clone(), nil
+	}
+	c.recordLookup(entityCompany, cacheResultMiss)
+
+	key := fmt.Sprintf("company:%d", companyID)
+	value, err := c.fetch(ctx, key, func(ctx context.Context) (interface{}, error) {
+		company, err := c.upstream.GetCompany(ctx, companyID)
+		switch {
+		case err == nil:
+			c.storeCompany(companyID, cacheEntry{value: company, expiresAt: time.Now().Add(c.cfg.CompanyTTL)})
+		case errors.Is(err, ErrCompanyNotFound):
+			c.storeCompany(companyID, cacheEntry{err: err, expiresAt: time.Now().Add(c.cfg.NegativeTTL)})
+		}
+		return company, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*Company).Clone(), nil
+}
+
+// GetService получает услугу из кеша или из SellerService
+func (c *CachedClient) GetService(ctx context.Context, companyID, serviceID int64) (*Service, error) {
+	sk := serviceKey{companyID: companyID, serviceID: serviceID}
+
+	c.mu.RLock()
+	entry, ok := c.services[sk]
+	c.mu.RUnlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		if entry.err != nil {
+			c.recordLookup(entityService, cacheResultNegativeHit)
+			return nil, entry.err
+		}
+		c.recordLookup(entityService, cacheResultHit)
+		return entry.value.(*Service).Clone(), nil
+	}
+	c.recordLookup(entityService, cacheResultMiss)
+
+	key := fmt.Sprintf("service:%d:%d", companyID, serviceID)
+	value, err := c.fetch(ctx, key, func(ctx context.Context) (interface{}, error) {
+		service, err := c.upstream.GetService(ctx, companyID, serviceID)
+		switch {
+		case err == nil:
+			c.storeService(sk, cacheEntry{value: service, expiresAt: time.Now().Add(c.cfg.ServiceTTL)})
+		case errors.Is(err, ErrServiceNotFound):
+			c.storeService(sk, cacheEntry{err: err, expiresAt: time.Now().Add(c.cfg.NegativeTTL)})
+		}
+		return service, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*Service).Clone(), nil
+}
+
+// fetch выполняет запрос к SellerService один раз на ключ для всех одновременных промахов
+// Запрос общий для всех ожидающих, поэтому не зависит от отмены контекста первого вызывающего
+// и ограничен FetchTimeout; каждый вызывающий ждет результат не дольше своего контекста
+func (c *CachedClient) fetch(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithoutCancel(ctx), context.CancelFunc(func() {})
+		if c.cfg.FetchTimeout > 0 {
+			fetchCtx, cancel = context.WithTimeout(fetchCtx, c.cfg.FetchTimeout)
+		}
+		defer cancel()
+		return fn(fetchCtx)
+	})
+
+	select {
+	case res := <-ch:
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// InvalidateCompany удаляет из кеша компанию и все её услуги
+// Вызывается при изменении компании в SellerService (менеджеры, адреса, расписание)
+func (c *CachedClient) InvalidateCompany(companyID int64) {
+	c.mu.Lock()
+	delete(c.companies, companyID)
+	for key := range c.services {
+		if key.companyID == companyID {
+			delete(c.services, key)
+		}
+	}
+	c.mu.Unlock()
+
+	c.recordInvalidation(entityCompany)
+	c.log.Info("CachedClient: invalidated company id=%d", companyID)
+}
+
+// InvalidateService удаляет из кеша услугу компании
+func (c *CachedClient) InvalidateService(companyID, serviceID int64) {
+	c.mu.Lock()
+	delete(c.services, serviceKey{companyID: companyID, serviceID: serviceID})
+	c.mu.Unlock()
+
+	c.recordInvalidation(entityService)
+	c.log.Info("CachedClient: invalidated service id=%d of company id=%d", serviceID, companyID)
+}
+
+// InvalidateAll полностью очищает кеш
+func (c *CachedClient) InvalidateAll() {
+	c.mu.Lock()
+	c.companies = make(map[int64]cacheEntry)
+	c.services = make(map[serviceKey]cacheEntry)
+	c.mu.Unlock()
+
+	c.recordInvalidation(entityCompany)
+	c.recordInvalidation(entityService)
+	c.log.Info("CachedClient: cache purged")
+}
+
+// storeCompany сохраняет компанию в кеш с учетом ограничения размера
+func (c *CachedClient) storeCompany(companyID int64, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.companies[companyID]; !exists && c.isFull() {
+		c.evict()
+	}
+	c.companies[companyID] = entry
+}
+
+// storeService сохраняет услугу в кеш с учетом ограничения размера
+func (c *CachedClient) storeService(key serviceKey, entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.services[key]; !exists && c.isFull() {
+		c.evict()
+	}
+	c.services[key] = entry
+}
+
+// isFull проверяет, достигнут ли лимит записей (вызывается под блокировкой)
+func (c *CachedClient) isFull() bool {
+	return c.cfg.MaxEntries > 0 && len(c.companies)+len(c.services) >= c.cfg.MaxEntries
+}
+
+// evict освобождает место в кеше (вызывается под блокировкой)
+// Сначала удаляются просроченные записи, если их нет - произвольная запись
+func (c *CachedClient) evict() {
+	now := time.Now()
+	removed := false
+	for key, entry := range c.companies {
+		if now.After(entry.expiresAt) {
+			delete(c.companies, key)
+			removed = true
+		}
+	}
+	for key, entry := range c.services {
+		if now.After(entry.expiresAt) {
+			delete(c.services, key)
+			removed = true
+		}
+	}
+	if removed {
+		return
+	}
+
+	for key := range c.services {
+		delete(c.services, key)
+		return
+	}
+	for key := range c.companies {
+		delete(c.companies, key)
+		return
+	}
+}
+
+// recordLookup записывает метрику обращения к кешу
+func (c *CachedClient) recordLookup(entity, result string) {
+	if c.metrics != nil {
+		c.metrics.RecordCacheLookup(c.serviceName, cacheName, entity, result)
+	}
+}
+
+// recordInvalidation записывает метрику инвалидации кеша
+func (c *CachedClient) recordInvalidation(entity string) {
+	if c.metrics != nil {
+		c.metrics.RecordCacheInvalidation(c.serviceName, cacheName, entity)
+	}
+}
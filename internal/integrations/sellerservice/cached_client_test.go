@@ -0,0 +1,252 @@
+package sellerservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...interface{})                          {}
+func (nopLogger) InfoContext(context.Context, string, ...interface{})  {}
+func (nopLogger) WarnContext(context.Context, string, ...interface{})  {}
+func (nopLogger) ErrorContext(context.Context, string, ...interface{}) {}
+
+// fakeUpstream считает запросы к SellerService; release (если задан) задерживает ответ
+type fakeUpstream struct {
+	companyCalls atomic.Int64
+	serviceCalls atomic.Int64
+	companyErr   error
+	serviceErr   error
+	release      chan struct{}
+}
+
+func (u *fakeUpstream) GetCompany(ctx context.Context, companyID int64) (*Company, error) {
+	u.companyCalls.Add(1)
+	if u.release != nil {
+		select {
+		case <-u.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if u.companyErr != nil {
+		return nil, u.companyErr
+	}
+	openTime := "09:00"
+	return &Company{
+		ID:           companyID,
+		Name:         "Wash",
+		Addresses:    []Address{{ID: 100, City: "Moscow"}},
+		ManagerIDs:   []int64{777},
+		WorkingHours: WorkingHours{Monday: DaySchedule{IsOpen: true, OpenTime: &openTime}},
+	}, nil
+}
+
+func (u *fakeUpstream) GetService(_ context.Context, companyID, serviceID int64) (*Service, error) {
+	u.serviceCalls.Add(1)
+	if u.serviceErr != nil {
+		return nil, u.serviceErr
+	}
+	return &Service{ID: serviceID, CompanyID: companyID, AddressIDs: []int64{100}}, nil
+}
+
+func newTestClient(upstream Upstream, cfg CacheConfig) *CachedClient {
+	return NewCachedClient(upstream, cfg, nil, "test", nopLogger{})
+}
+
+func TestCachedClient_TTL(t *testing.T) {
+	upstream := &fakeUpstream{}
+	client := newTestClient(upstream, CacheConfig{CompanyTTL: 50 * time.Millisecond, ServiceTTL: 50 * time.Millisecond})
+	ctx := context.Background()
+
+	for range 3 {
+		_, err := client.GetCompany(ctx, 1)
+		require.NoError(t, err)
+		_, err = client.GetService(ctx, 1, 10)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), upstream.companyCalls.Load())
+	assert.Equal(t, int64(1), upstream.serviceCalls.Load())
+
+	time.Sleep(60 * time.Millisecond)
+
+	_, err := client.GetCompany(ctx, 1)
+	require.NoError(t, err)
+	_, err = client.GetService(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), upstream.companyCalls.Load(), "просроченная запись запрашивается заново")
+	assert.Equal(t, int64(2), upstream.serviceCalls.Load())
+}
+
+func TestCachedClient_Singleflight(t *testing.T) {
+	upstream := &fakeUpstream{release: make(chan struct{})}
+	client := newTestClient(upstream, CacheConfig{CompanyTTL: time.Minute})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			company, err := client.GetCompany(context.Background(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), company.ID)
+		}()
+	}
+
+	// Ждем первый запрос к SellerService, затем отпускаем его
+	require.Eventually(t, func() bool { return upstream.companyCalls.Load() > 0 }, time.Second, time.Millisecond)
+	close(upstream.release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), upstream.companyCalls.Load())
+}
+
+func TestCachedClient_WaiterDeadline(t *testing.T) {
+	upstream := &fakeUpstream{release: make(chan struct{})}
+	client := newTestClient(upstream, CacheConfig{CompanyTTL: time.Minute, FetchTimeout: time.Minute})
+
+	// Первый вызывающий запускает общий запрос и ждет его без дедлайна
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetCompany(context.Background(), 1)
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return upstream.companyCalls.Load() > 0 }, time.Second, time.Millisecond)
+
+	// Второй перестает ждать по своему дедлайну, не дожидаясь общего запроса
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	_, err := client.GetCompany(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(started), time.Second)
+
+	// Общий запрос продолжается и отдает результат остальным
+	close(upstream.release)
+	require.NoError(t, <-done)
+	assert.Equal(t, int64(1), upstream.companyCalls.Load())
+}
+
+func TestCachedClient_FetchTimeout(t *testing.T) {
+	upstream := &fakeUpstream{release: make(chan struct{})}
+	client := newTestClient(upstream, CacheConfig{CompanyTTL: time.Minute, FetchTimeout: 20 * time.Millisecond})
+
+	started := time.Now()
+	_, err := client.GetCompany(context.Background(), 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "общий запрос ограничен FetchTimeout")
+	assert.Less(t, time.Since(started), time.Second)
+}
+
+func TestCachedClient_NegativeCaching(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCalls int64
+	}{
+		{"not found is cached", ErrCompanyNotFound, 1},
+		{"other errors are not cached", errors.New("connection refused"), 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upstream := &fakeUpstream{companyErr: tt.err}
+			client := newTestClient(upstream, CacheConfig{CompanyTTL: time.Minute, NegativeTTL: time.Minute})
+
+			for range 3 {
+				_, err := client.GetCompany(context.Background(), 1)
+				assert.ErrorIs(t, err, tt.err)
+			}
+			assert.Equal(t, tt.wantCalls, upstream.companyCalls.Load())
+		})
+	}
+
+	t.Run("service not found expires after negative ttl", func(t *testing.T) {
+		upstream := &fakeUpstream{serviceErr: ErrServiceNotFound}
+		client := newTestClient(upstream, CacheConfig{ServiceTTL: time.Minute, NegativeTTL: 30 * time.Millisecond})
+
+		_, err := client.GetService(context.Background(), 1, 10)
+		assert.ErrorIs(t, err, ErrServiceNotFound)
+		_, err = client.GetService(context.Background(), 1, 10)
+		assert.ErrorIs(t, err, ErrServiceNotFound)
+		assert.Equal(t, int64(1), upstream.serviceCalls.Load())
+
+		time.Sleep(40 * time.Millisecond)
+		upstream.serviceErr = nil
+
+		service, err := client.GetService(context.Background(), 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), service.ID)
+		assert.Equal(t, int64(2), upstream.serviceCalls.Load())
+	})
+}
+
+func TestCachedClient_ReturnsCopies(t *testing.T) {
+	client := newTestClient(&fakeUpstream{}, CacheConfig{CompanyTTL: time.Minute, ServiceTTL: time.Minute})
+	ctx := context.Background()
+
+	company, err := client.GetCompany(ctx, 1)
+	require.NoError(t, err)
+	company.Name = "changed"
+	company.ManagerIDs[0] = 1
+	company.Addresses[0].City = "changed"
+	*company.WorkingHours.Monday.OpenTime = "00:00"
+
+	service, err := client.GetService(ctx, 1, 10)
+	require.NoError(t, err)
+	service.AddressIDs[0] = 1
+
+	cachedCompany, err := client.GetCompany(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Wash", cachedCompany.Name)
+	assert.Equal(t, []int64{777}, cachedCompany.ManagerIDs)
+	assert.Equal(t, "Moscow", cachedCompany.Addresses[0].City)
+	assert.Equal(t, "09:00", *cachedCompany.WorkingHours.Monday.OpenTime)
+
+	cachedService, err := client.GetService(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{100}, cachedService.AddressIDs)
+}
+
+func TestCachedClient_Invalidate(t *testing.T) {
+	upstream := &fakeUpstream{}
+	client := newTestClient(upstream, CacheConfig{CompanyTTL: time.Minute, ServiceTTL: time.Minute})
+	ctx := context.Background()
+
+	load := func() {
+		t.Helper()
+		_, err := client.GetCompany(ctx, 1)
+		require.NoError(t, err)
+		_, err = client.GetService(ctx, 1, 10)
+		require.NoError(t, err)
+		_, err = client.GetService(ctx, 2, 20)
+		require.NoError(t, err)
+	}
+	load()
+	require.Equal(t, int64(1), upstream.companyCalls.Load())
+	require.Equal(t, int64(2), upstream.serviceCalls.Load())
+
+	// Услуга удаляется одна
+	client.InvalidateService(1, 10)
+	load()
+	assert.Equal(t, int64(1), upstream.companyCalls.Load())
+	assert.Equal(t, int64(3), upstream.serviceCalls.Load())
+
+	// Компания удаляется вместе со своими услугами
+	client.InvalidateCompany(1)
+	load()
+	assert.Equal(t, int64(2), upstream.companyCalls.Load())
+	assert.Equal(t, int64(4), upstream.serviceCalls.Load())
+
+	client.InvalidateAll()
+	load()
+	assert.Equal(t, int64(3), upstream.companyCalls.Load())
+	assert.Equal(t, int64(6), upstream.serviceCalls.Load())
+}
@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"time"
+
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
 )
 
 // Client клиент для работы с SellerService
@@ -17,13 +18,12 @@ type Client struct {
 }
 
 // NewClient создает новый экземпляр клиента SellerService
-func NewClient(baseURL string, timeout time.Duration, log Logger) *Client {
+// httpClient должен ограничивать время вызова (см. transport.NewHTTPClient)
+func NewClient(baseURL string, httpClient *http.Client, log Logger) *Client {
 	return &Client{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		log: log,
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		log:        log,
 	}
 }
 
@@ -36,6 +36,10 @@ func (c *Client) GetCompany(ctx context.Context, companyID int64) (*Company, err
 		return nil, fmt.Errorf("%w: failed to create request: %v", ErrInternal, err)
 	}
 
+	if id := requestid.FromContext(ctx); id != "" {
+		req.Header.Set(requestid.Header, id)
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to execute request: %v", ErrInternal, err)
@@ -71,6 +75,10 @@ func (c *Client) GetService(ctx context.Context, companyID, serviceID int64) (*S
 		return nil, fmt.Errorf("%w: failed to create request: %v", ErrInternal, err)
 	}
 
+	if id := requestid.FromContext(ctx); id != "" {
+		req.Header.Set(requestid.Header, id)
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to execute request: %v", ErrInternal, err)
@@ -94,6 +102,6 @@ func (c *Client) GetService(ctx context.Context, companyID, serviceID int64) (*S
 		return nil, fmt.Errorf("%w: failed to decode response: %v", ErrInvalidResponse, err)
 	}
 
-	c.log.Info("GetService: decoded service id=%d, address_ids=%v", service.ID, service.AddressIDs)
+	c.log.InfoContext(ctx, "GetService: decoded service id=%d, address_ids=%v", service.ID, service.AddressIDs)
 	return &service, nil
 }
@@ -1,8 +1,23 @@
 package sellerservice
 
+import "context"
+
 // Logger интерфейс для логирования
 type Logger interface {
 	Info(format string, v ...interface{})
-	Warn(format string, v ...interface{})
-	Error(format string, v ...interface{})
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
+
+// Upstream интерфейс клиента SellerService, который оборачивает CachedClient
+type Upstream interface {
+	GetCompany(ctx context.Context, companyID int64) (*Company, error)
+	GetService(ctx context.Context, companyID, serviceID int64) (*Service, error)
+}
+
+// CacheMetrics интерфейс для метрик кеша
+type CacheMetrics interface {
+	RecordCacheLookup(service, cache, entity, result string)
+	RecordCacheInvalidation(service, cache, entity string)
 }
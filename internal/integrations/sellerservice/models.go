@@ -9,6 +9,7 @@ type Company struct {
 	Addresses    []Address    `json:"addresses"`
 	WorkingHours WorkingHours `json:"working_hours"`
 	ManagerIDs   []int64      `json:"manager_ids"`
+	OperatorIDs  []int64      `json:"operator_ids"` // Операторы компании (могут отсутствовать в ответе)
 	CreatedAt    time.Time    `json:"created_at"`
 	UpdatedAt    time.Time    `json:"updated_at"`
 }
@@ -32,6 +33,28 @@ type WorkingHours struct {
 	Sunday    DaySchedule `json:"sunday"`
 }
 
+// ForDay возвращает расписание на указанный день недели
+func (w WorkingHours) ForDay(weekday time.Weekday) DaySchedule {
+	switch weekday {
+	case time.Monday:
+		return w.Monday
+	case time.Tuesday:
+		return w.Tuesday
+	case time.Wednesday:
+		return w.Wednesday
+	case time.Thursday:
+		return w.Thursday
+	case time.Friday:
+		return w.Friday
+	case time.Saturday:
+		return w.Saturday
+	case time.Sunday:
+		return w.Sunday
+	default:
+		return DaySchedule{IsOpen: false}
+	}
+}
+
 // DaySchedule расписание на день
 type DaySchedule struct {
 	IsOpen    bool    `json:"isOpen"`
@@ -57,6 +80,66 @@ type Service struct {
 	AppliedMultiplier *float64 `json:"applied_multiplier,omitempty"`
 }
 
+// Clone возвращает глубокую копию компании
+// CachedClient отдает копии, чтобы изменения у вызывающего не попадали в общий кеш
+func (c *Company) Clone() *Company {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	clone.Addresses = cloneSlice(c.Addresses)
+	clone.ManagerIDs = cloneSlice(c.ManagerIDs)
+	clone.OperatorIDs = cloneSlice(c.OperatorIDs)
+	clone.WorkingHours = WorkingHours{
+		Monday:    c.WorkingHours.Monday.clone(),
+		Tuesday:   c.WorkingHours.Tuesday.clone(),
+		Wednesday: c.WorkingHours.Wednesday.clone(),
+		Thursday:  c.WorkingHours.Thursday.clone(),
+		Friday:    c.WorkingHours.Friday.clone(),
+		Saturday:  c.WorkingHours.Saturday.clone(),
+		Sunday:    c.WorkingHours.Sunday.clone(),
+	}
+	return &clone
+}
+
+// Clone возвращает глубокую копию услуги
+func (s *Service) Clone() *Service {
+	if s == nil {
+		return nil
+	}
+	clone := *s
+	clone.AddressIDs = cloneSlice(s.AddressIDs)
+	clone.AverageDuration = clonePtr(s.AverageDuration)
+	clone.Price = clonePtr(s.Price)
+	clone.Currency = clonePtr(s.Currency)
+	clone.PricingType = clonePtr(s.PricingType)
+	clone.VehicleClass = clonePtr(s.VehicleClass)
+	clone.AppliedMultiplier = clonePtr(s.AppliedMultiplier)
+	return &clone
+}
+
+func (d DaySchedule) clone() DaySchedule {
+	d.OpenTime = clonePtr(d.OpenTime)
+	d.CloseTime = clonePtr(d.CloseTime)
+	return d
+}
+
+// cloneSlice копирует срез, сохраняя nil
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
 // ErrorResponse модель ошибки от SellerService
 type ErrorResponse struct {
 	Code    string `json:"code"`
@@ -0,0 +1,188 @@
+package transport
+
+import (
+	"sync"
+	"time"
+)
+
+// Состояния circuit breaker
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half_open"
+)
+
+// breaker circuit breaker для одной зависимости
+//
+// closed    - запросы проходят, неудачные попытки подряд считаются;
+// open      - после FailureThreshold неудач запросы сразу отклоняются с ErrCircuitOpen;
+// half_open - по истечении OpenTimeout пропускается HalfOpenMaxRequests пробных запросов,
+// успех замыкает breaker, неудача снова размыкает
+//
+// Пробный запрос привязан к своему периоду half_open (generation): его результат или отмена
+// освобождает слот только в том же периоде, поэтому запоздавшие ответы не сбивают счетчик.
+// onStateChange вызывается вне блокировки, в порядке переходов
+type breaker struct {
+	mu               sync.Mutex
+	state            string
+	failures         int
+	openedAt         time.Time
+	generation       uint64
+	halfOpenInFlight int
+
+	failureThreshold    int
+	openTimeout         time.Duration
+	halfOpenMaxRequests int
+
+	onStateChange func(from, to string)
+	pending       []transition
+	notifying     bool
+}
+
+// transition переход между состояниями, ожидающий уведомления
+type transition struct {
+	from, to string
+}
+
+// permit разрешение на пробный запрос периода half_open с номером generation
+// Для обычных попыток в замкнутом состоянии разрешение nil
+type permit struct {
+	generation uint64
+	released   bool
+}
+
+// newBreaker создает circuit breaker в замкнутом состоянии
+func newBreaker(cfg Config, onStateChange func(from, to string)) *breaker {
+	return &breaker{
+		state:               StateClosed,
+		failureThreshold:    cfg.FailureThreshold,
+		openTimeout:         cfg.OpenTimeout,
+		halfOpenMaxRequests: cfg.HalfOpenMaxRequests,
+		onStateChange:       onStateChange,
+	}
+}
+
+// allow проверяет, можно ли выполнить попытку
+// Полученное разрешение передается в record или release
+func (b *breaker) allow() (*permit, error) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return nil, ErrCircuitOpen
+		}
+		b.generation++
+		b.halfOpenInFlight = 0
+		b.setState(StateHalfOpen)
+		return b.acquireProbe(), nil
+
+	case StateHalfOpen:
+		if b.halfOpenInFlight >= b.halfOpenMaxRequests {
+			return nil, ErrCircuitOpen
+		}
+		return b.acquireProbe(), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// record учитывает результат попытки
+func (b *breaker) record(p *permit, success bool) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	switch b.state {
+	case StateHalfOpen:
+		// Решение в half_open принимается только по пробным запросам текущего периода
+		if !b.releaseProbe(p) {
+			return
+		}
+		if success {
+			b.failures = 0
+			b.setState(StateClosed)
+			return
+		}
+		b.open()
+
+	case StateClosed:
+		if success {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.open()
+		}
+	}
+}
+
+// release освобождает попытку без учета результата (например, запрос отменён вызывающим)
+func (b *breaker) release(p *permit) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	b.releaseProbe(p)
+}
+
+// acquireProbe занимает слот пробного запроса (вызывается под блокировкой)
+func (b *breaker) acquireProbe() *permit {
+	b.halfOpenInFlight++
+	return &permit{generation: b.generation}
+}
+
+// releaseProbe освобождает слот пробного запроса текущего периода half_open (вызывается под блокировкой)
+// Возвращает false, если разрешение не относится к текущему периоду или уже освобождено
+func (b *breaker) releaseProbe(p *permit) bool {
+	if p == nil || p.released || b.state != StateHalfOpen || p.generation != b.generation {
+		return false
+	}
+	p.released = true
+	b.halfOpenInFlight--
+	return true
+}
+
+// open размыкает breaker (вызывается под блокировкой)
+func (b *breaker) open() {
+	b.openedAt = time.Now()
+	b.halfOpenInFlight = 0
+	b.setState(StateOpen)
+}
+
+// setState меняет состояние и ставит переход в очередь уведомлений (вызывается под блокировкой)
+func (b *breaker) setState(state string) {
+	if b.state == state {
+		return
+	}
+	b.pending = append(b.pending, transition{from: b.state, to: state})
+	b.state = state
+}
+
+// unlock снимает блокировку и уведомляет о накопленных переходах
+// Уведомления доставляет одна горутина за раз, поэтому порядок переходов сохраняется,
+// а onStateChange может снова обращаться к breaker
+func (b *breaker) unlock() {
+	if b.onStateChange == nil {
+		b.pending = nil
+	}
+	if b.notifying || len(b.pending) == 0 {
+		// Переходы, если есть, доставит уже уведомляющая горутина
+		b.mu.Unlock()
+		return
+	}
+
+	b.notifying = true
+	for len(b.pending) > 0 {
+		pending := b.pending
+		b.pending = nil
+		b.mu.Unlock()
+		for _, t := range pending {
+			b.onStateChange(t.from, t.to)
+		}
+		b.mu.Lock()
+	}
+	b.notifying = false
+	b.mu.Unlock()
+}
@@ -0,0 +1,194 @@
+package transport
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder собирает переходы circuit breaker
+type recorder struct {
+	mu          sync.Mutex
+	transitions []string
+}
+
+func (r *recorder) onStateChange(from, to string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transitions = append(r.transitions, from+"->"+to)
+}
+
+func (r *recorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.transitions...)
+}
+
+func newTestBreaker(openTimeout time.Duration, halfOpenMax int) (*breaker, *recorder) {
+	rec := &recorder{}
+	b := newBreaker(Config{
+		FailureThreshold:    3,
+		OpenTimeout:         openTimeout,
+		HalfOpenMaxRequests: halfOpenMax,
+	}, rec.onStateChange)
+	return b, rec
+}
+
+// fail выполняет n неудачных попыток
+func fail(t *testing.T, b *breaker, n int) {
+	t.Helper()
+	for range n {
+		p, err := b.allow()
+		require.NoError(t, err)
+		b.record(p, false)
+	}
+}
+
+func TestBreaker_OpensAfterThreshold(t *testing.T) {
+	b, rec := newTestBreaker(time.Minute, 1)
+
+	fail(t, b, 2)
+	p, err := b.allow()
+	require.NoError(t, err)
+	b.record(p, true)
+	fail(t, b, 2)
+	assert.Equal(t, StateClosed, b.state, "успех сбрасывает счетчик неудач")
+
+	fail(t, b, 1)
+	assert.Equal(t, StateOpen, b.state)
+	assert.Equal(t, []string{"closed->open"}, rec.get())
+
+	_, err = b.allow()
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	tests := []struct {
+		name      string
+		success   bool
+		wantState string
+		want      []string
+	}{
+		{"probe success closes", true, StateClosed, []string{"closed->open", "open->half_open", "half_open->closed"}},
+		{"probe failure reopens", false, StateOpen, []string{"closed->open", "open->half_open", "half_open->open"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, rec := newTestBreaker(10*time.Millisecond, 2)
+			fail(t, b, 3)
+			time.Sleep(15 * time.Millisecond)
+
+			first, err := b.allow()
+			require.NoError(t, err)
+			second, err := b.allow()
+			require.NoError(t, err)
+			_, err = b.allow()
+			assert.ErrorIs(t, err, ErrCircuitOpen, "пробных запросов не больше HalfOpenMaxRequests")
+
+			b.record(first, tt.success)
+			assert.Equal(t, tt.wantState, b.state)
+			assert.Equal(t, tt.want, rec.get())
+
+			// Результат второй пробы уже не влияет на состояние
+			b.record(second, !tt.success)
+			assert.Equal(t, tt.wantState, b.state)
+			assert.Equal(t, tt.want, rec.get())
+		})
+	}
+}
+
+func TestBreaker_ReleaseFreesProbe(t *testing.T) {
+	b, _ := newTestBreaker(10*time.Millisecond, 1)
+	fail(t, b, 3)
+	time.Sleep(15 * time.Millisecond)
+
+	p, err := b.allow()
+	require.NoError(t, err)
+	_, err = b.allow()
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	b.release(p)
+	assert.Equal(t, 0, b.halfOpenInFlight)
+	b.release(p)
+	assert.Equal(t, 0, b.halfOpenInFlight, "повторное освобождение не уменьшает счетчик")
+
+	_, err = b.allow()
+	assert.NoError(t, err)
+}
+
+func TestBreaker_StaleProbeIgnored(t *testing.T) {
+	b, _ := newTestBreaker(10*time.Millisecond, 1)
+	fail(t, b, 3)
+	time.Sleep(15 * time.Millisecond)
+
+	// Проба первого периода half_open зависла, breaker снова разомкнулся
+	stale, err := b.allow()
+	require.NoError(t, err)
+	b.mu.Lock()
+	b.open()
+	b.mu.Unlock()
+	time.Sleep(15 * time.Millisecond)
+
+	current, err := b.allow()
+	require.NoError(t, err)
+
+	// Запоздавшие результат и отмена старой пробы не освобождают слот нового периода
+	b.release(stale)
+	b.record(stale, true)
+	assert.Equal(t, StateHalfOpen, b.state)
+	assert.Equal(t, 1, b.halfOpenInFlight)
+
+	b.record(current, true)
+	assert.Equal(t, StateClosed, b.state)
+	assert.Equal(t, 0, b.halfOpenInFlight)
+}
+
+func TestBreaker_CallbackOutsideLock(t *testing.T) {
+	var b *breaker
+	var states []string
+	b = newBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenMaxRequests: 1}, func(_, to string) {
+		// Обращение к breaker из обработчика не должно блокироваться
+		_, err := b.allow()
+		states = append(states, to)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fail(t, b, 1)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("обработчик перехода вызван под блокировкой")
+	}
+	assert.Equal(t, []string{StateOpen}, states)
+}
+
+type fakeMetrics struct {
+	states []string
+}
+
+func (m *fakeMetrics) RecordCircuitBreakerState(service, dependency, from, to string) {
+	m.SetCircuitBreakerState(service, dependency, to)
+}
+
+func (m *fakeMetrics) SetCircuitBreakerState(_, dependency, state string) {
+	m.states = append(m.states, dependency+":"+state)
+}
+
+func (m *fakeMetrics) RecordOutboundRetry(string, string, string) {}
+
+func TestNew_InitialBreakerState(t *testing.T) {
+	m := &fakeMetrics{}
+	New(nil, Config{Dependency: "sellerservice", BreakerEnabled: true}, m, "test", nil)
+	New(nil, Config{Dependency: "userservice"}, m, "test", nil)
+
+	assert.Equal(t, []string{"sellerservice:closed"}, m.states, "состояние выставляется только при включенном breaker")
+}
@@ -0,0 +1,20 @@
+package transport
+
+import "time"
+
+// Config настройки устойчивого транспорта для одной внешней зависимости
+type Config struct {
+	Dependency     string        // Имя зависимости (userservice, sellerservice) для метрик и логов
+	AttemptTimeout time.Duration // Таймаут одной попытки (ограничивается дедлайном контекста запроса)
+
+	// Повторы (только для идемпотентных GET/HEAD)
+	MaxAttempts    int           // Общее количество попыток, включая первую (1 = без повторов)
+	InitialBackoff time.Duration // Базовая задержка перед повтором
+	MaxBackoff     time.Duration // Максимальная задержка перед повтором
+
+	// Circuit breaker
+	BreakerEnabled      bool
+	FailureThreshold    int           // Количество подряд неудачных попыток для размыкания
+	OpenTimeout         time.Duration // Время в разомкнутом состоянии до пробного запроса
+	HalfOpenMaxRequests int           // Количество одновременных пробных запросов в half-open
+}
@@ -0,0 +1,18 @@
+package transport
+
+import "context"
+
+// Logger интерфейс для логирования
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
+
+// Metrics интерфейс для метрик транспорта
+type Metrics interface {
+	RecordCircuitBreakerState(service, dependency, from, to string)
+	SetCircuitBreakerState(service, dependency, state string)
+	RecordOutboundRetry(service, dependency, reason string)
+}
@@ -0,0 +1,8 @@
+package transport
+
+import "errors"
+
+var (
+	// ErrCircuitOpen возвращается, когда circuit breaker разомкнут и запрос не отправляется
+	ErrCircuitOpen = errors.New("transport: circuit breaker is open")
+)
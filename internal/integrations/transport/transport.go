@@ -0,0 +1,219 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Причины повтора запроса (значения метки reason)
+const (
+	retryReasonNetwork     = "network"
+	retryReasonTimeout     = "timeout"
+	retryReasonRateLimited = "status_429"
+	retryReasonUnavailable = "status_5xx"
+)
+
+// Transport устойчивый http.RoundTripper для вызовов внешних сервисов
+//
+// - каждая попытка ограничена AttemptTimeout, но не дольше дедлайна контекста запроса;
+// - идемпотентные GET/HEAD без тела повторяются при сетевых ошибках, таймаутах,
+// ответах 429/502/503/504 с экспоненциальной задержкой и jitter;
+// - circuit breaker на зависимость прекращает отправку запросов после серии неудач.
+type Transport struct {
+	base        http.RoundTripper
+	cfg         Config
+	breaker     *breaker
+	metrics     Metrics
+	serviceName string
+	log         Logger
+}
+
+// New создает устойчивый транспорт поверх base
+// metrics может быть nil, если метрики отключены
+func New(base http.RoundTripper, cfg Config, metrics Metrics, serviceName string, log Logger) *Transport {
+	t := &Transport{
+		base:        base,
+		cfg:         cfg,
+		metrics:     metrics,
+		serviceName: serviceName,
+		log:         log,
+	}
+	if cfg.BreakerEnabled {
+		t.breaker = newBreaker(cfg, t.onStateChange)
+		if metrics != nil {
+			metrics.SetCircuitBreakerState(serviceName, cfg.Dependency, StateClosed)
+		}
+	}
+	return t
+}
+
+// NewHTTPClient создает http.Client с устойчивым транспортом поверх http.DefaultTransport
+// Общий таймаут клиента не задается: время вызова ограничивается контекстом и AttemptTimeout.
+// Каждая попытка - отдельный спан ("userservice GET"), контекст трассы передается в заголовке traceparent.
+func NewHTTPClient(cfg Config, metrics Metrics, serviceName string, log Logger) *http.Client {
+	traced := otelhttp.NewTransport(http.DefaultTransport,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return cfg.Dependency + " " + r.Method
+		}),
+	)
+	return &http.Client{
+		Transport: New(traced, cfg, metrics, serviceName, log),
+	}
+}
+
+// RoundTrip выполняет запрос с повторами и учетом состояния circuit breaker
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxAttempts := 1
+	if isRetryable(req) && t.cfg.MaxAttempts > 1 {
+		maxAttempts = t.cfg.MaxAttempts
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(req)
+
+		reason := retryReason(ctx, resp, err)
+		if reason == "" || attempt >= maxAttempts {
+			return resp, err
+		}
+
+		// Не повторяем, если до дедлайна запроса не успеем дождаться следующей попытки
+		delay := t.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		t.log.WarnContext(ctx, "Transport: %s %s %s failed (attempt %d/%d, reason=%s), retrying in %s",
+			t.cfg.Dependency, req.Method, req.URL.Path, attempt, maxAttempts, reason, delay)
+		if t.metrics != nil {
+			t.metrics.RecordOutboundRetry(t.serviceName, t.cfg.Dependency, reason)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt выполняет одну попытку с собственным дедлайном
+func (t *Transport) attempt(req *http.Request) (*http.Response, error) {
+	var p *permit
+	if t.breaker != nil {
+		var err error
+		if p, err = t.breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.cfg.AttemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.cfg.AttemptTimeout)
+	}
+
+	resp, err := t.base.RoundTrip(req.Clone(ctx))
+
+	if t.breaker != nil {
+		switch {
+		case req.Context().Err() != nil:
+			// Запрос отменён вызывающим - это не сбой зависимости
+			t.breaker.release(p)
+		case err != nil:
+			t.breaker.record(p, false)
+		default:
+			t.breaker.record(p, resp.StatusCode < http.StatusInternalServerError)
+		}
+	}
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// Дедлайн попытки должен действовать до конца чтения тела ответа
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff вычисляет задержку перед повтором: экспоненциальный рост с equal jitter
+func (t *Transport) backoff(attempt int) time.Duration {
+	delay := t.cfg.InitialBackoff << (attempt - 1)
+	if delay <= 0 || delay > t.cfg.MaxBackoff {
+		delay = t.cfg.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// onStateChange логирует переход circuit breaker и записывает метрику
+func (t *Transport) onStateChange(from, to string) {
+	if to == StateOpen {
+		t.log.Error("Transport: circuit breaker for %s changed %s -> %s", t.cfg.Dependency, from, to)
+	} else {
+		t.log.Warn("Transport: circuit breaker for %s changed %s -> %s", t.cfg.Dependency, from, to)
+	}
+	if t.metrics != nil {
+		t.metrics.RecordCircuitBreakerState(t.serviceName, t.cfg.Dependency, from, to)
+	}
+}
+
+// isRetryable проверяет, что запрос идемпотентен и может быть безопасно повторен
+func isRetryable(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody
+}
+
+// retryReason возвращает причину повтора или пустую строку, если повтор не нужен
+func retryReason(ctx context.Context, resp *http.Response, err error) string {
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrCircuitOpen), ctx.Err() != nil:
+			return ""
+		case errors.Is(err, context.DeadlineExceeded):
+			return retryReasonTimeout
+		default:
+			return retryReasonNetwork
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return retryReasonRateLimited
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return retryReasonUnavailable
+	default:
+		return ""
+	}
+}
+
+// cancelOnClose освобождает контекст попытки при закрытии тела ответа
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
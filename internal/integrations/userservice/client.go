@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"time"
+
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
 )
 
 // Client клиент для работы с UserService
@@ -17,13 +18,12 @@ type Client struct {
 }
 
 // NewClient создает новый экземпляр клиента UserService
-func NewClient(baseURL string, timeout time.Duration, log Logger) *Client {
+// httpClient должен ограничивать время вызова (см. transport.NewHTTPClient)
+func NewClient(baseURL string, httpClient *http.Client, log Logger) *Client {
 	return &Client{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		log: log,
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		log:        log,
 	}
 }
 
@@ -38,6 +38,10 @@ func (c *Client) GetSelectedCar(ctx context.Context, tgUserID int64) (*Car, erro
 
 	req.Header.Set("Content-Type", "application/json")
 
+	if id := requestid.FromContext(ctx); id != "" {
+		req.Header.Set(requestid.Header, id)
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to execute request: %v", ErrInternal, err)
@@ -69,24 +73,24 @@ func (c *Client) GetSelectedCar(ctx context.Context, tgUserID int64) (*Car, erro
 // GetSelectedCarWithGracefulDegradation получает выбранный автомобиль пользователя с graceful degradation
 // При недоступности UserService возвращает ErrServiceDegraded, что позволяет сервису использовать базовые цены
 func (c *Client) GetSelectedCarWithGracefulDegradation(ctx context.Context, tgUserID int64) (*Car, error) {
-	c.log.Info("Fetching selected car for tg_user_id=%d", tgUserID)
+	c.log.InfoContext(ctx, "Fetching selected car for tg_user_id=%d", tgUserID)
 
 	car, err := c.GetSelectedCar(ctx, tgUserID)
 	if err != nil {
 		// Если это критичная бизнес-ошибка (не найден автомобиль),
 		// пробрасываем её дальше
 		if err == ErrCarNotFound {
-			c.log.Info("No selected car found for tg_user_id=%d", tgUserID)
+			c.log.InfoContext(ctx, "No selected car found for tg_user_id=%d", tgUserID)
 			return nil, err
 		}
 
 		// Для всех остальных ошибок (недоступность сервиса, timeout, ошибки парсинга и т.д.)
 		// применяем graceful degradation - возвращаем ErrServiceDegraded с контекстом
 		// Повышаем уровень логирования до ERROR, чтобы быстрее заметить проблему
-		c.log.Error("UserService unavailable, applying graceful degradation for tg_user_id=%d: %v", tgUserID, err)
+		c.log.ErrorContext(ctx, "UserService unavailable, applying graceful degradation for tg_user_id=%d: %v", tgUserID, err)
 		return nil, fmt.Errorf("%w: tg_user_id=%d, error=%v", ErrServiceDegraded, tgUserID, err)
 	}
 
-	c.log.Info("Successfully fetched car for tg_user_id=%d, vehicle_class=%s", tgUserID, car.Size)
+	c.log.InfoContext(ctx, "Successfully fetched car for tg_user_id=%d, vehicle_class=%s", tgUserID, car.Size)
 	return car, nil
 }
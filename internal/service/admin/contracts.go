@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+)
+
+// BookingRepository интерфейс репозитория бронирований
+type BookingRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.Booking, error)
+	GetByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter) ([]*domain.Booking, error)
+	Search(ctx context.Context, filter domain.AdminBookingsFilter) ([]*domain.Booking, error)
+	UpdateStatus(ctx context.Context, id int64, status domain.BookingStatus) error
+	Cancel(ctx context.Context, id int64, status domain.BookingStatus, reason string) error
+	Restore(ctx context.Context, id int64, status domain.BookingStatus) error
+	Reassign(ctx context.Context, id int64, userID int64) error
+	SetAuditContext(ctx context.Context, audit domain.AuditContext) error
+	GetHistory(ctx context.Context, bookingID int64) ([]*domain.BookingHistoryEntry, error)
+}
+
+// ConfigRepository интерфейс репозитория конфигурации слотов
+// Используется для проверки вместимости слота при восстановлении бронирования
+type ConfigRepository interface {
+	GetConfigWithHierarchy(ctx context.Context, companyID int64, addressID *int64, serviceID *int64) (*domain.CompanySlotsConfig, error)
+}
+
+// TransactionManager интерфейс для управления транзакциями
+// Изменения выполняются в транзакции, чтобы триггер истории получил контекст аудита
+type TransactionManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	DoSerializable(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// BookingEventPublisher публикация изменений бронирований (поток слотов, поток бронирований компании)
+type BookingEventPublisher interface {
+	PublishBookingEvent(ctx context.Context, event domain.BookingEvent)
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,23 @@
+package admin
+
+import "errors"
+
+var (
+	// ErrBookingNotFound возвращается, когда бронирование не найдено
+	ErrBookingNotFound = errors.New("booking not found")
+
+	// ErrAccessDenied возвращается, когда пользователь не является администратором платформы
+	ErrAccessDenied = errors.New("access denied")
+
+	// ErrInvalidInput возвращается при некорректных входных данных
+	ErrInvalidInput = errors.New("invalid input data")
+
+	// ErrNotCancelled возвращается при попытке восстановить неотмененное бронирование
+	ErrNotCancelled = errors.New("booking is not cancelled")
+
+	// ErrSlotNotAvailable возвращается, когда восстановление превысит вместимость слота
+	ErrSlotNotAvailable = errors.New("slot is not available")
+
+	// ErrInternal возвращается при внутренних ошибках сервиса
+	ErrInternal = errors.New("service: internal error")
+)
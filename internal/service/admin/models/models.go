@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	bookingsModels "github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+)
+
+// Request модели
+
+// SearchBookingsRequest запрос на поиск бронирований по всем компаниям
+type SearchBookingsRequest struct {
+	AdminID      int64
+	CompanyID    *int64
+	UserID       *int64
+	AddressID    *int64
+	ServiceID    *int64
+	Statuses     []string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	LicensePlate *string
+	Limit        int
+	Offset       int
+}
+
+// ForceStatusRequest запрос на принудительное изменение статуса
+type ForceStatusRequest struct {
+	AdminID int64
+	Status  string
+	Reason  string
+}
+
+// RestoreBookingRequest запрос на восстановление отмененного бронирования
+type RestoreBookingRequest struct {
+	AdminID int64
+	Status  *string // Статус после восстановления (pending или confirmed, по умолчанию confirmed)
+	Reason  string
+	Force   bool // Восстановить, даже если слот уже заполнен
+}
+
+// ReassignBookingRequest запрос на передачу бронирования другому пользователю
+type ReassignBookingRequest struct {
+	AdminID int64
+	UserID  int64
+	Reason  string
+}
+
+// Response модели
+
+// SearchBookingsResponse ответ на поиск бронирований
+type SearchBookingsResponse struct {
+	Bookings []bookingsModels.BookingResponse `json:"bookings"`
+	Limit    int                              `json:"limit"`
+	Offset   int                              `json:"offset"`
+}
+
+// HistoryEntryResponse запись истории изменений бронирования
+type HistoryEntryResponse struct {
+	ID        int64           `json:"id"`
+	Operation string          `json:"operation"`
+	Action    *string         `json:"action,omitempty"`
+	ActorID   *int64          `json:"actorId,omitempty"`
+	ActorRole *string         `json:"actorRole,omitempty"`
+	Reason    *string         `json:"reason,omitempty"`
+	Changes   json.RawMessage `json:"changes"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// BookingHistoryResponse история изменений бронирования
+type BookingHistoryResponse struct {
+	BookingID int64                  `json:"bookingId"`
+	Entries   []HistoryEntryResponse `json:"entries"`
+}
+
+// Методы конвертации
+
+// FromDomainHistory конвертирует историю изменений в DTO
+func FromDomainHistory(bookingID int64, entries []*domain.BookingHistoryEntry) *BookingHistoryResponse {
+	result := &BookingHistoryResponse{
+		BookingID: bookingID,
+		Entries:   make([]HistoryEntryResponse, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		result.Entries = append(result.Entries, HistoryEntryResponse{
+			ID:        e.ID,
+			Operation: e.Operation,
+			Action:    e.Action,
+			ActorID:   e.ActorID,
+			ActorRole: e.ActorRole,
+			Reason:    e.Reason,
+			Changes:   e.Changes,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,432 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	bookingRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/booking"
+	configRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/config"
+	"github.com/m04kA/SMC-BookingService/internal/service/admin/models"
+	bookingsModels "github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
+)
+
+// Ограничения поиска
+const (
+	DefaultSearchLimit = 50
+	MaxSearchLimit     = 500
+)
+
+const msgUseRestore = "отмененное бронирование восстанавливается через /restore"
+
+// Service сервис операций поддержки для администраторов платформы
+// Все изменения выполняются в транзакции с контекстом аудита (ID администратора, действие, причина),
+// который триггер записывает в историю изменений бронирования
+type Service struct {
+	bookingRepo BookingRepository
+	configRepo  ConfigRepository
+	txManager   TransactionManager
+	events      BookingEventPublisher
+	logger      Logger
+}
+
+// NewService создает новый экземпляр сервиса администрирования
+func NewService(
+	bookingRepo BookingRepository,
+	configRepo ConfigRepository,
+	txManager TransactionManager,
+	events BookingEventPublisher,
+	logger Logger,
+) *Service {
+	return &Service{
+		bookingRepo: bookingRepo,
+		configRepo:  configRepo,
+		txManager:   txManager,
+		events:      events,
+		logger:      logger,
+	}
+}
+
+// SearchBookings ищет бронирования по всем компаниям
+func (s *Service) SearchBookings(ctx context.Context, req *models.SearchBookingsRequest) (*models.SearchBookingsResponse, error) {
+	if err := s.checkAdmin(ctx, "SearchBookings", req.AdminID); err != nil {
+		return nil, err
+	}
+
+	filter, err := toSearchFilter(req)
+	if err != nil {
+		s.logger.WarnContext(ctx, "SearchBookings: invalid filter by admin=%d: %v", req.AdminID, err)
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "SearchBookings: admin=%d searching bookings: company=%v, user=%v, statuses=%v, limit=%d, offset=%d",
+		req.AdminID, req.CompanyID, req.UserID, req.Statuses, filter.Limit, filter.Offset)
+
+	bookings, err := s.bookingRepo.Search(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "SearchBookings: repository error: %v", err)
+		return nil, fmt.Errorf("%w: SearchBookings - repository error: %w", ErrInternal, err)
+	}
+
+	return &models.SearchBookingsResponse{
+		Bookings: bookingsModels.FromDomainBookingList(bookings).Bookings,
+		Limit:    filter.Limit,
+		Offset:   filter.Offset,
+	}, nil
+}
+
+// ForceStatus принудительно устанавливает статус бронирования без проверки допустимости перехода
+// Отмена сохраняет причину в cancellation_reason. Выход из отмены не допускается:
+// он занимает место в слоте и выполняется через Restore с проверкой вместимости
+func (s *Service) ForceStatus(ctx context.Context, bookingID int64, req *models.ForceStatusRequest) (*bookingsModels.BookingResponse, error) {
+	if err := s.checkAdmin(ctx, "ForceStatus", req.AdminID); err != nil {
+		return nil, err
+	}
+
+	reason, err := validateReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	newStatus, err := bookingsModels.ToDomainBookingStatus(req.Status)
+	if err != nil {
+		s.logger.WarnContext(ctx, "ForceStatus: invalid status=%s for booking id=%d", req.Status, bookingID)
+		return nil, domain.InvalidField(ErrInvalidInput, "status", "некорректный статус бронирования")
+	}
+
+	s.logger.InfoContext(ctx, "ForceStatus: admin=%d setting booking id=%d to status=%s, reason=%q",
+		req.AdminID, bookingID, newStatus, reason)
+
+	var result *domain.Booking
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		booking, err := s.getBooking(ctx, "ForceStatus", bookingID)
+		if err != nil {
+			return err
+		}
+		if booking.Status == newStatus {
+			return domain.InvalidField(ErrInvalidInput, "status", "бронирование уже в статусе "+string(newStatus))
+		}
+		cancelling := newStatus == domain.StatusCancelledByUser || newStatus == domain.StatusCancelledByCompany
+		if booking.IsCancelled() && !cancelling {
+			// Выход из отмены занимает место в слоте - только через Restore с проверкой вместимости
+			s.logger.WarnContext(ctx, "ForceStatus: booking id=%d is cancelled, restore must be used", bookingID)
+			return domain.InvalidField(ErrInvalidInput, "status", msgUseRestore)
+		}
+
+		if err := s.setAudit(ctx, domain.AuditActionAdminForceStatus, req.AdminID, reason); err != nil {
+			return err
+		}
+
+		if cancelling {
+			err = s.bookingRepo.Cancel(ctx, bookingID, newStatus, reason)
+		} else {
+			err = s.bookingRepo.UpdateStatus(ctx, bookingID, newStatus)
+		}
+		if err != nil {
+			return s.repoError(ctx, "ForceStatus", bookingID, err)
+		}
+
+		result, err = s.getBooking(ctx, "ForceStatus", bookingID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := domain.BookingEventStatusChanged
+	if result.IsCancelled() {
+		eventType = domain.BookingEventCancelled
+	}
+	s.events.PublishBookingEvent(ctx, domain.BookingEvent{Type: eventType, Booking: result})
+
+	s.logger.InfoContext(ctx, "ForceStatus: booking id=%d status changed to %s by admin=%d", bookingID, newStatus, req.AdminID)
+	return bookingsModels.FromDomainBooking(result), nil
+}
+
+// Restore восстанавливает ошибочно отмененное бронирование
+// Без req.Force проверяется, что в слоте осталось место с учетом текущей конфигурации
+func (s *Service) Restore(ctx context.Context, bookingID int64, req *models.RestoreBookingRequest) (*bookingsModels.BookingResponse, error) {
+	if err := s.checkAdmin(ctx, "Restore", req.AdminID); err != nil {
+		return nil, err
+	}
+
+	reason, err := validateReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	newStatus := domain.StatusConfirmed
+	if req.Status != nil {
+		newStatus = domain.BookingStatus(*req.Status)
+		if newStatus != domain.StatusConfirmed && newStatus != domain.StatusPending {
+			return nil, domain.InvalidField(ErrInvalidInput, "status", "допустимые значения: pending, confirmed")
+		}
+	}
+
+	s.logger.InfoContext(ctx, "Restore: admin=%d restoring booking id=%d to status=%s, force=%t, reason=%q",
+		req.AdminID, bookingID, newStatus, req.Force, reason)
+
+	var result *domain.Booking
+	err = s.txManager.DoSerializable(ctx, func(ctx context.Context) error {
+		booking, err := s.getBooking(ctx, "Restore", bookingID)
+		if err != nil {
+			return err
+		}
+		if !booking.IsCancelled() {
+			s.logger.WarnContext(ctx, "Restore: booking id=%d is not cancelled, status=%s", bookingID, booking.Status)
+			return ErrNotCancelled
+		}
+
+		if !req.Force {
+			if err := s.checkSlotCapacity(ctx, booking); err != nil {
+				return err
+			}
+		}
+
+		if err := s.setAudit(ctx, domain.AuditActionAdminRestore, req.AdminID, reason); err != nil {
+			return err
+		}
+
+		if err := s.bookingRepo.Restore(ctx, bookingID, newStatus); err != nil {
+			return s.repoError(ctx, "Restore", bookingID, err)
+		}
+
+		result, err = s.getBooking(ctx, "Restore", bookingID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishBookingEvent(ctx, domain.BookingEvent{Type: domain.BookingEventStatusChanged, Booking: result})
+
+	s.logger.InfoContext(ctx, "Restore: booking id=%d restored by admin=%d", bookingID, req.AdminID)
+	return bookingsModels.FromDomainBooking(result), nil
+}
+
+// Reassign передает бронирование другому пользователю
+func (s *Service) Reassign(ctx context.Context, bookingID int64, req *models.ReassignBookingRequest) (*bookingsModels.BookingResponse, error) {
+	if err := s.checkAdmin(ctx, "Reassign", req.AdminID); err != nil {
+		return nil, err
+	}
+
+	reason, err := validateReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID <= 0 {
+		return nil, domain.InvalidField(ErrInvalidInput, "userId", "ID пользователя должен быть положительным")
+	}
+
+	s.logger.InfoContext(ctx, "Reassign: admin=%d reassigning booking id=%d to user=%d, reason=%q",
+		req.AdminID, bookingID, req.UserID, reason)
+
+	var result *domain.Booking
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		booking, err := s.getBooking(ctx, "Reassign", bookingID)
+		if err != nil {
+			return err
+		}
+		if booking.UserID == req.UserID {
+			return domain.InvalidField(ErrInvalidInput, "userId", "бронирование уже принадлежит этому пользователю")
+		}
+
+		if err := s.setAudit(ctx, domain.AuditActionAdminReassign, req.AdminID, reason); err != nil {
+			return err
+		}
+
+		if err := s.bookingRepo.Reassign(ctx, bookingID, req.UserID); err != nil {
+			return s.repoError(ctx, "Reassign", bookingID, err)
+		}
+
+		result, err = s.getBooking(ctx, "Reassign", bookingID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.PublishBookingEvent(ctx, domain.BookingEvent{Type: domain.BookingEventReassigned, Booking: result})
+
+	s.logger.InfoContext(ctx, "Reassign: booking id=%d reassigned to user=%d by admin=%d", bookingID, req.UserID, req.AdminID)
+	return bookingsModels.FromDomainBooking(result), nil
+}
+
+// GetHistory получает полную историю изменений бронирования
+// История доступна и для удаленных бронирований
+func (s *Service) GetHistory(ctx context.Context, bookingID int64, adminID int64) (*models.BookingHistoryResponse, error) {
+	if err := s.checkAdmin(ctx, "GetHistory", adminID); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "GetHistory: admin=%d fetching history of booking id=%d", adminID, bookingID)
+
+	entries, err := s.bookingRepo.GetHistory(ctx, bookingID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "GetHistory: repository error for booking id=%d: %v", bookingID, err)
+		return nil, fmt.Errorf("%w: GetHistory - repository error: %w", ErrInternal, err)
+	}
+
+	if len(entries) == 0 {
+		// Бронирования, созданные до включения истории, не имеют записей
+		if _, err := s.getBooking(ctx, "GetHistory", bookingID); err != nil {
+			return nil, err
+		}
+	}
+
+	return models.FromDomainHistory(bookingID, entries), nil
+}
+
+// Вспомогательные методы
+
+// checkAdmin проверяет, что действие выполняет администратор платформы
+func (s *Service) checkAdmin(ctx context.Context, op string, adminID int64) error {
+	if !policy.IsPlatformAdmin(policy.ActorFromContext(ctx, adminID)) {
+		s.logger.WarnContext(ctx, "%s: user=%d is not a platform admin", op, adminID)
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// setAudit задает контекст аудита для изменений в текущей транзакции
+func (s *Service) setAudit(ctx context.Context, action string, adminID int64, reason string) error {
+	audit := domain.AuditContext{
+		Action:    action,
+		ActorID:   adminID,
+		ActorRole: string(policy.RolePlatformAdmin),
+		Reason:    reason,
+	}
+	if err := s.bookingRepo.SetAuditContext(ctx, audit); err != nil {
+		s.logger.ErrorContext(ctx, "%s: failed to set audit context: %v", action, err)
+		return fmt.Errorf("%w: failed to set audit context: %w", ErrInternal, err)
+	}
+	return nil
+}
+
+// getBooking получает бронирование по ID
+func (s *Service) getBooking(ctx context.Context, op string, bookingID int64) (*domain.Booking, error) {
+	booking, err := s.bookingRepo.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, s.repoError(ctx, op, bookingID, err)
+	}
+	return booking, nil
+}
+
+// repoError конвертирует ошибку репозитория в ошибку сервиса
+func (s *Service) repoError(ctx context.Context, op string, bookingID int64, err error) error {
+	if errors.Is(err, bookingRepo.ErrBookingNotFound) {
+		s.logger.WarnContext(ctx, "%s: booking id=%d not found", op, bookingID)
+		return ErrBookingNotFound
+	}
+	s.logger.ErrorContext(ctx, "%s: repository error for booking id=%d: %v", op, bookingID, err)
+	return fmt.Errorf("%w: %s - repository error: %w", ErrInternal, op, err)
+}
+
+// checkSlotCapacity проверяет, что восстановление не превысит вместимость слота
+func (s *Service) checkSlotCapacity(ctx context.Context, booking *domain.Booking) error {
+	config, err := s.configRepo.GetConfigWithHierarchy(ctx, booking.CompanyID, &booking.AddressID, &booking.ServiceID)
+	if err != nil && !errors.Is(err, configRepo.ErrConfigNotFound) {
+		s.logger.ErrorContext(ctx, "Restore: failed to get config: %v", err)
+		return fmt.Errorf("%w: failed to get config: %w", ErrInternal, err)
+	}
+
+	maxConcurrent := domain.DefaultMaxConcurrentBookings
+	if config != nil {
+		maxConcurrent = config.MaxConcurrentBookings
+	}
+
+	filter := domain.CompanyBookingsFilter{
+		CompanyID:       booking.CompanyID,
+		AddressID:       &booking.AddressID,
+		StartDate:       &booking.BookingDate,
+		EndDate:         &booking.BookingDate,
+		IncludeInactive: false,
+	}
+	bookings, err := s.bookingRepo.GetByCompanyWithFilter(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Restore: failed to get bookings: %v", err)
+		return fmt.Errorf("%w: failed to get bookings: %w", ErrInternal, err)
+	}
+
+	bookingEnd, err := booking.StartTime.AddMinutes(booking.DurationMinutes)
+	if err != nil {
+		return fmt.Errorf("%w: failed to calculate booking end: %v", ErrInternal, err)
+	}
+
+	overlapping := 0
+	for _, b := range bookings {
+		if b.ID == booking.ID || !b.IsActive() {
+			continue
+		}
+		end, err := b.StartTime.AddMinutes(b.DurationMinutes)
+		if err != nil {
+			continue
+		}
+		if b.StartTime.IsBefore(bookingEnd) && end.IsAfter(booking.StartTime) {
+			overlapping++
+		}
+	}
+
+	if overlapping >= maxConcurrent {
+		s.logger.WarnContext(ctx, "Restore: slot not available for booking id=%d, %d/%d spots taken",
+			booking.ID, overlapping, maxConcurrent)
+		return ErrSlotNotAvailable
+	}
+
+	return nil
+}
+
+// validateReason проверяет обязательную причину действия администратора
+func validateReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", domain.InvalidField(ErrInvalidInput, "reason", "причина обязательна")
+	}
+	if len([]rune(reason)) > domain.MaxCancellationReasonLength {
+		return "", domain.InvalidField(ErrInvalidInput, "reason",
+			fmt.Sprintf("причина не должна превышать %d символов", domain.MaxCancellationReasonLength))
+	}
+	return reason, nil
+}
+
+// toSearchFilter конвертирует запрос поиска в domain фильтр
+func toSearchFilter(req *models.SearchBookingsRequest) (domain.AdminBookingsFilter, error) {
+	filter := domain.AdminBookingsFilter{
+		CompanyID:    req.CompanyID,
+		UserID:       req.UserID,
+		AddressID:    req.AddressID,
+		ServiceID:    req.ServiceID,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		LicensePlate: req.LicensePlate,
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+	}
+
+	for _, st := range req.Statuses {
+		status, err := bookingsModels.ToDomainBookingStatus(st)
+		if err != nil {
+			return filter, domain.InvalidField(ErrInvalidInput, "status", fmt.Sprintf("некорректный статус бронирования %q", st))
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && filter.EndDate.Before(*filter.StartDate) {
+		return filter, domain.InvalidField(ErrInvalidInput, "to", "конец периода раньше начала")
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = DefaultSearchLimit
+	}
+	if filter.Limit < 0 || filter.Limit > MaxSearchLimit {
+		return filter, domain.InvalidField(ErrInvalidInput, "limit", fmt.Sprintf("размер страницы должен быть от 1 до %d", MaxSearchLimit))
+	}
+	if filter.Offset < 0 {
+		return filter, domain.InvalidField(ErrInvalidInput, "offset", "смещение не может быть отрицательным")
+	}
+
+	return filter, nil
+}
@@ -6,18 +6,28 @@ import (
 	"github.com/m04kA/SMC-BookingService/internal/domain"
 	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
 	"github.com/m04kA/SMC-BookingService/internal/integrations/userservice"
+	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
 )
 
 // BookingRepository интерфейс репозитория бронирований
 type BookingRepository interface {
 	Create(ctx context.Context, booking *domain.Booking) (*domain.Booking, error)
 	GetByID(ctx context.Context, id int64) (*domain.Booking, error)
-	GetByUserID(ctx context.Context, userID int64, status *domain.BookingStatus) ([]*domain.Booking, error)
+	GetByUserID(ctx context.Context, filter domain.UserBookingsFilter) ([]*domain.Booking, error)
 	GetByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter) ([]*domain.Booking, error)
+	StreamByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter, fn func(*domain.Booking) error) error
 	UpdateStatus(ctx context.Context, id int64, status domain.BookingStatus) error
 	Cancel(ctx context.Context, id int64, status domain.BookingStatus, reason string) error
 }
 
+// BookingExporter получатель выгрузки бронирований (CSV, XLSX)
+// Begin вызывается после проверки прав доступа, до первой строки
+type BookingExporter interface {
+	Begin() error
+	Row(booking *models.BookingResponse) error
+	End(totals []models.StatusTotal) error
+}
+
 // ConfigRepository интерфейс репозитория конфигурации слотов
 type ConfigRepository interface {
 	GetByCompanyAndService(ctx context.Context, companyID int64, serviceID *int64) (*domain.CompanySlotsConfig, error)
@@ -42,9 +52,14 @@ type TransactionManager interface {
 	DoReadOnly(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+// BookingEventPublisher публикация изменений бронирований (поток слотов, поток бронирований компании)
+type BookingEventPublisher interface {
+	PublishBookingEvent(ctx context.Context, event domain.BookingEvent)
+}
+
 // Logger интерфейс для логирования
 type Logger interface {
-	Info(format string, v ...interface{})
-	Warn(format string, v ...interface{})
-	Error(format string, v ...interface{})
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
 }
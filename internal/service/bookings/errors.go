@@ -33,6 +33,9 @@ var (
 	// ErrInvalidTimeRange возвращается при некорректном временном диапазоне
 	ErrInvalidTimeRange = errors.New("invalid time range")
 
+	// ErrExportWrite возвращается, когда выгрузку не удалось записать получателю
+	ErrExportWrite = errors.New("export write failed")
+
 	// ErrInternal возвращается при внутренних ошибках сервиса
 	ErrInternal = errors.New("service: internal error")
 )
@@ -2,14 +2,33 @@ package models
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/types"
 )
 
 var (
 	// ErrInvalidStatus возвращается при некорректном статусе
 	ErrInvalidStatus = errors.New("invalid booking status")
+
+	// ErrInvalidPeriod возвращается, когда конец периода раньше начала
+	ErrInvalidPeriod = errors.New("invalid period")
+
+	// ErrInvalidTimeRange возвращается при некорректном диапазоне времени начала
+	ErrInvalidTimeRange = errors.New("invalid time range")
+)
+
+// Сообщения клиенту об ошибках полей фильтра
+const (
+	msgInvalidStatus    = "некорректный статус бронирования"
+	msgInvalidPeriod    = "конец периода раньше начала"
+	msgInvalidTime      = "некорректный формат времени, ожидается HH:MM"
+	msgInvalidTimeRange = "конец диапазона времени должен быть позже начала"
+	msgInvalidLimit     = "размер страницы должен быть от 1 до 200"
+	msgInvalidSort      = "допустимые значения: asc, desc"
+	msgInvalidCursor    = "некорректный курсор пагинации"
 )
 
 // Request модели
@@ -26,21 +45,76 @@ type UpdateStatusRequest struct {
 	Status string `json:"status"`
 }
 
+// PageRequest параметры пагинации списка бронирований
+type PageRequest struct {
+	Limit  int     `json:"limit,omitempty"`  // Размер страницы (0 - domain.DefaultPageLimit)
+	Cursor *string `json:"cursor,omitempty"` // Курсор из nextCursor предыдущей страницы
+	Sort   *string `json:"sort,omitempty"`   // Направление сортировки: asc или desc
+}
+
 // GetUserBookingsRequest запрос на получение бронирований пользователя
 type GetUserBookingsRequest struct {
-	UserID int64   `json:"userId"`
-	Status *string `json:"status,omitempty"`
+	UserID    int64       `json:"userId"`
+	Status    *string     `json:"status,omitempty"`
+	StartDate *time.Time  `json:"startDate,omitempty"` // Начало периода (опционально)
+	EndDate   *time.Time  `json:"endDate,omitempty"`   // Конец периода (опционально)
+	Page      PageRequest `json:"page"`
+}
+
+// ToDomainFilter конвертирует request в domain фильтр
+func (r *GetUserBookingsRequest) ToDomainFilter() (domain.UserBookingsFilter, error) {
+	filter := domain.UserBookingsFilter{
+		UserID:    r.UserID,
+		StartDate: r.StartDate,
+		EndDate:   r.EndDate,
+	}
+
+	if err := validatePeriod(r.StartDate, r.EndDate); err != nil {
+		return filter, err
+	}
+
+	// Конвертируем статус если указан
+	if r.Status != nil {
+		status, err := ToDomainBookingStatus(*r.Status)
+		if err != nil {
+			return filter, domain.InvalidField(err, "status", msgInvalidStatus)
+		}
+		filter.Status = &status
+	}
+
+	page, err := r.Page.ToDomainPage()
+	if err != nil {
+		return filter, err
+	}
+	filter.Page = page
+
+	return filter, nil
 }
 
 // GetCompanyBookingsRequest запрос на получение бронирований компании
 type GetCompanyBookingsRequest struct {
-	UserID          int64      `json:"userId"`
-	CompanyID       int64      `json:"companyId"`
-	AddressID       *int64     `json:"addressId,omitempty"`       // Фильтр по адресу (опционально)
-	StartDate       *time.Time `json:"startDate,omitempty"`       // Начало периода (опционально)
-	EndDate         *time.Time `json:"endDate,omitempty"`         // Конец периода (опционально)
-	Status          *string    `json:"status,omitempty"`          // Фильтр по статусу (опционально)
-	IncludeInactive bool       `json:"includeInactive,omitempty"` // Включить отменённые бронирования
+	UserID          int64       `json:"userId"`
+	CompanyID       int64       `json:"companyId"`
+	AddressID       *int64      `json:"addressId,omitempty"`       // Фильтр по адресу (опционально)
+	ServiceID       *int64      `json:"serviceId,omitempty"`       // Фильтр по услуге (опционально)
+	CustomerID      *int64      `json:"customerId,omitempty"`      // Фильтр по клиенту (опционально)
+	StartDate       *time.Time  `json:"startDate,omitempty"`       // Начало периода (опционально)
+	EndDate         *time.Time  `json:"endDate,omitempty"`         // Конец периода (опционально)
+	TimeFrom        *string     `json:"timeFrom,omitempty"`        // Время начала не раньше, HH:MM (опционально)
+	TimeTo          *string     `json:"timeTo,omitempty"`          // Время начала раньше, HH:MM (опционально)
+	Statuses        []string    `json:"statuses,omitempty"`        // Фильтр по статусам (опционально)
+	LicensePlate    *string     `json:"licensePlate,omitempty"`    // Часть госномера (опционально)
+	IncludeInactive bool        `json:"includeInactive,omitempty"` // Включить отменённые бронирования
+	Page            PageRequest `json:"page"`
+}
+
+// GetCompanySnapshotRequest запрос снимка бронирований компании на дату (поток бронирований менеджера)
+type GetCompanySnapshotRequest struct {
+	UserID     int64     `json:"userId"`
+	CompanyID  int64     `json:"companyId"`
+	AddressIDs []int64   `json:"addressIds,omitempty"` // Фильтр по адресам (пусто - все адреса)
+	Date       time.Time `json:"date"`
+	Limit      int       `json:"limit"` // Максимум бронирований в снимке
 }
 
 // ToDomainFilter конвертирует request в domain фильтр
@@ -48,20 +122,57 @@ func (r *GetCompanyBookingsRequest) ToDomainFilter() (domain.CompanyBookingsFilt
 	filter := domain.CompanyBookingsFilter{
 		CompanyID:       r.CompanyID,
 		AddressID:       r.AddressID,
+		ServiceID:       r.ServiceID,
+		UserID:          r.CustomerID,
 		StartDate:       r.StartDate,
 		EndDate:         r.EndDate,
 		IncludeInactive: r.IncludeInactive,
 	}
 
-	// Конвертируем статус если указан
-	if r.Status != nil {
-		status, err := ToDomainBookingStatus(*r.Status)
+	// Конвертируем статусы если указаны
+	for _, st := range r.Statuses {
+		status, err := ToDomainBookingStatus(st)
 		if err != nil {
-			return filter, err
+			return filter, domain.InvalidField(err, "status", msgInvalidStatus)
 		}
-		filter.Status = &status
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	if err := validatePeriod(r.StartDate, r.EndDate); err != nil {
+		return filter, err
+	}
+
+	// Конвертируем диапазон времени начала
+	if r.TimeFrom != nil {
+		timeFrom, err := types.NewTimeStringFromString(*r.TimeFrom)
+		if err != nil {
+			return filter, domain.InvalidField(ErrInvalidTimeRange, "timeFrom", msgInvalidTime)
+		}
+		filter.StartTimeFrom = &timeFrom
+	}
+	if r.TimeTo != nil {
+		timeTo, err := types.NewTimeStringFromString(*r.TimeTo)
+		if err != nil {
+			return filter, domain.InvalidField(ErrInvalidTimeRange, "timeTo", msgInvalidTime)
+		}
+		filter.StartTimeTo = &timeTo
+	}
+	if filter.StartTimeFrom != nil && filter.StartTimeTo != nil && !filter.StartTimeFrom.IsBefore(*filter.StartTimeTo) {
+		return filter, domain.InvalidField(ErrInvalidTimeRange, "timeTo", msgInvalidTimeRange)
 	}
 
+	if r.LicensePlate != nil {
+		if plate := strings.TrimSpace(*r.LicensePlate); plate != "" {
+			filter.LicensePlate = &plate
+		}
+	}
+
+	page, err := r.Page.ToDomainPage()
+	if err != nil {
+		return filter, err
+	}
+	filter.Page = page
+
 	return filter, nil
 }
 
@@ -74,7 +185,7 @@ type BookingResponse struct {
 	CompanyID       int64   `json:"companyId"`
 	AddressID       int64   `json:"addressId"`
 	ServiceID       int64   `json:"serviceId"`
-	CarID           int64   `json:"carId"`
+	CarID           *int64  `json:"carId"`
 	BookingDate     string  `json:"bookingDate"`     // "2025-10-15"
 	StartTime       string  `json:"startTime"`       // "10:00"
 	DurationMinutes int     `json:"durationMinutes"`
@@ -88,6 +199,8 @@ type BookingResponse struct {
 	CarLicensePlate *string  `json:"carLicensePlate,omitempty"`
 	Notes           *string  `json:"notes,omitempty"`
 
+	CarDetailsPending bool `json:"carDetailsPending"` // Данные автомобиля ожидают дозаполнения
+
 	CancellationReason *string `json:"cancellationReason,omitempty"`
 	CancelledAt        *string `json:"cancelledAt,omitempty"` // ISO 8601 format
 
@@ -97,7 +210,23 @@ type BookingResponse struct {
 
 // BookingListResponse ответ со списком бронирований
 type BookingListResponse struct {
-	Bookings []BookingResponse `json:"bookings"`
+	Bookings   []BookingResponse `json:"bookings"`
+	NextCursor *string           `json:"nextCursor"` // nil - страница последняя
+}
+
+// CompanySnapshotResponse снимок бронирований компании на дату (включая отмененные)
+type CompanySnapshotResponse struct {
+	Date       string            `json:"date"`
+	AddressIDs []int64           `json:"addressIds"` // Пусто - все адреса
+	Bookings   []BookingResponse `json:"bookings"`   // По времени начала
+	Truncated  bool              `json:"truncated"`  // Бронирований больше, чем вошло в снимок
+}
+
+// StatusTotal итоги выгрузки по статусу бронирования
+type StatusTotal struct {
+	Status string  `json:"status"`
+	Count  int     `json:"count"`
+	Amount float64 `json:"amount"` // Сумма service_price
 }
 
 // Методы конвертации
@@ -126,6 +255,7 @@ func FromDomainBooking(b *domain.Booking) *BookingResponse {
 		CarLicensePlate: b.CarLicensePlate,
 		Notes:           b.Notes,
 		CancellationReason: b.CancellationReason,
+		CarDetailsPending: b.CarDetailsPending,
 		CreatedAt:       b.CreatedAt,
 		UpdatedAt:       b.UpdatedAt,
 	}
@@ -160,6 +290,16 @@ func FromDomainBookingList(bookings []*domain.Booking) *BookingListResponse {
 	return resp
 }
 
+// FromDomainBookingPage конвертирует страницу бронирований и курсор следующей страницы в DTO
+func FromDomainBookingPage(bookings []*domain.Booking, next *domain.BookingCursor) *BookingListResponse {
+	resp := FromDomainBookingList(bookings)
+	if next != nil {
+		cursor := EncodeCursor(*next)
+		resp.NextCursor = &cursor
+	}
+	return resp
+}
+
 // ToDomainBookingStatus конвертирует строку в domain.BookingStatus с валидацией
 func ToDomainBookingStatus(status string) (domain.BookingStatus, error) {
 	s := domain.BookingStatus(status)
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/types"
+)
+
+var (
+	// ErrInvalidCursor возвращается при некорректном курсоре пагинации
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrInvalidPage возвращается при некорректном размере страницы или сортировке
+	ErrInvalidPage = errors.New("invalid page parameters")
+)
+
+// cursorPayload содержимое курсора пагинации
+type cursorPayload struct {
+	Date string `json:"d"`
+	Time string `json:"t"`
+	ID   int64  `json:"id"`
+}
+
+// EncodeCursor кодирует позицию бронирования в непрозрачный курсор
+func EncodeCursor(c domain.BookingCursor) string {
+	data, _ := json.Marshal(cursorPayload{
+		Date: c.BookingDate.Format(domain.DateFormat),
+		Time: c.StartTime.String(),
+		ID:   c.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor декодирует курсор, полученный в nextCursor
+func DecodeCursor(cursor string) (*domain.BookingCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	date, err := time.Parse(domain.DateFormat, payload.Date)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	startTime, err := types.NewTimeStringFromString(payload.Time)
+	if err != nil || payload.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &domain.BookingCursor{
+		BookingDate: date,
+		StartTime:   startTime,
+		ID:          payload.ID,
+	}, nil
+}
+
+// ToDomainPage конвертирует параметры пагинации в domain модель
+func (p PageRequest) ToDomainPage() (domain.BookingPage, error) {
+	page := domain.BookingPage{Limit: p.Limit}
+
+	if page.Limit == 0 {
+		page.Limit = domain.DefaultPageLimit
+	}
+	if page.Limit < 0 || page.Limit > domain.MaxPageLimit {
+		return page, domain.InvalidField(ErrInvalidPage, "limit", msgInvalidLimit)
+	}
+
+	if p.Sort != nil {
+		switch direction := domain.SortDirection(*p.Sort); direction {
+		case domain.SortAsc, domain.SortDesc:
+			page.Direction = direction
+		default:
+			return page, domain.InvalidField(ErrInvalidPage, "sort", msgInvalidSort)
+		}
+	}
+
+	if p.Cursor != nil {
+		after, err := DecodeCursor(*p.Cursor)
+		if err != nil {
+			return page, domain.InvalidField(err, "cursor", msgInvalidCursor)
+		}
+		page.After = after
+	}
+
+	return page, nil
+}
+
+// validatePeriod проверяет, что конец периода не раньше начала
+func validatePeriod(start, end *time.Time) error {
+	if start != nil && end != nil && end.Before(*start) {
+		return domain.InvalidField(ErrInvalidPeriod, "to", msgInvalidPeriod)
+	}
+	return nil
+}
@@ -9,12 +9,14 @@ import (
 	bookingRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/booking"
 	sellerClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
 	"github.com/m04kA/SMC-BookingService/internal/service/bookings/models"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
 )
 
 // Service сервис для работы с бронированиями
 type Service struct {
 	bookingRepo  BookingRepository
 	sellerClient SellerServiceClient
+	events       BookingEventPublisher
 	logger       Logger
 }
 
@@ -22,77 +24,82 @@ type Service struct {
 func NewService(
 	bookingRepo BookingRepository,
 	sellerClient SellerServiceClient,
+	events BookingEventPublisher,
 	logger Logger,
 ) *Service {
 	return &Service{
 		bookingRepo:  bookingRepo,
 		sellerClient: sellerClient,
+		events:       events,
 		logger:       logger,
 	}
 }
 
 // GetByID получает бронирование по ID
 // Проверяет права доступа - пользователь может видеть только своё бронирование
-// или если он является менеджером компании
+// или если он является менеджером/оператором компании или администратором платформы
 func (s *Service) GetByID(ctx context.Context, id int64, userID int64) (*models.BookingResponse, error) {
-	s.logger.Info("GetByID: fetching booking id=%d for user=%d", id, userID)
+	s.logger.InfoContext(ctx, "GetByID: fetching booking id=%d for user=%d", id, userID)
 
 	booking, err := s.bookingRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, bookingRepo.ErrBookingNotFound) {
-			s.logger.Warn("GetByID: booking id=%d not found", id)
+			s.logger.WarnContext(ctx, "GetByID: booking id=%d not found", id)
 			return nil, ErrBookingNotFound
 		}
-		s.logger.Error("GetByID: repository error for booking id=%d: %v", id, err)
-		return nil, fmt.Errorf("%w: GetByID - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "GetByID: repository error for booking id=%d: %v", id, err)
+		return nil, fmt.Errorf("%w: GetByID - repository error: %w", ErrInternal, err)
 	}
 
 	// Проверяем права доступа
 	if err := s.checkUserAccess(ctx, booking, userID); err != nil {
-		s.logger.Warn("GetByID: access denied for user=%d to booking id=%d", userID, id)
+		s.logger.WarnContext(ctx, "GetByID: access denied for user=%d to booking id=%d", userID, id)
 		return nil, err
 	}
 
-	s.logger.Info("GetByID: successfully fetched booking id=%d", id)
+	s.logger.InfoContext(ctx, "GetByID: successfully fetched booking id=%d", id)
 	return models.FromDomainBooking(booking), nil
 }
 
 // GetUserBookings получает историю бронирований пользователя
-// Опционально фильтрует по статусу
+// Опционально фильтрует по статусу и периоду, возвращает страницу с курсором следующей
 func (s *Service) GetUserBookings(ctx context.Context, req *models.GetUserBookingsRequest) (*models.BookingListResponse, error) {
-	s.logger.Info("GetUserBookings: fetching bookings for user=%d, status=%v", req.UserID, req.Status)
-
-	// Конвертируем статус из строки в domain.BookingStatus
-	var domainStatus *domain.BookingStatus
-	if req.Status != nil {
-		status, err := models.ToDomainBookingStatus(*req.Status)
-		if err != nil {
-			s.logger.Warn("GetUserBookings: invalid status=%s for user=%d", *req.Status, req.UserID)
-			return nil, fmt.Errorf("%w: invalid status", ErrInvalidInput)
-		}
-		domainStatus = &status
+	s.logger.InfoContext(ctx, "GetUserBookings: fetching bookings for user=%d, status=%v", req.UserID, req.Status)
+
+	filter, err := req.ToDomainFilter()
+	if err != nil {
+		s.logger.WarnContext(ctx, "GetUserBookings: invalid filter for user=%d: %v", req.UserID, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
-	bookings, err := s.bookingRepo.GetByUserID(ctx, req.UserID, domainStatus)
+	// Запрашиваем на одну запись больше, чтобы определить наличие следующей страницы
+	limit := filter.Page.Limit
+	filter.Page.Limit++
+
+	bookings, err := s.bookingRepo.GetByUserID(ctx, filter)
 	if err != nil {
-		s.logger.Error("GetUserBookings: repository error for user=%d: %v", req.UserID, err)
-		return nil, fmt.Errorf("%w: GetUserBookings - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "GetUserBookings: repository error for user=%d: %v", req.UserID, err)
+		return nil, fmt.Errorf("%w: GetUserBookings - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("GetUserBookings: successfully fetched %d bookings for user=%d", len(bookings), req.UserID)
-	return models.FromDomainBookingList(bookings), nil
+	bookings, next := domain.TrimPage(bookings, limit)
+
+	s.logger.InfoContext(ctx, "GetUserBookings: successfully fetched %d bookings for user=%d", len(bookings), req.UserID)
+	return models.FromDomainBookingPage(bookings, next), nil
 }
 
 // GetCompanyBookings получает бронирования компании с гибкой фильтрацией
-// Поддерживает фильтрацию по адресу, периоду, статусу и включению неактивных бронирований
-// Доступно только менеджерам компании
+// Поддерживает фильтрацию по адресу, услуге, клиенту, периоду, времени начала, статусам,
+// части госномера и включению неактивных бронирований
+// Доступно менеджерам и операторам компании, администраторам платформы
 //
 // Примеры использования:
 // - Все активные бронирования: GetCompanyBookings(ctx, &GetCompanyBookingsRequest{CompanyID: 123, UserID: 456})
 // - Бронирования на конкретном адресе: указать AddressID
 // - Бронирования на дату: StartDate и EndDate указывают на одну дату
 // - Бронирования за период: StartDate и EndDate указывают на разные даты
-// - Только подтвержденные: указать Status = "confirmed"
+// - Только подтвержденные и ожидающие: указать Statuses = ["confirmed", "pending"]
+// - По госномеру: LicensePlate = "а123" (часть номера, без учета регистра)
 // - Включая отменённые: IncludeInactive = true
 func (s *Service) GetCompanyBookings(ctx context.Context, req *models.GetCompanyBookingsRequest) (*models.BookingListResponse, error) {
 	// Логируем запрос с деталями фильтрации
@@ -103,57 +110,199 @@ func (s *Service) GetCompanyBookings(ctx context.Context, req *models.GetCompany
 	if req.StartDate != nil && req.EndDate != nil {
 		logMsg += fmt.Sprintf(", period=%s to %s", req.StartDate.Format("2006-01-02"), req.EndDate.Format("2006-01-02"))
 	}
-	if req.Status != nil {
-		logMsg += fmt.Sprintf(", status=%s", *req.Status)
+	if req.ServiceID != nil {
+		logMsg += fmt.Sprintf(", service=%d", *req.ServiceID)
+	}
+	if req.CustomerID != nil {
+		logMsg += fmt.Sprintf(", customer=%d", *req.CustomerID)
+	}
+	if req.TimeFrom != nil {
+		logMsg += fmt.Sprintf(", timeFrom=%s", *req.TimeFrom)
+	}
+	if req.TimeTo != nil {
+		logMsg += fmt.Sprintf(", timeTo=%s", *req.TimeTo)
+	}
+	if len(req.Statuses) > 0 {
+		logMsg += fmt.Sprintf(", statuses=%v", req.Statuses)
+	}
+	if req.LicensePlate != nil {
+		logMsg += ", licensePlate=set"
 	}
 	if req.IncludeInactive {
 		logMsg += ", includeInactive=true"
 	}
-	s.logger.Info(logMsg)
+	s.logger.InfoContext(ctx, logMsg)
 
-	// Проверяем права доступа менеджера
-	if err := s.checkManagerAccess(ctx, req.CompanyID, req.UserID); err != nil {
+	// Проверяем права доступа к бронированиям компании
+	if err := s.checkCompanyAccess(ctx, req.CompanyID, req.UserID, policy.ActionViewBookings); err != nil {
 		return nil, err
 	}
 
 	// Конвертируем request в domain фильтр
 	filter, err := req.ToDomainFilter()
 	if err != nil {
-		s.logger.Warn("GetCompanyBookings: invalid filter for company=%d: %v", req.CompanyID, err)
-		return nil, fmt.Errorf("%w: invalid filter", ErrInvalidInput)
+		s.logger.WarnContext(ctx, "GetCompanyBookings: invalid filter for company=%d: %v", req.CompanyID, err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
+	// Запрашиваем на одну запись больше, чтобы определить наличие следующей страницы
+	limit := filter.Page.Limit
+	filter.Page.Limit++
+
 	// Получаем бронирования с фильтрацией
 	bookings, err := s.bookingRepo.GetByCompanyWithFilter(ctx, filter)
 	if err != nil {
-		s.logger.Error("GetCompanyBookings: repository error for company=%d: %v", req.CompanyID, err)
-		return nil, fmt.Errorf("%w: GetCompanyBookings - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "GetCompanyBookings: repository error for company=%d: %v", req.CompanyID, err)
+		return nil, fmt.Errorf("%w: GetCompanyBookings - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("GetCompanyBookings: successfully fetched %d bookings for company=%d", len(bookings), req.CompanyID)
-	return models.FromDomainBookingList(bookings), nil
+	bookings, next := domain.TrimPage(bookings, limit)
+
+	s.logger.InfoContext(ctx, "GetCompanyBookings: successfully fetched %d bookings for company=%d", len(bookings), req.CompanyID)
+	return models.FromDomainBookingPage(bookings, next), nil
+}
+
+// GetCompanySnapshot получает бронирования компании на дату для потока бронирований менеджера
+// Включает отмененные (менеджер видит отмену в течение дня), не больше req.Limit записей
+func (s *Service) GetCompanySnapshot(ctx context.Context, req *models.GetCompanySnapshotRequest) (*models.CompanySnapshotResponse, error) {
+	s.logger.InfoContext(ctx, "GetCompanySnapshot: fetching bookings for company=%d, user=%d, date=%s, addresses=%v",
+		req.CompanyID, req.UserID, req.Date.Format(domain.DateFormat), req.AddressIDs)
+
+	// Проверяем права доступа к бронированиям компании
+	if err := s.checkCompanyAccess(ctx, req.CompanyID, req.UserID, policy.ActionViewBookings); err != nil {
+		return nil, err
+	}
+
+	date := req.Date
+	filter := domain.CompanyBookingsFilter{
+		CompanyID:       req.CompanyID,
+		AddressIDs:      req.AddressIDs,
+		StartDate:       &date,
+		EndDate:         &date,
+		IncludeInactive: true,
+		// Запрашиваем на одну запись больше, чтобы определить, что снимок неполный
+		Page: domain.BookingPage{Limit: req.Limit + 1},
+	}
+
+	bookings, err := s.bookingRepo.GetByCompanyWithFilter(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "GetCompanySnapshot: repository error for company=%d: %v", req.CompanyID, err)
+		return nil, fmt.Errorf("%w: GetCompanySnapshot - repository error: %w", ErrInternal, err)
+	}
+
+	bookings, next := domain.TrimPage(bookings, req.Limit)
+
+	addressIDs := req.AddressIDs
+	if addressIDs == nil {
+		addressIDs = []int64{}
+	}
+
+	s.logger.InfoContext(ctx, "GetCompanySnapshot: fetched %d bookings for company=%d", len(bookings), req.CompanyID)
+	return &models.CompanySnapshotResponse{
+		Date:       date.Format(domain.DateFormat),
+		AddressIDs: addressIDs,
+		Bookings:   models.FromDomainBookingList(bookings).Bookings,
+		Truncated:  next != nil,
+	}, nil
+}
+
+// CheckCompanyBookingsAccess проверяет, что пользователю по-прежнему разрешен просмотр бронирований компании
+// Используется долгоживущими соединениями (поток бронирований), чтобы отключить менеджера, лишенного доступа
+func (s *Service) CheckCompanyBookingsAccess(ctx context.Context, companyID, userID int64) error {
+	return s.checkCompanyAccess(ctx, companyID, userID, policy.ActionViewBookings)
+}
+
+// ExportCompanyBookings выгружает бронирования компании в exporter построчно
+// Фильтры те же, что у GetCompanyBookings, но выгружаются все записи без пагинации
+// (по умолчанию от старых к новым). В конце передаются итоги service_price по статусам
+func (s *Service) ExportCompanyBookings(ctx context.Context, req *models.GetCompanyBookingsRequest, exporter BookingExporter) error {
+	s.logger.InfoContext(ctx, "ExportCompanyBookings: exporting bookings for company=%d, user=%d", req.CompanyID, req.UserID)
+
+	// Проверяем права доступа к бронированиям компании
+	if err := s.checkCompanyAccess(ctx, req.CompanyID, req.UserID, policy.ActionViewBookings); err != nil {
+		return err
+	}
+
+	filter, err := req.ToDomainFilter()
+	if err != nil {
+		s.logger.WarnContext(ctx, "ExportCompanyBookings: invalid filter for company=%d: %v", req.CompanyID, err)
+		return fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	// Выгрузка целиком: без лимита и курсора
+	direction := filter.Page.Direction
+	if direction == "" {
+		direction = domain.SortAsc
+	}
+	filter.Page = domain.BookingPage{Direction: direction}
+
+	if err := exporter.Begin(); err != nil {
+		return fmt.Errorf("%w: %v", ErrExportWrite, err)
+	}
+
+	totals := make(map[domain.BookingStatus]*models.StatusTotal)
+	count := 0
+
+	err = s.bookingRepo.StreamByCompanyWithFilter(ctx, filter, func(booking *domain.Booking) error {
+		total, ok := totals[booking.Status]
+		if !ok {
+			total = &models.StatusTotal{Status: string(booking.Status)}
+			totals[booking.Status] = total
+		}
+		total.Count++
+		total.Amount += booking.ServicePrice
+		count++
+
+		if err := exporter.Row(models.FromDomainBooking(booking)); err != nil {
+			return fmt.Errorf("%w: %v", ErrExportWrite, err)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrExportWrite) {
+			s.logger.WarnContext(ctx, "ExportCompanyBookings: failed to write export for company=%d: %v", req.CompanyID, err)
+			return err
+		}
+		s.logger.ErrorContext(ctx, "ExportCompanyBookings: repository error for company=%d: %v", req.CompanyID, err)
+		return fmt.Errorf("%w: ExportCompanyBookings - repository error: %w", ErrInternal, err)
+	}
+
+	// Итоги в порядке статусов: сначала активные, затем неактивные
+	result := make([]models.StatusTotal, 0, len(totals))
+	for _, status := range append(append([]domain.BookingStatus{}, domain.ActiveStatuses...), domain.InactiveStatuses...) {
+		if total, ok := totals[status]; ok {
+			result = append(result, *total)
+		}
+	}
+
+	if err := exporter.End(result); err != nil {
+		return fmt.Errorf("%w: %v", ErrExportWrite, err)
+	}
+
+	s.logger.InfoContext(ctx, "ExportCompanyBookings: exported %d bookings for company=%d", count, req.CompanyID)
+	return nil
 }
 
 // Cancel отменяет бронирование
 // Пользователь может отменить только своё бронирование (cancelled_by_user)
-// Менеджер может отменить любое бронирование компании (cancelled_by_company)
+// Менеджер или оператор компании может отменить любое бронирование компании (cancelled_by_company)
 func (s *Service) Cancel(ctx context.Context, bookingID int64, req *models.CancelBookingRequest) error {
-	s.logger.Info("Cancel: cancelling booking id=%d by user=%d", bookingID, req.UserID)
+	s.logger.InfoContext(ctx, "Cancel: cancelling booking id=%d by user=%d", bookingID, req.UserID)
 
 	// Получаем бронирование
 	booking, err := s.bookingRepo.GetByID(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, bookingRepo.ErrBookingNotFound) {
-			s.logger.Warn("Cancel: booking id=%d not found", bookingID)
+			s.logger.WarnContext(ctx, "Cancel: booking id=%d not found", bookingID)
 			return ErrBookingNotFound
 		}
-		s.logger.Error("Cancel: repository error for booking id=%d: %v", bookingID, err)
-		return fmt.Errorf("%w: Cancel - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Cancel: repository error for booking id=%d: %v", bookingID, err)
+		return fmt.Errorf("%w: Cancel - repository error: %w", ErrInternal, err)
 	}
 
 	// Проверяем, можно ли отменить бронирование
 	if !booking.CanBeCancelled() {
-		s.logger.Warn("Cancel: booking id=%d cannot be cancelled, status=%s", bookingID, booking.Status)
+		s.logger.WarnContext(ctx, "Cancel: booking id=%d cannot be cancelled, status=%s", bookingID, booking.Status)
 		return ErrCannotCancel
 	}
 
@@ -164,9 +313,9 @@ func (s *Service) Cancel(ctx context.Context, bookingID int64, req *models.Cance
 	if booking.UserID == req.UserID {
 		cancelStatus = domain.StatusCancelledByUser
 	} else {
-		// Проверяем, является ли пользователь менеджером компании
-		if err := s.checkManagerAccess(ctx, booking.CompanyID, req.UserID); err != nil {
-			s.logger.Warn("Cancel: access denied for user=%d to cancel booking id=%d", req.UserID, bookingID)
+		// Проверяем, может ли пользователь менять статусы бронирований компании
+		if err := s.checkCompanyAccess(ctx, booking.CompanyID, req.UserID, policy.ActionChangeBookingStatus); err != nil {
+			s.logger.WarnContext(ctx, "Cancel: access denied for user=%d to cancel booking id=%d", req.UserID, bookingID)
 			return ErrAccessDenied
 		}
 		cancelStatus = domain.StatusCancelledByCompany
@@ -175,100 +324,121 @@ func (s *Service) Cancel(ctx context.Context, bookingID int64, req *models.Cance
 	// Отменяем бронирование
 	if err := s.bookingRepo.Cancel(ctx, bookingID, cancelStatus, req.CancellationReason); err != nil {
 		if errors.Is(err, bookingRepo.ErrBookingNotFound) {
-			s.logger.Warn("Cancel: booking id=%d not found during cancellation", bookingID)
+			s.logger.WarnContext(ctx, "Cancel: booking id=%d not found during cancellation", bookingID)
 			return ErrBookingNotFound
 		}
-		s.logger.Error("Cancel: repository error for booking id=%d: %v", bookingID, err)
-		return fmt.Errorf("%w: Cancel - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Cancel: repository error for booking id=%d: %v", bookingID, err)
+		return fmt.Errorf("%w: Cancel - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("Cancel: successfully cancelled booking id=%d with status=%s", bookingID, cancelStatus)
+	s.publishEvent(ctx, "Cancel", domain.BookingEventCancelled, bookingID)
+
+	s.logger.InfoContext(ctx, "Cancel: successfully cancelled booking id=%d with status=%s", bookingID, cancelStatus)
 	return nil
 }
 
 // UpdateStatus обновляет статус бронирования
-// Доступно только менеджерам компании
+// Доступно менеджерам и операторам компании, администраторам платформы
 func (s *Service) UpdateStatus(ctx context.Context, bookingID int64, req *models.UpdateStatusRequest) error {
-	s.logger.Info("UpdateStatus: updating booking id=%d to status=%s by user=%d",
+	s.logger.InfoContext(ctx, "UpdateStatus: updating booking id=%d to status=%s by user=%d",
 		bookingID, req.Status, req.UserID)
 
 	// Получаем бронирование
 	booking, err := s.bookingRepo.GetByID(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, bookingRepo.ErrBookingNotFound) {
-			s.logger.Warn("UpdateStatus: booking id=%d not found", bookingID)
+			s.logger.WarnContext(ctx, "UpdateStatus: booking id=%d not found", bookingID)
 			return ErrBookingNotFound
 		}
-		s.logger.Error("UpdateStatus: repository error for booking id=%d: %v", bookingID, err)
-		return fmt.Errorf("%w: UpdateStatus - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "UpdateStatus: repository error for booking id=%d: %v", bookingID, err)
+		return fmt.Errorf("%w: UpdateStatus - repository error: %w", ErrInternal, err)
 	}
 
-	// Проверяем права доступа (только менеджер компании)
-	if err := s.checkManagerAccess(ctx, booking.CompanyID, req.UserID); err != nil {
+	// Проверяем права доступа (менеджер или оператор компании)
+	if err := s.checkCompanyAccess(ctx, booking.CompanyID, req.UserID, policy.ActionChangeBookingStatus); err != nil {
 		return err
 	}
 
 	// Валидируем и конвертируем статус
 	newStatus, err := models.ToDomainBookingStatus(req.Status)
 	if err != nil {
-		s.logger.Warn("UpdateStatus: invalid status=%s for booking id=%d", req.Status, bookingID)
-		return fmt.Errorf("%w: invalid status", ErrInvalidInput)
+		s.logger.WarnContext(ctx, "UpdateStatus: invalid status=%s for booking id=%d", req.Status, bookingID)
+		return domain.InvalidField(ErrInvalidStatus, "status", "некорректный статус бронирования")
 	}
 
 	// Обновляем статус
 	if err := s.bookingRepo.UpdateStatus(ctx, bookingID, newStatus); err != nil {
 		if errors.Is(err, bookingRepo.ErrBookingNotFound) {
-			s.logger.Warn("UpdateStatus: booking id=%d not found during update", bookingID)
+			s.logger.WarnContext(ctx, "UpdateStatus: booking id=%d not found during update", bookingID)
 			return ErrBookingNotFound
 		}
-		s.logger.Error("UpdateStatus: repository error for booking id=%d: %v", bookingID, err)
-		return fmt.Errorf("%w: UpdateStatus - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "UpdateStatus: repository error for booking id=%d: %v", bookingID, err)
+		return fmt.Errorf("%w: UpdateStatus - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("UpdateStatus: successfully updated booking id=%d to status=%s", bookingID, newStatus)
+	// Отмена менеджером публикуется как отмена (поток бронирований, метрики отмен), как в admin.ForceStatus
+	eventType := domain.BookingEventStatusChanged
+	if newStatus == domain.StatusCancelledByUser || newStatus == domain.StatusCancelledByCompany {
+		eventType = domain.BookingEventCancelled
+	}
+	s.publishEvent(ctx, "UpdateStatus", eventType, bookingID)
+
+	s.logger.InfoContext(ctx, "UpdateStatus: successfully updated booking id=%d to status=%s", bookingID, newStatus)
 	return nil
 }
 
 // Вспомогательные методы
 
+// publishEvent публикует событие с состоянием бронирования после изменения
+// Изменение уже сохранено, поэтому ошибка чтения только логируется
+func (s *Service) publishEvent(ctx context.Context, op string, eventType domain.BookingEventType, bookingID int64) {
+	booking, err := s.bookingRepo.GetByID(ctx, bookingID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "%s: failed to load booking id=%d for %s event: %v", op, bookingID, eventType, err)
+		return
+	}
+	s.events.PublishBookingEvent(ctx, domain.BookingEvent{Type: eventType, Booking: booking})
+}
+
 // checkUserAccess проверяет, что пользователь имеет доступ к бронированию
-// Пользователь может видеть своё бронирование или если он менеджер компании
+// Пользователь может видеть своё бронирование или бронирования компании, к которой относится
 func (s *Service) checkUserAccess(ctx context.Context, booking *domain.Booking, userID int64) error {
 	// Если пользователь владелец бронирования - доступ разрешён
 	if booking.UserID == userID {
 		return nil
 	}
 
-	// Проверяем, является ли пользователь менеджером компании
-	if err := s.checkManagerAccess(ctx, booking.CompanyID, userID); err != nil {
-		// Ошибка уже залогирована в checkManagerAccess
+	// Проверяем доступ к бронированиям компании
+	if err := s.checkCompanyAccess(ctx, booking.CompanyID, userID, policy.ActionViewBookings); err != nil {
+		// Ошибка уже залогирована в checkCompanyAccess
 		return ErrAccessDenied
 	}
 
 	return nil
 }
 
-// checkManagerAccess проверяет, что пользователь является менеджером компании
-func (s *Service) checkManagerAccess(ctx context.Context, companyID int64, userID int64) error {
+// checkCompanyAccess проверяет, что пользователю разрешено действие над компанией
+// Роль пользователя берется из контекста запроса (см. policy.ActorFromContext)
+func (s *Service) checkCompanyAccess(ctx context.Context, companyID int64, userID int64, action policy.Action) error {
 	// Получаем компанию через SellerService
 	company, err := s.sellerClient.GetCompany(ctx, companyID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			s.logger.Warn("checkManagerAccess: company id=%d not found", companyID)
+			s.logger.WarnContext(ctx, "checkCompanyAccess: company id=%d not found", companyID)
 			return ErrCompanyNotFound
 		}
-		s.logger.Error("checkManagerAccess: failed to get company id=%d: %v", companyID, err)
-		return fmt.Errorf("%w: checkManagerAccess - failed to get company: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "checkCompanyAccess: failed to get company id=%d: %v", companyID, err)
+		return fmt.Errorf("%w: checkCompanyAccess - failed to get company: %v", ErrInternal, err)
 	}
 
-	// Проверяем, что userID в списке менеджеров
-	for _, managerID := range company.ManagerIDs {
-		if managerID == userID {
-			s.logger.Info("checkManagerAccess: user=%d is manager of company=%d", userID, companyID)
-			return nil
-		}
+	actor := policy.ActorFromContext(ctx, userID)
+	if policy.Can(actor, company, action) {
+		s.logger.InfoContext(ctx, "checkCompanyAccess: user=%d (%s) allowed %s in company=%d",
+			userID, policy.CompanyRole(actor, company), action, companyID)
+		return nil
 	}
 
-	s.logger.Warn("checkManagerAccess: user=%d is not a manager of company=%d", userID, companyID)
+	s.logger.WarnContext(ctx, "checkCompanyAccess: user=%d (role=%s) is not allowed %s in company=%d",
+		userID, actor.Role, action, companyID)
 	return ErrAccessDenied
 }
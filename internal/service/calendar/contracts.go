@@ -0,0 +1,40 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+)
+
+// FeedRepository интерфейс репозитория токенов подписки на календарь
+type FeedRepository interface {
+	Create(ctx context.Context, feed *domain.CalendarFeed) (*domain.CalendarFeed, error)
+	GetActiveByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error)
+	RevokeActive(ctx context.Context, target domain.CalendarFeedTarget) error
+	Revoke(ctx context.Context, id int64) error
+}
+
+// BookingRepository интерфейс репозитория бронирований
+type BookingRepository interface {
+	GetByUserID(ctx context.Context, filter domain.UserBookingsFilter) ([]*domain.Booking, error)
+	GetByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter) ([]*domain.Booking, error)
+}
+
+// SellerServiceClient интерфейс клиента для SellerService
+type SellerServiceClient interface {
+	GetCompany(ctx context.Context, companyID int64) (*sellerservice.Company, error)
+}
+
+// TransactionManager интерфейс для управления транзакциями
+// Перевыпуск токена (отзыв старого и создание нового) выполняется в одной транзакции
+type TransactionManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
+}
@@ -0,0 +1,23 @@
+package calendar
+
+import "errors"
+
+var (
+	// ErrFeedNotFound возвращается, когда токен подписки не найден или отозван
+	ErrFeedNotFound = errors.New("calendar feed not found")
+
+	// ErrCompanyNotFound возвращается, когда компания не найдена
+	ErrCompanyNotFound = errors.New("company not found")
+
+	// ErrAddressNotFound возвращается, когда адрес не принадлежит компании
+	ErrAddressNotFound = errors.New("address not found")
+
+	// ErrAccessDenied возвращается, когда у пользователя нет прав доступа
+	ErrAccessDenied = errors.New("access denied")
+
+	// ErrConflict возвращается, когда токен одновременно перевыпускается другим запросом
+	ErrConflict = errors.New("calendar feed is being reissued concurrently")
+
+	// ErrInternal возвращается при внутренних ошибках сервиса
+	ErrInternal = errors.New("service: internal error")
+)
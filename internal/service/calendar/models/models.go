@@ -0,0 +1,107 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+)
+
+// Области подписки на календарь (Feed.Scope, FeedTokenResponse.Scope)
+const (
+	ScopeUser    = string(domain.CalendarFeedScopeUser)
+	ScopeAddress = string(domain.CalendarFeedScopeAddress)
+)
+
+// Response модели
+
+// FeedTokenResponse выпущенный токен подписки на календарь
+// Токен возвращается только при выпуске, в БД хранится его хеш
+type FeedTokenResponse struct {
+	Scope     string    `json:"scope"`
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Feed данные календаря для подписки
+type Feed struct {
+	Scope       string // ScopeUser или ScopeAddress
+	UserID      *int64 // Для подписки пользователя
+	CompanyName string // Для подписки адреса (пусто, если SellerService недоступен)
+	Address     string // Для подписки адреса (пусто, если SellerService недоступен)
+	TimeZone    string // Часовой пояс локального времени бронирований (пусто - не указан)
+	Events      []FeedEvent
+}
+
+// FeedEvent бронирование в календаре
+type FeedEvent struct {
+	BookingID          int64
+	Start              time.Time // Локальное время начала
+	End                time.Time // Локальное время окончания
+	Status             string
+	ServiceName        string
+	CompanyName        string // Пусто, если SellerService недоступен
+	Address            string // Пусто, если SellerService недоступен
+	UserID             int64
+	CarBrand           *string
+	CarModel           *string
+	CarLicensePlate    *string
+	Notes              *string
+	CancellationReason *string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// FromDomainBooking конвертирует бронирование в событие календаря
+// company может быть nil, если данные компании не получены
+func FromDomainBooking(b *domain.Booking, company *sellerservice.Company) (FeedEvent, error) {
+	start, err := b.StartTime.Parse(b.BookingDate)
+	if err != nil {
+		return FeedEvent{}, fmt.Errorf("booking id=%d: %w", b.ID, err)
+	}
+
+	event := FeedEvent{
+		BookingID:          b.ID,
+		Start:              start,
+		End:                start.Add(time.Duration(b.DurationMinutes) * time.Minute),
+		Status:             string(b.Status),
+		ServiceName:        b.ServiceName,
+		UserID:             b.UserID,
+		CarBrand:           b.CarBrand,
+		CarModel:           b.CarModel,
+		CarLicensePlate:    b.CarLicensePlate,
+		Notes:              b.Notes,
+		CancellationReason: b.CancellationReason,
+		CreatedAt:          b.CreatedAt,
+		UpdatedAt:          b.UpdatedAt,
+	}
+
+	if company != nil {
+		event.CompanyName = company.Name
+		event.Address = FormatAddress(company, b.AddressID)
+	}
+
+	return event, nil
+}
+
+// FormatAddress возвращает адрес компании строкой "Город, Улица Дом"
+// Пустая строка, если адрес не найден в компании
+func FormatAddress(company *sellerservice.Company, addressID int64) string {
+	for _, addr := range company.Addresses {
+		if addr.ID != addressID {
+			continue
+		}
+		parts := make([]string, 0, 2)
+		if addr.City != "" {
+			parts = append(parts, addr.City)
+		}
+		if street := strings.TrimSpace(addr.Street + " " + addr.Building); street != "" {
+			parts = append(parts, street)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return ""
+}
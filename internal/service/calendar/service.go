@@ -0,0 +1,351 @@
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	calendarRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/calendar"
+	sellerClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+	"github.com/m04kA/SMC-BookingService/internal/service/calendar/models"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
+)
+
+// tokenBytes длина случайной части токена подписки (256 бит)
+const tokenBytes = 32
+
+// Config настройки календаря
+type Config struct {
+	FeedURLPrefix string // Префикс ссылки на календарь, к нему добавляется "<token>.ics"
+	PastDays      int    // Сколько дней до сегодняшнего включать в календарь
+	FutureDays    int    // Сколько дней после сегодняшнего включать в календарь
+	MaxEvents     int    // Максимальное количество событий (при превышении остаются ближайшие к концу периода)
+	TimeZone      string // Часовой пояс, в котором заданы даты и время бронирований (пусто - не указывается)
+}
+
+// Service сервис подписок на календарь бронирований
+type Service struct {
+	feedRepo     FeedRepository
+	bookingRepo  BookingRepository
+	sellerClient SellerServiceClient
+	txManager    TransactionManager
+	config       Config
+	logger       Logger
+}
+
+// NewService создает новый экземпляр сервиса календаря
+func NewService(
+	feedRepo FeedRepository,
+	bookingRepo BookingRepository,
+	sellerClient SellerServiceClient,
+	txManager TransactionManager,
+	config Config,
+	logger Logger,
+) *Service {
+	return &Service{
+		feedRepo:     feedRepo,
+		bookingRepo:  bookingRepo,
+		sellerClient: sellerClient,
+		txManager:    txManager,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// IssueUserFeed выпускает токен подписки на бронирования пользователя
+// Действующий токен пользователя отзывается. Права (сам пользователь или администратор)
+// проверяются в handler, actorID - пользователь, выпускающий токен
+func (s *Service) IssueUserFeed(ctx context.Context, userID int64, actorID int64) (*models.FeedTokenResponse, error) {
+	s.logger.InfoContext(ctx, "IssueUserFeed: issuing calendar feed for user=%d by user=%d", userID, actorID)
+
+	return s.issue(ctx, domain.UserCalendarFeed(userID), actorID)
+}
+
+// IssueAddressFeed выпускает токен подписки на расписание адреса компании
+// Действующий токен адреса отзывается. Доступно менеджерам и операторам компании, администраторам платформы
+func (s *Service) IssueAddressFeed(ctx context.Context, companyID, addressID int64, userID int64) (*models.FeedTokenResponse, error) {
+	s.logger.InfoContext(ctx, "IssueAddressFeed: issuing calendar feed for company=%d, address=%d by user=%d",
+		companyID, addressID, userID)
+
+	if err := s.checkAddressAccess(ctx, companyID, addressID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.issue(ctx, domain.AddressCalendarFeed(companyID, addressID), userID)
+}
+
+// RevokeUserFeed отзывает токен подписки на бронирования пользователя
+func (s *Service) RevokeUserFeed(ctx context.Context, userID int64) error {
+	s.logger.InfoContext(ctx, "RevokeUserFeed: revoking calendar feed for user=%d", userID)
+
+	return s.revoke(ctx, domain.UserCalendarFeed(userID))
+}
+
+// RevokeAddressFeed отзывает токен подписки на расписание адреса компании
+func (s *Service) RevokeAddressFeed(ctx context.Context, companyID, addressID int64, userID int64) error {
+	s.logger.InfoContext(ctx, "RevokeAddressFeed: revoking calendar feed for company=%d, address=%d by user=%d",
+		companyID, addressID, userID)
+
+	if err := s.checkAddressAccess(ctx, companyID, addressID, userID); err != nil {
+		return err
+	}
+
+	return s.revoke(ctx, domain.AddressCalendarFeed(companyID, addressID))
+}
+
+// GetFeed возвращает бронирования для календаря по токену подписки
+// Включаются бронирования за период [сегодня - PastDays, сегодня + FutureDays], включая отмененные
+// Токен адреса действует, пока у выпустившего его пользователя есть доступ к бронированиям компании:
+// иначе токен отзывается и возвращается ErrFeedNotFound
+func (s *Service) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	feed, err := s.feedRepo.GetActiveByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, calendarRepo.ErrFeedNotFound) {
+			s.logger.WarnContext(ctx, "GetFeed: calendar feed not found")
+			return nil, ErrFeedNotFound
+		}
+		s.logger.ErrorContext(ctx, "GetFeed: repository error: %v", err)
+		return nil, fmt.Errorf("%w: GetFeed - repository error: %w", ErrInternal, err)
+	}
+
+	// Компании нужны для названия и адреса в событиях
+	companies := make(map[int64]*sellerClient.Company)
+
+	if feed.Target.Scope == domain.CalendarFeedScopeAddress {
+		company, err := s.checkFeedCreatorAccess(ctx, feed)
+		if err != nil {
+			return nil, err
+		}
+		companies[*feed.Target.CompanyID] = company
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	startDate := today.AddDate(0, 0, -s.config.PastDays)
+	endDate := today.AddDate(0, 0, s.config.FutureDays)
+
+	// Ближайшие к концу периода бронирования важнее: при превышении MaxEvents отбрасываются старые
+	page := domain.BookingPage{Limit: s.config.MaxEvents, Direction: domain.SortDesc}
+
+	var bookings []*domain.Booking
+	result := &models.Feed{Scope: string(feed.Target.Scope), TimeZone: s.config.TimeZone}
+
+	switch feed.Target.Scope {
+	case domain.CalendarFeedScopeUser:
+		result.UserID = feed.Target.UserID
+		bookings, err = s.bookingRepo.GetByUserID(ctx, domain.UserBookingsFilter{
+			UserID:    *feed.Target.UserID,
+			StartDate: &startDate,
+			EndDate:   &endDate,
+			Page:      page,
+		})
+
+	case domain.CalendarFeedScopeAddress:
+		bookings, err = s.bookingRepo.GetByCompanyWithFilter(ctx, domain.CompanyBookingsFilter{
+			CompanyID:       *feed.Target.CompanyID,
+			AddressID:       feed.Target.AddressID,
+			StartDate:       &startDate,
+			EndDate:         &endDate,
+			IncludeInactive: true,
+			Page:            page,
+		})
+
+	default:
+		s.logger.ErrorContext(ctx, "GetFeed: unknown feed scope=%s, feed id=%d", feed.Target.Scope, feed.ID)
+		return nil, fmt.Errorf("%w: GetFeed - unknown feed scope %q", ErrInternal, feed.Target.Scope)
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "GetFeed: repository error for feed id=%d: %v", feed.ID, err)
+		return nil, fmt.Errorf("%w: GetFeed - repository error: %w", ErrInternal, err)
+	}
+
+	// Без SellerService календарь отдается без названий и адресов компаний
+	for _, booking := range bookings {
+		if _, ok := companies[booking.CompanyID]; ok {
+			continue
+		}
+		company, err := s.sellerClient.GetCompany(ctx, booking.CompanyID)
+		if err != nil {
+			s.logger.WarnContext(ctx, "GetFeed: failed to get company id=%d, events without address: %v", booking.CompanyID, err)
+		}
+		companies[booking.CompanyID] = company
+	}
+
+	if feed.Target.Scope == domain.CalendarFeedScopeAddress {
+		company := companies[*feed.Target.CompanyID]
+		result.CompanyName = company.Name
+		result.Address = models.FormatAddress(company, *feed.Target.AddressID)
+	}
+
+	result.Events = make([]models.FeedEvent, 0, len(bookings))
+	for _, booking := range bookings {
+		event, err := models.FromDomainBooking(booking, companies[booking.CompanyID])
+		if err != nil {
+			s.logger.WarnContext(ctx, "GetFeed: skipping booking in feed id=%d: %v", feed.ID, err)
+			continue
+		}
+		result.Events = append(result.Events, event)
+	}
+
+	s.logger.InfoContext(ctx, "GetFeed: loaded %d events for feed id=%d (scope=%s)", len(result.Events), feed.ID, feed.Target.Scope)
+	return result, nil
+}
+
+// Вспомогательные методы
+
+// issue отзывает действующий токен владельца и выпускает новый
+func (s *Service) issue(ctx context.Context, target domain.CalendarFeedTarget, actorID int64) (*models.FeedTokenResponse, error) {
+	token, err := newToken()
+	if err != nil {
+		s.logger.ErrorContext(ctx, "issue: failed to generate token: %v", err)
+		return nil, fmt.Errorf("%w: issue - generate token: %v", ErrInternal, err)
+	}
+
+	var created *domain.CalendarFeed
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.feedRepo.RevokeActive(ctx, target); err != nil && !errors.Is(err, calendarRepo.ErrFeedNotFound) {
+			return err
+		}
+
+		created, err = s.feedRepo.Create(ctx, &domain.CalendarFeed{
+			TokenHash:     hashToken(token),
+			Target:        target,
+			CreatedBy:     actorID,
+			CreatedByRole: string(policy.ActorFromContext(ctx, actorID).Role),
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, calendarRepo.ErrDuplicateFeed) {
+			s.logger.WarnContext(ctx, "issue: concurrent reissue of calendar feed scope=%s", target.Scope)
+			return nil, ErrConflict
+		}
+		s.logger.ErrorContext(ctx, "issue: repository error for calendar feed scope=%s: %v", target.Scope, err)
+		return nil, fmt.Errorf("%w: issue - repository error: %w", ErrInternal, err)
+	}
+
+	s.logger.InfoContext(ctx, "issue: calendar feed id=%d issued (scope=%s)", created.ID, target.Scope)
+	return &models.FeedTokenResponse{
+		Scope:     string(target.Scope),
+		Token:     token,
+		URL:       s.config.FeedURLPrefix + token + ".ics",
+		CreatedAt: created.CreatedAt,
+	}, nil
+}
+
+// revoke отзывает действующий токен владельца
+func (s *Service) revoke(ctx context.Context, target domain.CalendarFeedTarget) error {
+	if err := s.feedRepo.RevokeActive(ctx, target); err != nil {
+		if errors.Is(err, calendarRepo.ErrFeedNotFound) {
+			s.logger.WarnContext(ctx, "revoke: no active calendar feed (scope=%s)", target.Scope)
+			return ErrFeedNotFound
+		}
+		s.logger.ErrorContext(ctx, "revoke: repository error for calendar feed scope=%s: %v", target.Scope, err)
+		return fmt.Errorf("%w: revoke - repository error: %w", ErrInternal, err)
+	}
+
+	s.logger.InfoContext(ctx, "revoke: calendar feed revoked (scope=%s)", target.Scope)
+	return nil
+}
+
+// checkAddressAccess проверяет, что адрес принадлежит компании и пользователю разрешен просмотр ее бронирований
+func (s *Service) checkAddressAccess(ctx context.Context, companyID, addressID int64, userID int64) error {
+	company, err := s.sellerClient.GetCompany(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
+			s.logger.WarnContext(ctx, "checkAddressAccess: company id=%d not found", companyID)
+			return ErrCompanyNotFound
+		}
+		s.logger.ErrorContext(ctx, "checkAddressAccess: failed to get company id=%d: %v", companyID, err)
+		return fmt.Errorf("%w: checkAddressAccess - failed to get company: %v", ErrInternal, err)
+	}
+
+	actor := policy.ActorFromContext(ctx, userID)
+	if !policy.Can(actor, company, policy.ActionViewBookings) {
+		s.logger.WarnContext(ctx, "checkAddressAccess: user=%d (role=%s) is not allowed %s in company=%d",
+			userID, actor.Role, policy.ActionViewBookings, companyID)
+		return ErrAccessDenied
+	}
+
+	if !hasAddress(company, addressID) {
+		s.logger.WarnContext(ctx, "checkAddressAccess: address id=%d not found in company=%d", addressID, companyID)
+		return ErrAddressNotFound
+	}
+
+	return nil
+}
+
+// checkFeedCreatorAccess проверяет, что у выпустившего токен адреса пользователя остался доступ
+// к бронированиям компании (роль - на момент выпуска, членство - по текущим данным SellerService)
+// Токен пользователя, лишенного доступа, или удаленного адреса отзывается. Возвращает компанию
+func (s *Service) checkFeedCreatorAccess(ctx context.Context, feed *domain.CalendarFeed) (*sellerClient.Company, error) {
+	companyID := *feed.Target.CompanyID
+
+	company, err := s.sellerClient.GetCompany(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
+			s.logger.WarnContext(ctx, "GetFeed: company id=%d of feed id=%d not found", companyID, feed.ID)
+			return nil, s.revokeStaleFeed(ctx, feed)
+		}
+		// Без SellerService доступ не проверить: календарь не отдается
+		s.logger.ErrorContext(ctx, "GetFeed: failed to get company id=%d: %v", companyID, err)
+		return nil, fmt.Errorf("%w: GetFeed - failed to get company: %v", ErrInternal, err)
+	}
+
+	actor := policy.Actor{UserID: feed.CreatedBy, Role: policy.ParseRole(feed.CreatedByRole)}
+	if !policy.Can(actor, company, policy.ActionViewBookings) {
+		s.logger.WarnContext(ctx, "GetFeed: creator user=%d (role=%s) of feed id=%d lost access to company=%d",
+			feed.CreatedBy, actor.Role, feed.ID, companyID)
+		return nil, s.revokeStaleFeed(ctx, feed)
+	}
+
+	if !hasAddress(company, *feed.Target.AddressID) {
+		s.logger.WarnContext(ctx, "GetFeed: address id=%d of feed id=%d not found in company=%d",
+			*feed.Target.AddressID, feed.ID, companyID)
+		return nil, s.revokeStaleFeed(ctx, feed)
+	}
+
+	return company, nil
+}
+
+// revokeStaleFeed отзывает токен, который больше не должен действовать, и возвращает ErrFeedNotFound
+// Ошибка отзыва только логируется: токен будет отклонен и при следующем запросе
+func (s *Service) revokeStaleFeed(ctx context.Context, feed *domain.CalendarFeed) error {
+	if err := s.feedRepo.Revoke(ctx, feed.ID); err != nil && !errors.Is(err, calendarRepo.ErrFeedNotFound) {
+		s.logger.ErrorContext(ctx, "GetFeed: failed to revoke feed id=%d: %v", feed.ID, err)
+	} else {
+		s.logger.InfoContext(ctx, "GetFeed: feed id=%d revoked", feed.ID)
+	}
+	return ErrFeedNotFound
+}
+
+// hasAddress проверяет, что адрес существует в компании
+func hasAddress(company *sellerClient.Company, addressID int64) bool {
+	for _, addr := range company.Addresses {
+		if addr.ID == addressID {
+			return true
+		}
+	}
+	return false
+}
+
+// newToken генерирует случайный токен подписки (base64url без выравнивания)
+func newToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken возвращает SHA-256 токена в hex (в БД хранится только хеш)
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
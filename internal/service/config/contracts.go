@@ -19,6 +19,24 @@ type ConfigRepository interface {
 	DeleteByCompanyAddressAndService(ctx context.Context, companyID int64, addressID *int64, serviceID *int64) error
 }
 
+// BookingRepository интерфейс репозитория бронирований
+// Используется для оценки влияния изменений конфигурации на существующие бронирования
+type BookingRepository interface {
+	GetByCompanyWithFilter(ctx context.Context, filter domain.CompanyBookingsFilter) ([]*domain.Booking, error)
+}
+
+// TransactionManager интерфейс для управления транзакциями
+// Используется для атомарного применения массового импорта и проверки конфликтов вместе с изменением
+type TransactionManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	DoSerializable(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// ConfigEventPublisher публикация изменений конфигурации (поток слотов пересчитывает доступность)
+type ConfigEventPublisher interface {
+	PublishConfigEvent(ctx context.Context, event domain.ConfigChangedEvent)
+}
+
 // SellerServiceClient интерфейс клиента для SellerService
 type SellerServiceClient interface {
 	GetCompany(ctx context.Context, companyID int64) (*sellerservice.Company, error)
@@ -27,7 +45,7 @@ type SellerServiceClient interface {
 
 // Logger интерфейс для логирования
 type Logger interface {
-	Info(format string, v ...interface{})
-	Warn(format string, v ...interface{})
-	Error(format string, v ...interface{})
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
 }
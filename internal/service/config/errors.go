@@ -24,6 +24,9 @@ var (
 	// ErrConfigAlreadyExists возвращается при попытке создать дублирующую конфигурацию
 	ErrConfigAlreadyExists = errors.New("config already exists")
 
+	// ErrConfigConflicts возвращается, когда изменение конфигурации конфликтует с существующими бронированиями
+	ErrConfigConflicts = errors.New("config change conflicts with existing bookings")
+
 	// ErrInternal возвращается при внутренних ошибках сервиса
 	ErrInternal = errors.New("service: internal error")
 )
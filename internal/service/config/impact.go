@@ -0,0 +1,278 @@
+package config
+
+import (
+	"sort"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	sellerClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+	"github.com/m04kA/SMC-BookingService/pkg/types"
+)
+
+// calculateImpact оценивает влияние предлагаемой конфигурации на существующие бронирования
+//
+// Набор конфигураций компании сравнивается с тем же набором, в котором текущая версия
+// изменяемой конфигурации заменена на proposed (см. calculateSetImpact)
+func calculateImpact(
+	current *domain.CompanySlotsConfig,
+	proposed *domain.CompanySlotsConfig,
+	allConfigs []*domain.CompanySlotsConfig,
+	bookings []*domain.Booking,
+	company *sellerClient.Company,
+) *models.ConfigImpactResponse {
+	// Подменяем изменяемую конфигурацию на предлагаемую
+	configs := make([]*domain.CompanySlotsConfig, 0, len(allConfigs))
+	for _, c := range allConfigs {
+		if c.ID == current.ID {
+			continue
+		}
+		configs = append(configs, c)
+	}
+	configs = append(configs, proposed)
+
+	result := calculateSetImpact(allConfigs, configs, bookings, company)
+	return &models.ConfigImpactResponse{
+		ConfigID:            current.ID,
+		Current:             *models.FromDomainConfig(current),
+		Proposed:            *models.FromDomainConfig(proposed),
+		CheckedBookings:     result.CheckedBookings,
+		HasConflicts:        len(result.ConflictingBookings) > 0,
+		ConflictingBookings: result.ConflictingBookings,
+		ConflictWindows:     result.ConflictWindows,
+	}
+}
+
+// setImpact конфликты бронирований при замене набора конфигураций компании
+type setImpact struct {
+	CheckedBookings     int
+	ConflictingBookings []models.ConflictingBooking
+	ConflictWindows     []models.ConflictWindow
+}
+
+// calculateSetImpact оценивает влияние замены набора конфигураций компании before на after
+// Используется как для изменения одного уровня, так и для импорта, который может изменить,
+// создать и удалить несколько уровней сразу
+//
+// Алгоритм:
+//  1. Для каждого активного бронирования определяем действующую конфигурацию по иерархии
+//     в наборах before и after
+//  2. Проверяем только бронирования, у которых меняется вместимость или длительность слота
+//     (в том числе при переходе на родительский уровень после удаления конфигурации)
+//  3. При изменении maxConcurrentBookings считаем пиковое число одновременно идущих активных бронирований
+//     на том же адресе и в ту же дату в интервале бронирования и сравниваем с новой вместимостью
+//  4. При изменении slotDurationMinutes проверяем, что время начала попадает в новую сетку слотов
+func calculateSetImpact(
+	before []*domain.CompanySlotsConfig,
+	after []*domain.CompanySlotsConfig,
+	bookings []*domain.Booking,
+	company *sellerClient.Company,
+) setImpact {
+	impact := setImpact{
+		ConflictingBookings: []models.ConflictingBooking{},
+		ConflictWindows:     []models.ConflictWindow{},
+	}
+
+	// Группируем активные бронирования по (адрес, дата) для подсчёта занятости
+	type dayKey struct {
+		addressID int64
+		date      string
+	}
+	byDay := make(map[dayKey][]*domain.Booking)
+	for _, b := range bookings {
+		if !b.IsActive() {
+			continue
+		}
+		key := dayKey{addressID: b.AddressID, date: b.BookingDate.Format(domain.DateFormat)}
+		byDay[key] = append(byDay[key], b)
+	}
+
+	type windowKey struct {
+		day        dayKey
+		start, end types.TimeString
+	}
+	seenWindows := make(map[windowKey]bool)
+
+	for _, b := range bookings {
+		if !b.IsActive() {
+			continue
+		}
+
+		effective := resolveConfig(after, b.AddressID, b.ServiceID)
+		if effective == nil {
+			continue
+		}
+		previous := resolveConfig(before, b.AddressID, b.ServiceID)
+		capacityChanged := previous == nil || previous.MaxConcurrentBookings != effective.MaxConcurrentBookings
+		durationChanged := previous == nil || previous.SlotDurationMinutes != effective.SlotDurationMinutes
+		if !capacityChanged && !durationChanged {
+			continue
+		}
+		impact.CheckedBookings++
+
+		if capacityChanged {
+			key := dayKey{addressID: b.AddressID, date: b.BookingDate.Format(domain.DateFormat)}
+			overlapping := peakOverlapping(b, byDay[key])
+			if overlapping > effective.MaxConcurrentBookings {
+				impact.ConflictingBookings = append(impact.ConflictingBookings,
+					toConflictingBooking(b, models.ConflictReasonOverCapacity))
+
+				end, err := b.StartTime.AddMinutes(b.DurationMinutes)
+				if err == nil {
+					wk := windowKey{day: key, start: b.StartTime, end: end}
+					if !seenWindows[wk] {
+						seenWindows[wk] = true
+						impact.ConflictWindows = append(impact.ConflictWindows, models.ConflictWindow{
+							AddressID:      b.AddressID,
+							Date:           key.date,
+							StartTime:      b.StartTime.String(),
+							EndTime:        end.String(),
+							ActiveBookings: overlapping,
+							Capacity:       effective.MaxConcurrentBookings,
+						})
+					}
+				}
+				continue
+			}
+		}
+
+		if durationChanged && !isAlignedToSlotGrid(company, b.BookingDate, b.StartTime, effective.SlotDurationMinutes) {
+			impact.ConflictingBookings = append(impact.ConflictingBookings,
+				toConflictingBooking(b, models.ConflictReasonSlotMisaligned))
+		}
+	}
+
+	sort.Slice(impact.ConflictWindows, func(i, j int) bool {
+		wi, wj := impact.ConflictWindows[i], impact.ConflictWindows[j]
+		if wi.Date != wj.Date {
+			return wi.Date < wj.Date
+		}
+		if wi.AddressID != wj.AddressID {
+			return wi.AddressID < wj.AddressID
+		}
+		return wi.StartTime < wj.StartTime
+	})
+
+	return impact
+}
+
+// resolveConfig выбирает действующую конфигурацию по иерархии в памяти
+// Приоритет совпадает с GetConfigWithHierarchy: service@address > address > service > global
+func resolveConfig(configs []*domain.CompanySlotsConfig, addressID, serviceID int64) *domain.CompanySlotsConfig {
+	var address, service, global *domain.CompanySlotsConfig
+
+	for _, c := range configs {
+		switch {
+		case c.IsServiceAtAddress():
+			if *c.AddressID == addressID && *c.ServiceID == serviceID {
+				return c
+			}
+		case c.IsAddressSpecific():
+			if *c.AddressID == addressID {
+				address = c
+			}
+		case c.IsServiceSpecific():
+			if *c.ServiceID == serviceID {
+				service = c
+			}
+		case c.IsGlobalConfig():
+			global = c
+		}
+	}
+
+	if address != nil {
+		return address
+	}
+	if service != nil {
+		return service
+	}
+	return global
+}
+
+// peakOverlapping считает максимальное число активных бронирований, одновременно идущих
+// в интервале бронирования target (включая само бронирование). Граничные случаи пересечением не считаются.
+//
+// Бронирования, пересекающиеся с target, но не друг с другом (10:00-10:30 и 10:30-11:00 при target 10:00-11:00),
+// одновременно не занимают места, поэтому считается пик занятости, а не число пересечений
+func peakOverlapping(target *domain.Booking, dayBookings []*domain.Booking) int {
+	targetEnd, err := target.StartTime.AddMinutes(target.DurationMinutes)
+	if err != nil {
+		return 0
+	}
+
+	type interval struct {
+		start, end types.TimeString
+	}
+	overlapping := make([]interval, 0, len(dayBookings))
+	for _, b := range dayBookings {
+		end, err := b.StartTime.AddMinutes(b.DurationMinutes)
+		if err != nil {
+			continue
+		}
+		if b.StartTime.IsBefore(targetEnd) && end.IsAfter(target.StartTime) {
+			overlapping = append(overlapping, interval{start: b.StartTime, end: end})
+		}
+	}
+
+	// Занятость растет только в моменты начала бронирований: достаточно проверить начало target
+	// и начала пересекающихся бронирований внутри его интервала
+	peak := 0
+	for _, point := range overlapping {
+		at := point.start
+		if at.IsBefore(target.StartTime) {
+			at = target.StartTime
+		}
+
+		count := 0
+		for _, other := range overlapping {
+			if !other.start.IsAfter(at) && other.end.IsAfter(at) {
+				count++
+			}
+		}
+		if count > peak {
+			peak = count
+		}
+	}
+	return peak
+}
+
+// isAlignedToSlotGrid проверяет, что время начала попадает в сетку слотов новой длительности
+// Сетка строится от времени открытия компании в день бронирования (как в get_available_slots)
+// Если расписание неизвестно, бронирование считается выровненным
+func isAlignedToSlotGrid(company *sellerClient.Company, date time.Time, startTime types.TimeString, slotDuration int) bool {
+	if company == nil || slotDuration <= 0 {
+		return true
+	}
+
+	schedule := company.WorkingHours.ForDay(date.Weekday())
+	if !schedule.IsOpen || schedule.OpenTime == nil {
+		return true
+	}
+
+	openTime, err := types.NewTimeStringFromString(*schedule.OpenTime)
+	if err != nil {
+		return true
+	}
+
+	minutes, err := openTime.MinutesBetween(startTime)
+	if err != nil || minutes < 0 {
+		return true
+	}
+
+	return minutes%slotDuration == 0
+}
+
+// toConflictingBooking конвертирует бронирование в модель конфликта
+func toConflictingBooking(b *domain.Booking, reason string) models.ConflictingBooking {
+	return models.ConflictingBooking{
+		BookingID:       b.ID,
+		UserID:          b.UserID,
+		AddressID:       b.AddressID,
+		ServiceID:       b.ServiceID,
+		BookingDate:     b.BookingDate.Format(domain.DateFormat),
+		StartTime:       b.StartTime.String(),
+		DurationMinutes: b.DurationMinutes,
+		Status:          string(b.Status),
+		Reason:          reason,
+	}
+}
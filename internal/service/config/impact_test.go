@@ -0,0 +1,112 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+	"github.com/m04kA/SMC-BookingService/pkg/types"
+)
+
+func booking(start string, duration int) *domain.Booking {
+	return &domain.Booking{StartTime: types.TimeString(start), DurationMinutes: duration}
+}
+
+func TestPeakOverlapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		target *domain.Booking
+		others []*domain.Booking
+		want   int
+	}{
+		{
+			name:   "only target",
+			target: booking("10:00", 60),
+			want:   1,
+		},
+		{
+			name:   "sequential bookings do not add up",
+			target: booking("10:00", 60),
+			others: []*domain.Booking{booking("10:00", 30), booking("10:30", 30)},
+			want:   2,
+		},
+		{
+			name:   "bookings running at the same time",
+			target: booking("10:00", 60),
+			others: []*domain.Booking{booking("09:30", 60), booking("10:15", 30), booking("10:20", 10)},
+			want:   4,
+		},
+		{
+			name:   "peak outside target interval is ignored",
+			target: booking("10:00", 30),
+			others: []*domain.Booking{booking("09:00", 30), booking("09:00", 30), booking("10:15", 60)},
+			want:   2,
+		},
+		{
+			name:   "touching bookings do not overlap",
+			target: booking("10:00", 30),
+			others: []*domain.Booking{booking("09:30", 30), booking("10:30", 30)},
+			want:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day := append([]*domain.Booking{tt.target}, tt.others...)
+			assert.Equal(t, tt.want, peakOverlapping(tt.target, day))
+		})
+	}
+}
+
+func TestCalculateSetImpact(t *testing.T) {
+	addressID := int64(100)
+	date := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	newBooking := func(id int64, start string) *domain.Booking {
+		b := booking(start, 60)
+		b.ID, b.AddressID, b.ServiceID, b.BookingDate, b.Status = id, addressID, 1, date, domain.StatusConfirmed
+		return b
+	}
+	bookings := []*domain.Booking{newBooking(1, "10:00"), newBooking(2, "10:00")}
+
+	global := &domain.CompanySlotsConfig{ID: 1, SlotDurationMinutes: 60, MaxConcurrentBookings: 1}
+	address := &domain.CompanySlotsConfig{ID: 2, AddressID: &addressID, SlotDurationMinutes: 60, MaxConcurrentBookings: 2}
+
+	tests := []struct {
+		name          string
+		before, after []*domain.CompanySlotsConfig
+		wantConflicts int
+	}{
+		{
+			name:   "unchanged set",
+			before: []*domain.CompanySlotsConfig{global, address},
+			after:  []*domain.CompanySlotsConfig{global, address},
+		},
+		{
+			name:          "deleted level falls back to smaller parent",
+			before:        []*domain.CompanySlotsConfig{global, address},
+			after:         []*domain.CompanySlotsConfig{global},
+			wantConflicts: 2,
+		},
+		{
+			name:          "created level lowers capacity",
+			before:        []*domain.CompanySlotsConfig{address},
+			after:         []*domain.CompanySlotsConfig{address, {AddressID: &addressID, ServiceID: ptr.Ptr(int64(1)), SlotDurationMinutes: 60, MaxConcurrentBookings: 1}},
+			wantConflicts: 2,
+		},
+		{
+			name:   "raised capacity",
+			before: []*domain.CompanySlotsConfig{global},
+			after:  []*domain.CompanySlotsConfig{address},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			impact := calculateSetImpact(tt.before, tt.after, bookings, nil)
+			assert.Len(t, impact.ConflictingBookings, tt.wantConflicts)
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	sellerClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
+)
+
+// Сообщения об ошибках строк импорта (переводятся каталогом apierror)
+const (
+	msgImportInvalidConfig       = "некорректные данные конфигурации"
+	msgImportDuplicateLevel      = "уровень конфигурации уже указан в файле"
+	msgImportAddressNotFound     = "адрес не принадлежит компании"
+	msgImportServiceNotFound     = "услуга не найдена в компании"
+	msgImportServiceNotAtAddress = "услуга недоступна на этом адресе"
+)
+
+// configKey ключ уровня конфигурации (адрес, услуга) внутри компании
+type configKey struct {
+	addressID  int64
+	serviceID  int64
+	hasAddress bool
+	hasService bool
+}
+
+// newConfigKey формирует ключ уровня конфигурации, nil означает "для всех"
+func newConfigKey(addressID, serviceID *int64) configKey {
+	var key configKey
+	if addressID != nil {
+		key.addressID, key.hasAddress = *addressID, true
+	}
+	if serviceID != nil {
+		key.serviceID, key.hasService = *serviceID, true
+	}
+	return key
+}
+
+// Import выполняет массовый импорт конфигураций компании
+// Доступно менеджерам компании и администраторам платформы
+// Сначала валидируется весь файл целиком (параметры, адреса и услуги в SellerService),
+// при наличии ошибок ничего не применяется и возвращается список ошибок по строкам.
+// Изменения применяются атомарно в одной транзакции: существующие уровни обновляются,
+// новые создаются, а при req.Replace отсутствующие в файле конфигурации удаляются.
+// Если итоговый набор конфликтует с будущими активными бронированиями, возвращает ErrConfigConflicts
+// вместе с ответом, содержащим конфликты (проверку можно пропустить через req.Force)
+func (s *Service) Import(ctx context.Context, req *models.ImportConfigsRequest) (*models.ImportConfigsResponse, error) {
+	s.logger.InfoContext(ctx, "Import: importing %d configs for company=%d by user=%d, replace=%t, force=%t",
+		len(req.Items), req.CompanyID, req.UserID, req.Replace, req.Force)
+
+	if len(req.Items) == 0 {
+		s.logger.WarnContext(ctx, "Import: empty import for company=%d", req.CompanyID)
+		return nil, fmt.Errorf("%w: import contains no configs", ErrInvalidInput)
+	}
+
+	// 1. Получаем компанию для проверки прав доступа и адресов
+	company, err := s.sellerClient.GetCompany(ctx, req.CompanyID)
+	if err != nil {
+		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
+			s.logger.WarnContext(ctx, "Import: company id=%d not found", req.CompanyID)
+			return nil, ErrCompanyNotFound
+		}
+		s.logger.ErrorContext(ctx, "Import: failed to get company id=%d: %v", req.CompanyID, err)
+		return nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
+	}
+
+	// 2. Проверяем права доступа (менеджер компании или администратор платформы)
+	if !s.can(ctx, company, req.UserID, policy.ActionManageConfig) {
+		s.logger.WarnContext(ctx, "Import: user=%d is not allowed to manage configs of company=%d", req.UserID, req.CompanyID)
+		return nil, ErrAccessDenied
+	}
+
+	// 3. Валидируем весь файл целиком
+	lineErrors, err := s.validateImportItems(ctx, company, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	if len(lineErrors) > 0 {
+		s.logger.WarnContext(ctx, "Import: validation failed for company=%d: %d errors", req.CompanyID, len(lineErrors))
+		return &models.ImportConfigsResponse{
+			Applied: false,
+			Configs: []models.ConfigResponse{},
+			Errors:  lineErrors,
+		}, nil
+	}
+
+	// 4. Проверяем конфликты с бронированиями и применяем изменения атомарно
+	// Проверка и запись выполняются в одной serializable транзакции (как в Update).
+	// Ответ собирается заново в каждой попытке транзакции (при конфликте сериализации она повторяется)
+	var result *models.ImportConfigsResponse
+	err = s.txManager.DoSerializable(ctx, func(ctx context.Context) error {
+		attempt := &models.ImportConfigsResponse{
+			Configs: make([]models.ConfigResponse, 0, len(req.Items)),
+			Errors:  []models.ImportLineError{},
+		}
+		result = attempt
+
+		existing, err := s.configRepo.GetAllByCompany(ctx, req.CompanyID)
+		if err != nil {
+			return fmt.Errorf("%w: Import - repository error: %w", ErrInternal, err)
+		}
+
+		existingByKey := make(map[configKey]*domain.CompanySlotsConfig, len(existing))
+		for _, c := range existing {
+			existingByKey[newConfigKey(c.AddressID, c.ServiceID)] = c
+		}
+
+		// Собираем итоговый набор конфигураций компании
+		planned := make([]*domain.CompanySlotsConfig, 0, len(req.Items))
+		imported := make(map[configKey]bool, len(req.Items))
+		for _, item := range req.Items {
+			key := newConfigKey(item.AddressID, item.ServiceID)
+			imported[key] = true
+
+			config := &domain.CompanySlotsConfig{
+				CompanyID:               req.CompanyID,
+				AddressID:               item.AddressID,
+				ServiceID:               item.ServiceID,
+				SlotDurationMinutes:     item.SlotDurationMinutes,
+				MaxConcurrentBookings:   item.MaxConcurrentBookings,
+				AdvanceBookingDays:      item.AdvanceBookingDays,
+				MinBookingNoticeMinutes: item.MinBookingNoticeMinutes,
+			}
+			if current, ok := existingByKey[key]; ok {
+				config.ID = current.ID
+			}
+			planned = append(planned, config)
+		}
+
+		var removed []*domain.CompanySlotsConfig
+		after := append([]*domain.CompanySlotsConfig{}, planned...)
+		for key, c := range existingByKey {
+			if imported[key] {
+				continue
+			}
+			if req.Replace {
+				removed = append(removed, c)
+			} else {
+				after = append(after, c)
+			}
+		}
+
+		// Изменённые, созданные и удалённые уровни не должны оставлять бронирования сверх вместимости
+		// или вне сетки слотов (проверку можно пропустить через req.Force)
+		if !req.Force {
+			bookings, err := s.loadFutureBookings(ctx, "Import", req.CompanyID, nil)
+			if err != nil {
+				return err
+			}
+			impact := calculateSetImpact(existing, after, bookings, company)
+			if len(impact.ConflictingBookings) > 0 {
+				attempt.ConflictingBookings = impact.ConflictingBookings
+				attempt.ConflictWindows = impact.ConflictWindows
+				return ErrConfigConflicts
+			}
+		}
+
+		for i, config := range planned {
+			var saved *domain.CompanySlotsConfig
+			if config.ID != 0 {
+				saved, err = s.configRepo.Update(ctx, config.ID, config)
+				if err != nil {
+					return fmt.Errorf("%w: Import - failed to update config id=%d: %w", ErrInternal, config.ID, err)
+				}
+				attempt.Updated++
+			} else {
+				saved, err = s.configRepo.Create(ctx, config)
+				if err != nil {
+					return fmt.Errorf("%w: Import - failed to create config (line %d): %w", ErrInternal, req.Items[i].Line, err)
+				}
+				attempt.Created++
+			}
+			attempt.Configs = append(attempt.Configs, *models.FromDomainConfig(saved))
+		}
+
+		for _, c := range removed {
+			if err := s.configRepo.Delete(ctx, c.ID); err != nil {
+				return fmt.Errorf("%w: Import - failed to delete config id=%d: %w", ErrInternal, c.ID, err)
+			}
+			attempt.Deleted++
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrConfigConflicts) {
+			s.logger.WarnContext(ctx, "Import: import for company=%d conflicts with %d bookings",
+				req.CompanyID, len(result.ConflictingBookings))
+			result.Configs = []models.ConfigResponse{}
+			return result, ErrConfigConflicts
+		}
+		s.logger.ErrorContext(ctx, "Import: failed to apply import for company=%d: %v", req.CompanyID, err)
+		if errors.Is(err, ErrInternal) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: Import - transaction error: %w", ErrInternal, err)
+	}
+
+	result.Applied = true
+	s.logger.InfoContext(ctx, "Import: company=%d imported successfully: created=%d, updated=%d, deleted=%d",
+		req.CompanyID, result.Created, result.Updated, result.Deleted)
+	s.publishConfigChanged(ctx, req.CompanyID)
+	return result, nil
+}
+
+// validateImportItems проверяет все строки импорта и возвращает ошибки по строкам
+// Ошибка возвращается только при недоступности SellerService
+func (s *Service) validateImportItems(
+	ctx context.Context,
+	company *sellerClient.Company,
+	items []models.ImportConfigItem,
+) ([]models.ImportLineError, error) {
+	lineErrors := []models.ImportLineError{}
+	addError := func(item models.ImportConfigItem, lineErr models.ImportLineError) {
+		lineErr.Line = item.Line
+		lineErr.AddressID = item.AddressID
+		lineErr.ServiceID = item.ServiceID
+		lineErrors = append(lineErrors, lineErr)
+	}
+
+	// Услуги запрашиваем один раз на serviceID (nil - услуга не найдена)
+	services := make(map[int64]*sellerClient.Service)
+	seen := make(map[configKey]int)
+
+	for _, item := range items {
+		key := newConfigKey(item.AddressID, item.ServiceID)
+		if line, ok := seen[key]; ok {
+			addError(item, models.ImportLineError{Message: msgImportDuplicateLevel, DuplicateOfLine: line})
+			continue
+		}
+		seen[key] = item.Line
+
+		if err := s.validateConfigData(item.SlotDurationMinutes, item.MaxConcurrentBookings,
+			item.AdvanceBookingDays, item.MinBookingNoticeMinutes); err != nil {
+			lineErr := models.ImportLineError{Message: msgImportInvalidConfig}
+			var fieldErr *domain.FieldError
+			if errors.As(err, &fieldErr) {
+				lineErr.Field, lineErr.Message = fieldErr.Field, fieldErr.Message
+			}
+			addError(item, lineErr)
+			continue
+		}
+
+		if item.AddressID != nil && !s.addressExists(company, *item.AddressID) {
+			addError(item, models.ImportLineError{Field: "addressId", Message: msgImportAddressNotFound})
+			continue
+		}
+
+		if item.ServiceID == nil {
+			continue
+		}
+
+		service, ok := services[*item.ServiceID]
+		if !ok {
+			fetched, err := s.sellerClient.GetService(ctx, company.ID, *item.ServiceID)
+			if err != nil && !errors.Is(err, sellerClient.ErrServiceNotFound) {
+				s.logger.ErrorContext(ctx, "Import: failed to get service id=%d: %v", *item.ServiceID, err)
+				return nil, fmt.Errorf("%w: failed to get service: %v", ErrInternal, err)
+			}
+			service = fetched
+			services[*item.ServiceID] = service
+		}
+
+		if service == nil {
+			addError(item, models.ImportLineError{Field: "serviceId", Message: msgImportServiceNotFound})
+			continue
+		}
+
+		if item.AddressID != nil && !s.serviceAtAddress(service, *item.AddressID) {
+			addError(item, models.ImportLineError{Field: "serviceId", Message: msgImportServiceNotAtAddress})
+		}
+	}
+
+	return lineErrors, nil
+}
@@ -28,6 +28,7 @@ type UpdateConfigRequest struct {
 	MaxConcurrentBookings   *int  `json:"maxConcurrentBookings,omitempty"`
 	AdvanceBookingDays      *int  `json:"advanceBookingDays,omitempty"`
 	MinBookingNoticeMinutes *int  `json:"minBookingNoticeMinutes,omitempty"`
+	Force                   bool  `json:"force,omitempty"` // Применить изменения несмотря на конфликты с бронированиями
 }
 
 // GetConfigRequest запрос на получение конфигурации (для иерархического поиска)
@@ -46,6 +47,26 @@ type DeleteConfigRequest struct {
 	ServiceID *int64 `json:"serviceId,omitempty"`
 }
 
+// ImportConfigItem одна строка импорта - конфигурация уровня (адрес, услуга)
+type ImportConfigItem struct {
+	Line                    int    `json:"-"`                   // Номер строки в исходном файле (для сообщений об ошибках)
+	AddressID               *int64 `json:"addressId,omitempty"` // NULL = для всех адресов
+	ServiceID               *int64 `json:"serviceId,omitempty"` // NULL = для всех услуг
+	SlotDurationMinutes     int    `json:"slotDurationMinutes"`
+	MaxConcurrentBookings   int    `json:"maxConcurrentBookings"`
+	AdvanceBookingDays      int    `json:"advanceBookingDays"`
+	MinBookingNoticeMinutes int    `json:"minBookingNoticeMinutes"`
+}
+
+// ImportConfigsRequest запрос на массовый импорт конфигураций компании
+type ImportConfigsRequest struct {
+	UserID    int64              `json:"userId"`
+	CompanyID int64              `json:"companyId"`
+	Replace   bool               `json:"replace,omitempty"` // Удалить конфигурации, отсутствующие в файле
+	Force     bool               `json:"force,omitempty"`   // Применить, несмотря на конфликты с бронированиями
+	Items     []ImportConfigItem `json:"configs"`
+}
+
 // Response модели
 
 // ConfigResponse ответ с данными конфигурации слотов
@@ -67,6 +88,72 @@ type ConfigListResponse struct {
 	Configs []ConfigResponse `json:"configs"`
 }
 
+// ImportConfigsResponse результат массового импорта конфигураций
+// Если Errors или ConflictingBookings не пусты, изменения не применялись
+type ImportConfigsResponse struct {
+	Applied bool              `json:"applied"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Deleted int               `json:"deleted"`
+	Configs []ConfigResponse  `json:"configs"`
+	Errors  []ImportLineError `json:"errors"`
+
+	// Бронирования и окна, которые конфликтуют с импортируемым набором (заполняются при отказе без force)
+	ConflictingBookings []ConflictingBooking `json:"conflictingBookings,omitempty"`
+	ConflictWindows     []ConflictWindow     `json:"conflictWindows,omitempty"`
+}
+
+// ImportLineError ошибка валидации строки импорта
+// Message - сообщение на исходном языке (i18n.Source), обработчик переводит его на язык ответа
+type ImportLineError struct {
+	Line            int    `json:"line"`
+	AddressID       *int64 `json:"addressId,omitempty"`
+	ServiceID       *int64 `json:"serviceId,omitempty"`
+	Field           string `json:"field,omitempty"`           // Поле строки с ошибкой (slotDurationMinutes, addressId)
+	DuplicateOfLine int    `json:"duplicateOfLine,omitempty"` // Строка, в которой уровень указан впервые
+	Message         string `json:"message"`
+}
+
+// Причины конфликта бронирования с новой конфигурацией
+const (
+	ConflictReasonOverCapacity   = "over_capacity"   // Бронирований в окне больше, чем maxConcurrentBookings
+	ConflictReasonSlotMisaligned = "slot_misaligned" // Время начала не попадает в сетку новых слотов
+)
+
+// ConfigImpactResponse результат оценки влияния изменения конфигурации (dry-run)
+type ConfigImpactResponse struct {
+	ConfigID            int64                `json:"configId"`
+	Current             ConfigResponse       `json:"current"`
+	Proposed            ConfigResponse       `json:"proposed"`
+	CheckedBookings     int                  `json:"checkedBookings"` // Количество проверенных будущих активных бронирований
+	HasConflicts        bool                 `json:"hasConflicts"`
+	ConflictingBookings []ConflictingBooking `json:"conflictingBookings"`
+	ConflictWindows     []ConflictWindow     `json:"conflictWindows"`
+}
+
+// ConflictingBooking бронирование, которое нарушает новую конфигурацию
+type ConflictingBooking struct {
+	BookingID       int64  `json:"bookingId"`
+	UserID          int64  `json:"userId"`
+	AddressID       int64  `json:"addressId"`
+	ServiceID       int64  `json:"serviceId"`
+	BookingDate     string `json:"bookingDate"` // "2025-10-15"
+	StartTime       string `json:"startTime"`   // "10:00"
+	DurationMinutes int    `json:"durationMinutes"`
+	Status          string `json:"status"`
+	Reason          string `json:"reason"`
+}
+
+// ConflictWindow временное окно, в котором занятость превышает новую вместимость
+type ConflictWindow struct {
+	AddressID      int64  `json:"addressId"`
+	Date           string `json:"date"`      // "2025-10-15"
+	StartTime      string `json:"startTime"` // "10:00"
+	EndTime        string `json:"endTime"`   // "10:30"
+	ActiveBookings int    `json:"activeBookings"`
+	Capacity       int    `json:"capacity"`
+}
+
 // Методы конвертации
 
 // FromDomainConfig конвертирует domain модель в DTO
@@ -4,44 +4,55 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/m04kA/SMC-BookingService/internal/domain"
 	configRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/config"
 	sellerClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
 	"github.com/m04kA/SMC-BookingService/internal/service/config/models"
+	"github.com/m04kA/SMC-BookingService/internal/service/policy"
 )
 
 // Service сервис для работы с конфигурацией слотов
 type Service struct {
 	configRepo   ConfigRepository
+	bookingRepo  BookingRepository
 	sellerClient SellerServiceClient
+	txManager    TransactionManager
+	events       ConfigEventPublisher
 	logger       Logger
 }
 
 // NewService создает новый экземпляр сервиса конфигурации
 func NewService(
 	configRepo ConfigRepository,
+	bookingRepo BookingRepository,
 	sellerClient SellerServiceClient,
+	txManager TransactionManager,
+	events ConfigEventPublisher,
 	logger Logger,
 ) *Service {
 	return &Service{
 		configRepo:   configRepo,
+		bookingRepo:  bookingRepo,
 		sellerClient: sellerClient,
+		txManager:    txManager,
+		events:       events,
 		logger:       logger,
 	}
 }
 
 // Create создает новую конфигурацию слотов
-// Доступно только менеджерам компании
+// Доступно менеджерам компании и администраторам платформы
 // Проверяет существование компании, адреса (если указан) и услуги (если указана)
 func (s *Service) Create(ctx context.Context, req *models.CreateConfigRequest) (*models.ConfigResponse, error) {
-	s.logger.Info("Create: creating config for company=%d, address=%v, service=%v by user=%d",
+	s.logger.InfoContext(ctx, "Create: creating config for company=%d, address=%v, service=%v by user=%d",
 		req.CompanyID, req.AddressID, req.ServiceID, req.UserID)
 
 	// 1. Валидируем входные данные
 	if err := s.validateConfigData(req.SlotDurationMinutes, req.MaxConcurrentBookings,
 		req.AdvanceBookingDays, req.MinBookingNoticeMinutes); err != nil {
-		s.logger.Warn("Create: validation failed: %v", err)
+		s.logger.WarnContext(ctx, "Create: validation failed: %v", err)
 		return nil, err
 	}
 
@@ -49,23 +60,23 @@ func (s *Service) Create(ctx context.Context, req *models.CreateConfigRequest) (
 	company, err := s.sellerClient.GetCompany(ctx, req.CompanyID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			s.logger.Warn("Create: company id=%d not found", req.CompanyID)
+			s.logger.WarnContext(ctx, "Create: company id=%d not found", req.CompanyID)
 			return nil, ErrCompanyNotFound
 		}
-		s.logger.Error("Create: failed to get company id=%d: %v", req.CompanyID, err)
+		s.logger.ErrorContext(ctx, "Create: failed to get company id=%d: %v", req.CompanyID, err)
 		return nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
 	}
 
-	// 3. Проверяем права доступа (только менеджер компании)
-	if !s.isManager(company, req.UserID) {
-		s.logger.Warn("Create: user=%d is not a manager of company=%d", req.UserID, req.CompanyID)
+	// 3. Проверяем права доступа (менеджер компании или администратор платформы)
+	if !s.can(ctx, company, req.UserID, policy.ActionManageConfig) {
+		s.logger.WarnContext(ctx, "Create: user=%d is not allowed to manage configs of company=%d", req.UserID, req.CompanyID)
 		return nil, ErrAccessDenied
 	}
 
 	// 4. Если указан addressID, проверяем его существование
 	if req.AddressID != nil {
 		if !s.addressExists(company, *req.AddressID) {
-			s.logger.Warn("Create: address id=%d not found in company=%d", *req.AddressID, req.CompanyID)
+			s.logger.WarnContext(ctx, "Create: address id=%d not found in company=%d", *req.AddressID, req.CompanyID)
 			return nil, ErrAddressNotFound
 		}
 	}
@@ -75,17 +86,17 @@ func (s *Service) Create(ctx context.Context, req *models.CreateConfigRequest) (
 		service, err := s.sellerClient.GetService(ctx, req.CompanyID, *req.ServiceID)
 		if err != nil {
 			if errors.Is(err, sellerClient.ErrServiceNotFound) {
-				s.logger.Warn("Create: service id=%d not found in company=%d", *req.ServiceID, req.CompanyID)
+				s.logger.WarnContext(ctx, "Create: service id=%d not found in company=%d", *req.ServiceID, req.CompanyID)
 				return nil, ErrServiceNotFound
 			}
-			s.logger.Error("Create: failed to get service id=%d: %v", *req.ServiceID, err)
+			s.logger.ErrorContext(ctx, "Create: failed to get service id=%d: %v", *req.ServiceID, err)
 			return nil, fmt.Errorf("%w: failed to get service: %v", ErrInternal, err)
 		}
 
 		// Если указан и адрес, и услуга - проверяем, что услуга доступна на этом адресе
 		if req.AddressID != nil {
 			if !s.serviceAtAddress(service, *req.AddressID) {
-				s.logger.Warn("Create: service id=%d is not available at address id=%d",
+				s.logger.WarnContext(ctx, "Create: service id=%d is not available at address id=%d",
 					*req.ServiceID, *req.AddressID)
 				return nil, fmt.Errorf("%w: service is not available at this address", ErrInvalidInput)
 			}
@@ -95,11 +106,11 @@ func (s *Service) Create(ctx context.Context, req *models.CreateConfigRequest) (
 	// 6. Проверяем, не существует ли уже конфигурация с такими параметрами
 	existingConfig, err := s.configRepo.GetByCompanyAddressAndService(ctx, req.CompanyID, req.AddressID, req.ServiceID)
 	if err != nil && !errors.Is(err, configRepo.ErrConfigNotFound) {
-		s.logger.Error("Create: failed to check existing config: %v", err)
-		return nil, fmt.Errorf("%w: failed to check existing config: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Create: failed to check existing config: %v", err)
+		return nil, fmt.Errorf("%w: failed to check existing config: %w", ErrInternal, err)
 	}
 	if existingConfig != nil {
-		s.logger.Warn("Create: config already exists for company=%d, address=%v, service=%v",
+		s.logger.WarnContext(ctx, "Create: config already exists for company=%d, address=%v, service=%v",
 			req.CompanyID, req.AddressID, req.ServiceID)
 		return nil, ErrConfigAlreadyExists
 	}
@@ -108,30 +119,31 @@ func (s *Service) Create(ctx context.Context, req *models.CreateConfigRequest) (
 	domainConfig := req.ToDomainConfig()
 	createdConfig, err := s.configRepo.Create(ctx, domainConfig)
 	if err != nil {
-		s.logger.Error("Create: repository error: %v", err)
-		return nil, fmt.Errorf("%w: Create - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Create: repository error: %v", err)
+		return nil, fmt.Errorf("%w: Create - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("Create: successfully created config id=%d", createdConfig.ID)
+	s.logger.InfoContext(ctx, "Create: successfully created config id=%d", createdConfig.ID)
+	s.publishConfigChanged(ctx, req.CompanyID)
 	return models.FromDomainConfig(createdConfig), nil
 }
 
 // GetByID получает конфигурацию по ID
 // Публичный метод - доступен всем
 func (s *Service) GetByID(ctx context.Context, id int64) (*models.ConfigResponse, error) {
-	s.logger.Info("GetByID: fetching config id=%d", id)
+	s.logger.InfoContext(ctx, "GetByID: fetching config id=%d", id)
 
 	config, err := s.configRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("GetByID: config id=%d not found", id)
+			s.logger.WarnContext(ctx, "GetByID: config id=%d not found", id)
 			return nil, ErrConfigNotFound
 		}
-		s.logger.Error("GetByID: repository error for config id=%d: %v", id, err)
-		return nil, fmt.Errorf("%w: GetByID - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "GetByID: repository error for config id=%d: %v", id, err)
+		return nil, fmt.Errorf("%w: GetByID - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("GetByID: successfully fetched config id=%d", id)
+	s.logger.InfoContext(ctx, "GetByID: successfully fetched config id=%d", id)
 	return models.FromDomainConfig(config), nil
 }
 
@@ -139,238 +151,365 @@ func (s *Service) GetByID(ctx context.Context, id int64) (*models.ConfigResponse
 // Публичный метод - используется для получения актуальной конфигурации при бронировании
 // Приоритет: service@address > address > service > global
 func (s *Service) GetWithHierarchy(ctx context.Context, req *models.GetConfigRequest) (*models.ConfigResponse, error) {
-	s.logger.Info("GetWithHierarchy: fetching config for company=%d, address=%d, service=%d",
+	s.logger.InfoContext(ctx, "GetWithHierarchy: fetching config for company=%d, address=%d, service=%d",
 		req.CompanyID, req.AddressID, req.ServiceID)
 
 	config, err := s.configRepo.GetConfigWithHierarchy(ctx, req.CompanyID, req.AddressID, req.ServiceID)
 	if err != nil {
 		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("GetWithHierarchy: no config found for company=%d, address=%d, service=%d",
+			s.logger.WarnContext(ctx, "GetWithHierarchy: no config found for company=%d, address=%d, service=%d",
 				req.CompanyID, req.AddressID, req.ServiceID)
 			return nil, ErrConfigNotFound
 		}
-		s.logger.Error("GetWithHierarchy: repository error: %v", err)
-		return nil, fmt.Errorf("%w: GetWithHierarchy - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "GetWithHierarchy: repository error: %v", err)
+		return nil, fmt.Errorf("%w: GetWithHierarchy - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("GetWithHierarchy: successfully fetched config id=%d (level: %s)",
+	s.logger.InfoContext(ctx, "GetWithHierarchy: successfully fetched config id=%d (level: %s)",
 		config.ID, s.getConfigLevel(config))
 	return models.FromDomainConfig(config), nil
 }
 
 // GetAllByCompany получает все конфигурации компании
-// Доступно только менеджерам компании
+// Доступно менеджерам и операторам компании, администраторам платформы
 func (s *Service) GetAllByCompany(ctx context.Context, companyID int64, userID int64) (*models.ConfigListResponse, error) {
-	s.logger.Info("GetAllByCompany: fetching configs for company=%d by user=%d", companyID, userID)
+	s.logger.InfoContext(ctx, "GetAllByCompany: fetching configs for company=%d by user=%d", companyID, userID)
 
 	// Получаем компанию для проверки прав доступа
 	company, err := s.sellerClient.GetCompany(ctx, companyID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			s.logger.Warn("GetAllByCompany: company id=%d not found", companyID)
+			s.logger.WarnContext(ctx, "GetAllByCompany: company id=%d not found", companyID)
 			return nil, ErrCompanyNotFound
 		}
-		s.logger.Error("GetAllByCompany: failed to get company id=%d: %v", companyID, err)
+		s.logger.ErrorContext(ctx, "GetAllByCompany: failed to get company id=%d: %v", companyID, err)
 		return nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
 	}
 
-	// Проверяем права доступа (только менеджер компании)
-	if !s.isManager(company, userID) {
-		s.logger.Warn("GetAllByCompany: user=%d is not a manager of company=%d", userID, companyID)
+	// Проверяем права доступа (менеджер или оператор компании)
+	if !s.can(ctx, company, userID, policy.ActionViewConfig) {
+		s.logger.WarnContext(ctx, "GetAllByCompany: user=%d is not allowed to view configs of company=%d", userID, companyID)
 		return nil, ErrAccessDenied
 	}
 
 	configs, err := s.configRepo.GetAllByCompany(ctx, companyID)
 	if err != nil {
-		s.logger.Error("GetAllByCompany: repository error for company=%d: %v", companyID, err)
-		return nil, fmt.Errorf("%w: GetAllByCompany - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "GetAllByCompany: repository error for company=%d: %v", companyID, err)
+		return nil, fmt.Errorf("%w: GetAllByCompany - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("GetAllByCompany: successfully fetched %d configs for company=%d", len(configs), companyID)
+	s.logger.InfoContext(ctx, "GetAllByCompany: successfully fetched %d configs for company=%d", len(configs), companyID)
 	return models.FromDomainConfigList(configs), nil
 }
 
 // Update обновляет существующую конфигурацию
-// Доступно только менеджерам компании
+// Доступно менеджерам компании и администраторам платформы
 // Поддерживает частичное обновление - обновляются только указанные поля
+// Если изменение конфликтует с будущими активными бронированиями, возвращает ErrConfigConflicts
+// (проверку можно пропустить через req.Force)
 func (s *Service) Update(ctx context.Context, id int64, req *models.UpdateConfigRequest) (*models.ConfigResponse, error) {
-	s.logger.Info("Update: updating config id=%d by user=%d", id, req.UserID)
+	s.logger.InfoContext(ctx, "Update: updating config id=%d by user=%d, force=%t", id, req.UserID, req.Force)
 
-	// 1. Получаем существующую конфигурацию
-	config, err := s.configRepo.GetByID(ctx, id)
+	// 1-5. Получаем конфигурацию, валидируем изменения и проверяем права доступа
+	_, _, company, err := s.prepareUpdate(ctx, "Update", id, req)
 	if err != nil {
-		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("Update: config id=%d not found", id)
-			return nil, ErrConfigNotFound
-		}
-		s.logger.Error("Update: repository error for config id=%d: %v", id, err)
-		return nil, fmt.Errorf("%w: Update - repository error: %v", ErrInternal, err)
+		return nil, err
 	}
 
-	// 2. Применяем обновления к конфигурации (создаём копию для валидации)
-	tempConfig := *config
-	req.ApplyToConfig(&tempConfig)
+	// 6-7. Проверяем влияние изменений на бронирования и обновляем конфигурацию в одной
+	// serializable транзакции: бронирование, созданное между проверкой и обновлением,
+	// приводит к конфликту сериализации, и транзакция повторяется с новой проверкой
+	var updatedConfig *domain.CompanySlotsConfig
+	err = s.txManager.DoSerializable(ctx, func(ctx context.Context) error {
+		config, err := s.configRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, configRepo.ErrConfigNotFound) {
+				return ErrConfigNotFound
+			}
+			return fmt.Errorf("%w: Update - repository error: %w", ErrInternal, err)
+		}
+		proposed := *config
+		req.ApplyToConfig(&proposed)
 
-	// 3. Валидируем обновленные данные
-	if err := s.validateConfigData(tempConfig.SlotDurationMinutes, tempConfig.MaxConcurrentBookings,
-		tempConfig.AdvanceBookingDays, tempConfig.MinBookingNoticeMinutes); err != nil {
-		s.logger.Warn("Update: validation failed for config id=%d: %v", id, err)
-		return nil, err
-	}
+		if !req.Force {
+			impact, err := s.evaluateImpact(ctx, "Update", config, &proposed, company)
+			if err != nil {
+				return err
+			}
+			if impact.HasConflicts {
+				s.logger.WarnContext(ctx, "Update: config id=%d change conflicts with %d bookings",
+					id, len(impact.ConflictingBookings))
+				return ErrConfigConflicts
+			}
+		}
 
-	// 4. Получаем компанию для проверки прав доступа
-	company, err := s.sellerClient.GetCompany(ctx, config.CompanyID)
+		updatedConfig, err = s.configRepo.Update(ctx, id, &proposed)
+		if err != nil {
+			if errors.Is(err, configRepo.ErrConfigNotFound) {
+				return ErrConfigNotFound
+			}
+			return fmt.Errorf("%w: Update - repository error: %w", ErrInternal, err)
+		}
+		return nil
+	})
 	if err != nil {
-		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			s.logger.Warn("Update: company id=%d not found", config.CompanyID)
-			return nil, ErrCompanyNotFound
+		switch {
+		case errors.Is(err, ErrConfigNotFound):
+			s.logger.WarnContext(ctx, "Update: config id=%d not found during update", id)
+			return nil, ErrConfigNotFound
+		case errors.Is(err, ErrConfigConflicts):
+			return nil, ErrConfigConflicts
+		case errors.Is(err, ErrInternal):
+			s.logger.ErrorContext(ctx, "Update: failed to update config id=%d: %v", id, err)
+			return nil, err
 		}
-		s.logger.Error("Update: failed to get company id=%d: %v", config.CompanyID, err)
-		return nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Update: transaction error for config id=%d: %v", id, err)
+		return nil, fmt.Errorf("%w: Update - transaction error: %w", ErrInternal, err)
 	}
 
-	// 5. Проверяем права доступа (только менеджер компании)
-	if !s.isManager(company, req.UserID) {
-		s.logger.Warn("Update: user=%d is not a manager of company=%d", req.UserID, config.CompanyID)
-		return nil, ErrAccessDenied
-	}
+	s.logger.InfoContext(ctx, "Update: successfully updated config id=%d", id)
+	s.publishConfigChanged(ctx, updatedConfig.CompanyID)
+	return models.FromDomainConfig(updatedConfig), nil
+}
 
-	// 6. Применяем обновления к оригинальной конфигурации
-	req.ApplyToConfig(config)
+// PreviewUpdate выполняет обновление в режиме dry-run
+// Ничего не сохраняет, возвращает список бронирований и временных окон,
+// которые конфликтуют с предлагаемыми значениями
+// Доступно менеджерам компании и администраторам платформы
+func (s *Service) PreviewUpdate(ctx context.Context, id int64, req *models.UpdateConfigRequest) (*models.ConfigImpactResponse, error) {
+	s.logger.InfoContext(ctx, "PreviewUpdate: previewing config id=%d change by user=%d", id, req.UserID)
 
-	// 7. Обновляем конфигурацию в БД
-	updatedConfig, err := s.configRepo.Update(ctx, id, config)
+	config, proposed, company, err := s.prepareUpdate(ctx, "PreviewUpdate", id, req)
 	if err != nil {
-		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("Update: config id=%d not found during update", id)
-			return nil, ErrConfigNotFound
-		}
-		s.logger.Error("Update: repository error for config id=%d: %v", id, err)
-		return nil, fmt.Errorf("%w: Update - repository error: %v", ErrInternal, err)
+		return nil, err
 	}
 
-	s.logger.Info("Update: successfully updated config id=%d", id)
-	return models.FromDomainConfig(updatedConfig), nil
+	impact, err := s.evaluateImpact(ctx, "PreviewUpdate", config, proposed, company)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "PreviewUpdate: config id=%d, checked=%d bookings, conflicts=%d",
+		id, impact.CheckedBookings, len(impact.ConflictingBookings))
+	return impact, nil
 }
 
 // Delete удаляет конфигурацию по ID
-// Доступно только менеджерам компании
+// Доступно менеджерам компании и администраторам платформы
 func (s *Service) Delete(ctx context.Context, id int64, userID int64) error {
-	s.logger.Info("Delete: deleting config id=%d by user=%d", id, userID)
+	s.logger.InfoContext(ctx, "Delete: deleting config id=%d by user=%d", id, userID)
 
 	// 1. Получаем конфигурацию для проверки прав доступа
 	config, err := s.configRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("Delete: config id=%d not found", id)
+			s.logger.WarnContext(ctx, "Delete: config id=%d not found", id)
 			return ErrConfigNotFound
 		}
-		s.logger.Error("Delete: repository error for config id=%d: %v", id, err)
-		return fmt.Errorf("%w: Delete - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Delete: repository error for config id=%d: %v", id, err)
+		return fmt.Errorf("%w: Delete - repository error: %w", ErrInternal, err)
 	}
 
 	// 2. Получаем компанию для проверки прав доступа
 	company, err := s.sellerClient.GetCompany(ctx, config.CompanyID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			s.logger.Warn("Delete: company id=%d not found", config.CompanyID)
+			s.logger.WarnContext(ctx, "Delete: company id=%d not found", config.CompanyID)
 			return ErrCompanyNotFound
 		}
-		s.logger.Error("Delete: failed to get company id=%d: %v", config.CompanyID, err)
+		s.logger.ErrorContext(ctx, "Delete: failed to get company id=%d: %v", config.CompanyID, err)
 		return fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
 	}
 
-	// 3. Проверяем права доступа (только менеджер компании)
-	if !s.isManager(company, userID) {
-		s.logger.Warn("Delete: user=%d is not a manager of company=%d", userID, config.CompanyID)
+	// 3. Проверяем права доступа (менеджер компании или администратор платформы)
+	if !s.can(ctx, company, userID, policy.ActionManageConfig) {
+		s.logger.WarnContext(ctx, "Delete: user=%d is not allowed to manage configs of company=%d", userID, config.CompanyID)
 		return ErrAccessDenied
 	}
 
 	// 4. Удаляем конфигурацию
 	if err := s.configRepo.Delete(ctx, id); err != nil {
 		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("Delete: config id=%d not found during deletion", id)
+			s.logger.WarnContext(ctx, "Delete: config id=%d not found during deletion", id)
 			return ErrConfigNotFound
 		}
-		s.logger.Error("Delete: repository error for config id=%d: %v", id, err)
-		return fmt.Errorf("%w: Delete - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "Delete: repository error for config id=%d: %v", id, err)
+		return fmt.Errorf("%w: Delete - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("Delete: successfully deleted config id=%d", id)
+	s.logger.InfoContext(ctx, "Delete: successfully deleted config id=%d", id)
+	s.publishConfigChanged(ctx, config.CompanyID)
 	return nil
 }
 
 // DeleteByKey удаляет конфигурацию по ключу (company_id, address_id, service_id)
-// Доступно только менеджерам компании
+// Доступно менеджерам компании и администраторам платформы
 func (s *Service) DeleteByKey(ctx context.Context, req *models.DeleteConfigRequest) error {
-	s.logger.Info("DeleteByKey: deleting config for company=%d, address=%v, service=%v by user=%d",
+	s.logger.InfoContext(ctx, "DeleteByKey: deleting config for company=%d, address=%v, service=%v by user=%d",
 		req.CompanyID, req.AddressID, req.ServiceID, req.UserID)
 
 	// 1. Получаем компанию для проверки прав доступа
 	company, err := s.sellerClient.GetCompany(ctx, req.CompanyID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			s.logger.Warn("DeleteByKey: company id=%d not found", req.CompanyID)
+			s.logger.WarnContext(ctx, "DeleteByKey: company id=%d not found", req.CompanyID)
 			return ErrCompanyNotFound
 		}
-		s.logger.Error("DeleteByKey: failed to get company id=%d: %v", req.CompanyID, err)
+		s.logger.ErrorContext(ctx, "DeleteByKey: failed to get company id=%d: %v", req.CompanyID, err)
 		return fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
 	}
 
-	// 2. Проверяем права доступа (только менеджер компании)
-	if !s.isManager(company, req.UserID) {
-		s.logger.Warn("DeleteByKey: user=%d is not a manager of company=%d", req.UserID, req.CompanyID)
+	// 2. Проверяем права доступа (менеджер компании или администратор платформы)
+	if !s.can(ctx, company, req.UserID, policy.ActionManageConfig) {
+		s.logger.WarnContext(ctx, "DeleteByKey: user=%d is not allowed to manage configs of company=%d", req.UserID, req.CompanyID)
 		return ErrAccessDenied
 	}
 
 	// 3. Удаляем конфигурацию по ключу
 	if err := s.configRepo.DeleteByCompanyAddressAndService(ctx, req.CompanyID, req.AddressID, req.ServiceID); err != nil {
 		if errors.Is(err, configRepo.ErrConfigNotFound) {
-			s.logger.Warn("DeleteByKey: config not found for company=%d, address=%v, service=%v",
+			s.logger.WarnContext(ctx, "DeleteByKey: config not found for company=%d, address=%v, service=%v",
 				req.CompanyID, req.AddressID, req.ServiceID)
 			return ErrConfigNotFound
 		}
-		s.logger.Error("DeleteByKey: repository error: %v", err)
-		return fmt.Errorf("%w: DeleteByKey - repository error: %v", ErrInternal, err)
+		s.logger.ErrorContext(ctx, "DeleteByKey: repository error: %v", err)
+		return fmt.Errorf("%w: DeleteByKey - repository error: %w", ErrInternal, err)
 	}
 
-	s.logger.Info("DeleteByKey: successfully deleted config for company=%d, address=%v, service=%v",
+	s.logger.InfoContext(ctx, "DeleteByKey: successfully deleted config for company=%d, address=%v, service=%v",
 		req.CompanyID, req.AddressID, req.ServiceID)
+	s.publishConfigChanged(ctx, req.CompanyID)
 	return nil
 }
 
 // Вспомогательные методы
 
-// isManager проверяет, что пользователь является менеджером компании
-func (s *Service) isManager(company *sellerClient.Company, userID int64) bool {
-	for _, managerID := range company.ManagerIDs {
-		if managerID == userID {
-			return true
+// publishConfigChanged уведомляет открытые потоки слотов компании об изменении конфигурации
+func (s *Service) publishConfigChanged(ctx context.Context, companyID int64) {
+	s.events.PublishConfigEvent(ctx, domain.ConfigChangedEvent{CompanyID: companyID})
+}
+
+// prepareUpdate загружает конфигурацию, применяет к её копии изменения из запроса,
+// валидирует результат и проверяет, что пользователь - может изменять конфигурацию компании
+// Возвращает текущую конфигурацию, предлагаемую конфигурацию и компанию
+func (s *Service) prepareUpdate(
+	ctx context.Context,
+	op string,
+	id int64,
+	req *models.UpdateConfigRequest,
+) (*domain.CompanySlotsConfig, *domain.CompanySlotsConfig, *sellerClient.Company, error) {
+	// 1. Получаем существующую конфигурацию
+	config, err := s.configRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, configRepo.ErrConfigNotFound) {
+			s.logger.WarnContext(ctx, "%s: config id=%d not found", op, id)
+			return nil, nil, nil, ErrConfigNotFound
 		}
+		s.logger.ErrorContext(ctx, "%s: repository error for config id=%d: %v", op, id, err)
+		return nil, nil, nil, fmt.Errorf("%w: %s - repository error: %w", ErrInternal, op, err)
 	}
-	return false
+
+	// 2. Применяем обновления к копии конфигурации
+	proposed := *config
+	req.ApplyToConfig(&proposed)
+
+	// 3. Валидируем обновленные данные
+	if err := s.validateConfigData(proposed.SlotDurationMinutes, proposed.MaxConcurrentBookings,
+		proposed.AdvanceBookingDays, proposed.MinBookingNoticeMinutes); err != nil {
+		s.logger.WarnContext(ctx, "%s: validation failed for config id=%d: %v", op, id, err)
+		return nil, nil, nil, err
+	}
+
+	// 4. Получаем компанию для проверки прав доступа
+	company, err := s.sellerClient.GetCompany(ctx, config.CompanyID)
+	if err != nil {
+		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
+			s.logger.WarnContext(ctx, "%s: company id=%d not found", op, config.CompanyID)
+			return nil, nil, nil, ErrCompanyNotFound
+		}
+		s.logger.ErrorContext(ctx, "%s: failed to get company id=%d: %v", op, config.CompanyID, err)
+		return nil, nil, nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
+	}
+
+	// 5. Проверяем права доступа (менеджер компании или администратор платформы)
+	if !s.can(ctx, company, req.UserID, policy.ActionManageConfig) {
+		s.logger.WarnContext(ctx, "%s: user=%d is not allowed to manage configs of company=%d", op, req.UserID, config.CompanyID)
+		return nil, nil, nil, ErrAccessDenied
+	}
+
+	return config, &proposed, company, nil
+}
+
+// evaluateImpact загружает будущие активные бронирования и все конфигурации компании
+// и рассчитывает конфликты предлагаемой конфигурации с ними
+func (s *Service) evaluateImpact(
+	ctx context.Context,
+	op string,
+	current *domain.CompanySlotsConfig,
+	proposed *domain.CompanySlotsConfig,
+	company *sellerClient.Company,
+) (*models.ConfigImpactResponse, error) {
+	allConfigs, err := s.configRepo.GetAllByCompany(ctx, current.CompanyID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "%s: failed to get configs for company=%d: %v", op, current.CompanyID, err)
+		return nil, fmt.Errorf("%w: %s - repository error: %w", ErrInternal, op, err)
+	}
+
+	// Бронирования адреса конфигурации (все адреса для глобальной конфигурации и конфигурации услуги)
+	bookings, err := s.loadFutureBookings(ctx, op, current.CompanyID, current.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	return calculateImpact(current, proposed, allConfigs, bookings, company), nil
+}
+
+// loadFutureBookings загружает активные бронирования компании начиная с сегодняшнего дня
+// addressID ограничивает выборку одним адресом (nil - все адреса)
+func (s *Service) loadFutureBookings(ctx context.Context, op string, companyID int64, addressID *int64) ([]*domain.Booking, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	filter := domain.CompanyBookingsFilter{
+		CompanyID:       companyID,
+		AddressID:       addressID,
+		StartDate:       &today,
+		IncludeInactive: false,
+	}
+
+	bookings, err := s.bookingRepo.GetByCompanyWithFilter(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "%s: failed to get bookings for company=%d: %v", op, companyID, err)
+		return nil, fmt.Errorf("%w: %s - booking repository error: %w", ErrInternal, op, err)
+	}
+	return bookings, nil
+}
+
+// can проверяет, что пользователю разрешено действие над конфигурацией компании
+// Роль пользователя берется из контекста запроса (см. policy.ActorFromContext)
+func (s *Service) can(ctx context.Context, company *sellerClient.Company, userID int64, action policy.Action) bool {
+	return policy.Can(policy.ActorFromContext(ctx, userID), company, action)
 }
 
 // validateConfigData валидирует параметры конфигурации
 func (s *Service) validateConfigData(slotDuration, maxConcurrent, advanceDays, minNotice int) error {
 	// Проверяем slotDurationMinutes
 	if slotDuration <= 0 || slotDuration > 480 { // максимум 8 часов
-		return fmt.Errorf("%w: slotDurationMinutes must be between 1 and 480", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "slotDurationMinutes", "должно быть от 1 до 480")
 	}
 
 	// Проверяем maxConcurrentBookings
 	if maxConcurrent <= 0 || maxConcurrent > 100 {
-		return fmt.Errorf("%w: maxConcurrentBookings must be between 1 and 100", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "maxConcurrentBookings", "должно быть от 1 до 100")
 	}
 
 	// Проверяем advanceBookingDays
 	if advanceDays < 0 || advanceDays > 365 {
-		return fmt.Errorf("%w: advanceBookingDays must be between 0 and 365", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "advanceBookingDays", "должно быть от 0 до 365")
 	}
 
 	// Проверяем minBookingNoticeMinutes
 	if minNotice < 0 || minNotice > 10080 { // максимум 7 дней в минутах
-		return fmt.Errorf("%w: minBookingNoticeMinutes must be between 0 and 10080", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "minBookingNoticeMinutes", "должно быть от 0 до 10080")
 	}
 
 	return nil
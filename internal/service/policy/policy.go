@@ -0,0 +1,78 @@
+package policy
+
+import (
+	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+)
+
+// Action действие над ресурсами компании
+type Action string
+
+const (
+	ActionViewBookings        Action = "bookings:view"          // Просмотр бронирований компании
+	ActionChangeBookingStatus Action = "bookings:change_status" // Изменение статуса и отмена бронирований компании
+	ActionViewConfig          Action = "config:view"            // Просмотр и экспорт конфигурации слотов
+	ActionManageConfig        Action = "config:manage"          // Создание, изменение, импорт и удаление конфигурации
+)
+
+// permissions действия, разрешенные роли в компании
+var permissions = map[Role]map[Action]bool{
+	RoleCompanyManager: {
+		ActionViewBookings:        true,
+		ActionChangeBookingStatus: true,
+		ActionViewConfig:          true,
+		ActionManageConfig:        true,
+	},
+	RoleCompanyOperator: {
+		ActionViewBookings:        true,
+		ActionChangeBookingStatus: true,
+		ActionViewConfig:          true,
+	},
+}
+
+// CompanyRole определяет роль пользователя в компании
+//
+// Администратор платформы имеет роль в любой компании. Для остальных роль определяется
+// членством в компании по данным SellerService (manager_ids, operator_ids). Если токен
+// пользователя выдан с ролью оператора, права менеджера понижаются до оператора.
+// Возвращает RoleCustomer, если пользователь не относится к компании
+func CompanyRole(actor Actor, company *sellerservice.Company) Role {
+	if actor.Role == RolePlatformAdmin {
+		return RolePlatformAdmin
+	}
+
+	if contains(company.ManagerIDs, actor.UserID) {
+		if actor.Role == RoleCompanyOperator {
+			return RoleCompanyOperator
+		}
+		return RoleCompanyManager
+	}
+
+	if contains(company.OperatorIDs, actor.UserID) {
+		return RoleCompanyOperator
+	}
+
+	return RoleCustomer
+}
+
+// Can проверяет, разрешено ли пользователю действие над компанией
+func Can(actor Actor, company *sellerservice.Company, action Action) bool {
+	role := CompanyRole(actor, company)
+	if role == RolePlatformAdmin {
+		return true
+	}
+	return permissions[role][action]
+}
+
+// IsPlatformAdmin проверяет, что пользователь - администратор платформы
+func IsPlatformAdmin(actor Actor) bool {
+	return actor.Role == RolePlatformAdmin
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
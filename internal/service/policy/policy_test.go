@@ -0,0 +1,74 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+)
+
+const (
+	managerID  int64 = 1
+	operatorID int64 = 2
+	outsiderID int64 = 3
+)
+
+var testCompany = &sellerservice.Company{
+	ID:          10,
+	ManagerIDs:  []int64{managerID},
+	OperatorIDs: []int64{operatorID},
+}
+
+func TestCompanyRole(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor Actor
+		want  Role
+	}{
+		{"manager", Actor{UserID: managerID, Role: RoleCompanyManager}, RoleCompanyManager},
+		{"manager with customer token", Actor{UserID: managerID, Role: RoleCustomer}, RoleCompanyManager},
+		{"manager with operator token is downgraded", Actor{UserID: managerID, Role: RoleCompanyOperator}, RoleCompanyOperator},
+		{"operator", Actor{UserID: operatorID, Role: RoleCompanyOperator}, RoleCompanyOperator},
+		{"operator with manager token is not upgraded", Actor{UserID: operatorID, Role: RoleCompanyManager}, RoleCompanyOperator},
+		{"outsider with manager token", Actor{UserID: outsiderID, Role: RoleCompanyManager}, RoleCustomer},
+		{"customer", Actor{UserID: outsiderID, Role: RoleCustomer}, RoleCustomer},
+		{"platform admin outside company", Actor{UserID: outsiderID, Role: RolePlatformAdmin}, RolePlatformAdmin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CompanyRole(tt.actor, testCompany))
+		})
+	}
+}
+
+func TestCan(t *testing.T) {
+	actors := map[string]Actor{
+		"manager":  {UserID: managerID, Role: RoleCompanyManager},
+		"operator": {UserID: operatorID, Role: RoleCompanyOperator},
+		"customer": {UserID: outsiderID, Role: RoleCustomer},
+		"admin":    {UserID: outsiderID, Role: RolePlatformAdmin},
+	}
+
+	// Ожидаемые права: manager, operator, customer, admin
+	matrix := map[Action][4]bool{
+		ActionViewBookings:        {true, true, false, true},
+		ActionChangeBookingStatus: {true, true, false, true},
+		ActionViewConfig:          {true, true, false, true},
+		ActionManageConfig:        {true, false, false, true},
+	}
+
+	for action, want := range matrix {
+		for i, name := range []string{"manager", "operator", "customer", "admin"} {
+			t.Run(string(action)+"/"+name, func(t *testing.T) {
+				assert.Equal(t, want[i], Can(actors[name], testCompany, action))
+			})
+		}
+	}
+
+	t.Run("unknown action is denied", func(t *testing.T) {
+		assert.False(t, Can(actors["manager"], testCompany, Action("bookings:delete")))
+		assert.True(t, Can(actors["admin"], testCompany, Action("bookings:delete")))
+	})
+}
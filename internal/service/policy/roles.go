@@ -0,0 +1,47 @@
+package policy
+
+import "context"
+
+// Role роль пользователя
+type Role string
+
+const (
+	RoleCustomer        Role = "customer"         // Клиент: доступ только к своим бронированиям
+	RoleCompanyManager  Role = "company_manager"  // Менеджер компании: бронирования и конфигурация
+	RoleCompanyOperator Role = "company_operator" // Оператор компании: бронирования и их статусы, без конфигурации
+	RolePlatformAdmin   Role = "platform_admin"   // Администратор платформы: доступ ко всем компаниям
+)
+
+// ParseRole преобразует роль из токена, неизвестная или пустая роль считается клиентской
+func ParseRole(role string) Role {
+	switch r := Role(role); r {
+	case RoleCompanyManager, RoleCompanyOperator, RolePlatformAdmin:
+		return r
+	default:
+		return RoleCustomer
+	}
+}
+
+// Actor пользователь, от имени которого выполняется действие
+type Actor struct {
+	UserID int64
+	Role   Role
+}
+
+type contextKey struct{}
+
+// WithActor сохраняет пользователя в контекст (вызывается middleware аутентификации)
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// ActorFromContext возвращает пользователя, от имени которого выполняется запрос
+// userID - ID пользователя из запроса к сервису. Если в контексте нет пользователя
+// или он не совпадает с userID, возвращается клиент с этим ID без дополнительных прав
+func ActorFromContext(ctx context.Context, userID int64) Actor {
+	actor, ok := ctx.Value(contextKey{}).(Actor)
+	if !ok || actor.UserID != userID {
+		return Actor{UserID: userID, Role: RoleCustomer}
+	}
+	return actor
+}
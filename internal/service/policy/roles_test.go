@@ -0,0 +1,36 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		role string
+		want Role
+	}{
+		{"company_manager", RoleCompanyManager},
+		{"company_operator", RoleCompanyOperator},
+		{"platform_admin", RolePlatformAdmin},
+		{"customer", RoleCustomer},
+		{"", RoleCustomer},
+		{"root", RoleCustomer},
+		{"PLATFORM_ADMIN", RoleCustomer},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ParseRole(tt.role), "role %q", tt.role)
+	}
+}
+
+func TestActorFromContext(t *testing.T) {
+	admin := Actor{UserID: 5, Role: RolePlatformAdmin}
+	ctx := WithActor(context.Background(), admin)
+
+	assert.Equal(t, admin, ActorFromContext(ctx, 5))
+	assert.Equal(t, Actor{UserID: 6, Role: RoleCustomer}, ActorFromContext(ctx, 6), "роль не переносится на другого пользователя")
+	assert.Equal(t, Actor{UserID: 5, Role: RoleCustomer}, ActorFromContext(context.Background(), 5))
+}
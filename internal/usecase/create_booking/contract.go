@@ -28,7 +28,7 @@ type SellerServiceClient interface {
 
 // UserServiceClient интерфейс клиента для UserService
 type UserServiceClient interface {
-	GetSelectedCar(ctx context.Context, tgUserID int64) (*userservice.Car, error)
+	GetSelectedCarWithGracefulDegradation(ctx context.Context, tgUserID int64) (*userservice.Car, error)
 }
 
 // TransactionManager интерфейс для управления транзакциями
@@ -36,6 +36,16 @@ type TransactionManager interface {
 	DoSerializable(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+// BookingEventPublisher публикация изменений бронирований (поток слотов, поток бронирований компании)
+type BookingEventPublisher interface {
+	PublishBookingEvent(ctx context.Context, event domain.BookingEvent)
+}
+
+// Metrics бизнес метрики создания бронирований
+type Metrics interface {
+	RecordSlotRejection(ctx context.Context, companyID int64)
+}
+
 // TimeProvider интерфейс для получения текущего времени (для тестирования)
 type TimeProvider interface {
 	Now() time.Time
@@ -43,9 +53,9 @@ type TimeProvider interface {
 
 // Logger интерфейс для логирования
 type Logger interface {
-	Info(format string, v ...interface{})
-	Warn(format string, v ...interface{})
-	Error(format string, v ...interface{})
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
 }
 
 // RealTimeProvider реальный провайдер времени для production
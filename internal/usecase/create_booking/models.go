@@ -15,6 +15,16 @@ type Request struct {
 	Date      time.Time        // Дата бронирования (без времени)
 	StartTime types.TimeString // Время начала слота (например, "10:00")
 	Notes     *string          // Дополнительные заметки (опционально)
+	Car       *Car             // Автомобиль (опционально, по умолчанию - выбранный автомобиль из UserService)
+}
+
+// Car автомобиль, переданный клиентом вместе с запросом
+// Марка, модель и номер не сохраняются: данные автомобиля берутся из UserService (см. resolveCar)
+type Car struct {
+	ID           int64
+	Brand        string
+	Model        string
+	LicensePlate string
 }
 
 // Response модель ответа с созданным бронированием
@@ -24,7 +34,7 @@ type Response struct {
 	CompanyID       int64            // ID компании
 	AddressID       int64            // ID адреса
 	ServiceID       int64            // ID услуги
-	CarID           int64            // ID автомобиля
+	CarID           *int64           // ID автомобиля (nil, если данные ещё не получены)
 	BookingDate     time.Time        // Дата бронирования
 	StartTime       types.TimeString // Время начала
 	DurationMinutes int              // Длительность в минутах
@@ -38,6 +48,8 @@ type Response struct {
 	CarLicensePlate *string // Госномер
 	Notes           *string // Заметки
 
+	CarDetailsPending bool // Данные автомобиля будут дозаполнены, когда UserService станет доступен
+
 	CreatedAt time.Time // Время создания
 	UpdatedAt time.Time // Время обновления
 }
@@ -5,13 +5,19 @@ import (
 	"errors"
 	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/m04kA/SMC-BookingService/internal/domain"
 	configRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/config"
 	sellerClient "github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
 	userClient "github.com/m04kA/SMC-BookingService/internal/integrations/userservice"
 	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+	"github.com/m04kA/SMC-BookingService/pkg/tracing"
 )
 
+// tracerName имя трассировщика спанов шагов use case
+const tracerName = "create_booking"
+
 // UseCase use case для создания бронирования
 type UseCase struct {
 	bookingRepo  BookingRepository
@@ -19,17 +25,22 @@ type UseCase struct {
 	sellerClient SellerServiceClient
 	userClient   UserServiceClient
 	txManager    TransactionManager
+	events       BookingEventPublisher
+	metrics      Metrics
 	timeProvider TimeProvider
 	logger       Logger
 }
 
 // NewUseCase создает новый экземпляр use case
+// metrics может быть nil, если метрики отключены
 func NewUseCase(
 	bookingRepo BookingRepository,
 	configRepo ConfigRepository,
 	sellerClient SellerServiceClient,
 	userClient UserServiceClient,
 	txManager TransactionManager,
+	events BookingEventPublisher,
+	metrics Metrics,
 	logger Logger,
 ) *UseCase {
 	return &UseCase{
@@ -38,20 +49,31 @@ func NewUseCase(
 		sellerClient: sellerClient,
 		userClient:   userClient,
 		txManager:    txManager,
+		events:       events,
+		metrics:      metrics,
 		timeProvider: &RealTimeProvider{},
 		logger:       logger,
 	}
 }
 
 // Execute выполняет use case создания бронирования
-// Использует сериализуемую транзакцию для предотвращения гонки данных
-func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error) {
-	uc.logger.Info("CreateBooking: user=%d, company=%d, address=%d, service=%d, date=%s, time=%s",
+// Использует сериализуемую транзакцию для предотвращения гонки данных.
+// Каждый шаг (запросы в SellerService и UserService, транзакция и запросы в ней) - отдельный спан трассы
+func (uc *UseCase) Execute(ctx context.Context, req *Request) (_ *Response, err error) {
+	ctx, span := tracing.Start(ctx, tracerName, "create_booking",
+		attribute.Int64("user_id", req.UserID),
+		attribute.Int64("company_id", req.CompanyID),
+		attribute.Int64("address_id", req.AddressID),
+		attribute.Int64("service_id", req.ServiceID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	uc.logger.InfoContext(ctx, "CreateBooking: user=%d, company=%d, address=%d, service=%d, date=%s, time=%s",
 		req.UserID, req.CompanyID, req.AddressID, req.ServiceID, req.Date.Format(domain.DateFormat), req.StartTime)
 
 	// 1. Валидация входных данных
 	if err := validateRequest(req); err != nil {
-		uc.logger.Warn("CreateBooking: validation failed: %v", err)
+		uc.logger.WarnContext(ctx, "CreateBooking: validation failed: %v", err)
 		return nil, err
 	}
 
@@ -59,61 +81,71 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 	now := uc.timeProvider.Now()
 
 	// 3. Получаем компанию
-	company, err := uc.sellerClient.GetCompany(ctx, req.CompanyID)
+	var company *sellerClient.Company
+	err = traceStep(ctx, "get_company", func(ctx context.Context) (err error) {
+		company, err = uc.sellerClient.GetCompany(ctx, req.CompanyID)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			uc.logger.Warn("CreateBooking: company id=%d not found", req.CompanyID)
+			uc.logger.WarnContext(ctx, "CreateBooking: company id=%d not found", req.CompanyID)
 			return nil, ErrCompanyNotFound
 		}
-		uc.logger.Error("CreateBooking: failed to get company id=%d: %v", req.CompanyID, err)
+		uc.logger.ErrorContext(ctx, "CreateBooking: failed to get company id=%d: %v", req.CompanyID, err)
 		return nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
 	}
 
 	// 4. Проверяем существование адреса
 	if err := validateAddressExists(company, req.AddressID); err != nil {
-		uc.logger.Warn("CreateBooking: address id=%d not found in company id=%d", req.AddressID, req.CompanyID)
+		uc.logger.WarnContext(ctx, "CreateBooking: address id=%d not found in company id=%d", req.AddressID, req.CompanyID)
 		return nil, err
 	}
 
 	// 5. Получаем услугу
-	service, err := uc.sellerClient.GetService(ctx, req.CompanyID, req.ServiceID)
+	var service *sellerClient.Service
+	err = traceStep(ctx, "get_service", func(ctx context.Context) (err error) {
+		service, err = uc.sellerClient.GetService(ctx, req.CompanyID, req.ServiceID)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrServiceNotFound) {
-			uc.logger.Warn("CreateBooking: service id=%d not found", req.ServiceID)
+			uc.logger.WarnContext(ctx, "CreateBooking: service id=%d not found", req.ServiceID)
 			return nil, ErrServiceNotFound
 		}
-		uc.logger.Error("CreateBooking: failed to get service id=%d: %v", req.ServiceID, err)
+		uc.logger.ErrorContext(ctx, "CreateBooking: failed to get service id=%d: %v", req.ServiceID, err)
 		return nil, fmt.Errorf("%w: failed to get service: %v", ErrInternal, err)
 	}
 
 	// 6. Проверяем, что услуга доступна на этом адресе
 	if err := validateServiceAtAddress(service, req.AddressID); err != nil {
-		uc.logger.Warn("CreateBooking: service id=%d not available at address id=%d",
+		uc.logger.WarnContext(ctx, "CreateBooking: service id=%d not available at address id=%d",
 			req.ServiceID, req.AddressID)
 		return nil, err
 	}
 
-	// 7. Получаем выбранный автомобиль пользователя
-	car, err := uc.userClient.GetSelectedCar(ctx, req.UserID)
+	// 7. Получаем данные автомобиля (из запроса или из UserService с graceful degradation)
+	var car domain.CarDetails
+	var carDetailsPending bool
+	err = traceStep(ctx, "resolve_car", func(ctx context.Context) (err error) {
+		car, carDetailsPending, err = uc.resolveCar(ctx, req)
+		return err
+	})
 	if err != nil {
-		if errors.Is(err, userClient.ErrCarNotFound) {
-			uc.logger.Warn("CreateBooking: user id=%d has no selected car", req.UserID)
-			return nil, ErrCarNotFound
-		}
-		uc.logger.Error("CreateBooking: failed to get selected car for user id=%d: %v", req.UserID, err)
-		return nil, fmt.Errorf("%w: failed to get selected car: %v", ErrInternal, err)
+		return nil, err
 	}
 
 	// Переменная для хранения результата
 	var result *domain.Booking
 
 	// 8. Выполняем операции с БД в сериализуемой транзакции
-	err = uc.txManager.DoSerializable(ctx, func(txCtx context.Context) error {
+	// Запросы транзакции (включая выборку бронирований FOR UPDATE) - дочерние спаны шага transaction
+	txSpanCtx, txSpan := tracing.Start(ctx, tracerName, "create_booking.transaction")
+	err = uc.txManager.DoSerializable(txSpanCtx, func(txCtx context.Context) error {
 		// 8.1. Получаем конфигурацию слотов с учетом иерархии
 		config, err := uc.configRepo.GetConfigWithHierarchy(txCtx, req.CompanyID, ptr.Ptr(req.AddressID), ptr.Ptr(req.ServiceID))
 		if err != nil && !errors.Is(err, configRepo.ErrConfigNotFound) {
-			uc.logger.Error("CreateBooking: failed to get config: %v", err)
-			return fmt.Errorf("%w: failed to get config: %v", ErrInternal, err)
+			uc.logger.ErrorContext(ctx, "CreateBooking: failed to get config: %v", err)
+			return fmt.Errorf("%w: failed to get config: %w", ErrInternal, err)
 		}
 
 		// Если конфигурация не найдена, используем дефолтные значения
@@ -124,28 +156,28 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 				AdvanceBookingDays:      domain.DefaultAdvanceBookingDays,
 				MinBookingNoticeMinutes: domain.DefaultMinBookingNoticeMinutes,
 			}
-			uc.logger.Info("CreateBooking: using default config for company=%d, address=%d, service=%d",
+			uc.logger.InfoContext(ctx, "CreateBooking: using default config for company=%d, address=%d, service=%d",
 				req.CompanyID, req.AddressID, req.ServiceID)
 		} else {
-			uc.logger.Info("CreateBooking: using config id=%d", config.ID)
+			uc.logger.InfoContext(ctx, "CreateBooking: using config id=%d", config.ID)
 		}
 
 		// 8.2. Валидация даты с учетом конфигурации
 		if err := validateDate(req.Date, now, config.AdvanceBookingDays); err != nil {
-			uc.logger.Warn("CreateBooking: date validation failed: %v", err)
+			uc.logger.WarnContext(ctx, "CreateBooking: date validation failed: %v", err)
 			return err
 		}
 
 		// 8.3. Получаем рабочие часы на указанную дату
-		workingHours := getWorkingHoursForDay(company, req.Date)
+		workingHours := company.WorkingHours.ForDay(req.Date.Weekday())
 		if !workingHours.IsOpen {
-			uc.logger.Warn("CreateBooking: company is closed on %s", req.Date.Format(domain.DateFormat))
+			uc.logger.WarnContext(ctx, "CreateBooking: company is closed on %s", req.Date.Format(domain.DateFormat))
 			return ErrCompanyClosed
 		}
 
 		// 8.4. Валидация времени бронирования (minBookingNoticeMinutes)
 		if err := validateBookingTime(req.Date, req.StartTime, now, config.MinBookingNoticeMinutes); err != nil {
-			uc.logger.Warn("CreateBooking: booking time validation failed: %v", err)
+			uc.logger.WarnContext(ctx, "CreateBooking: booking time validation failed: %v", err)
 			return err
 		}
 
@@ -160,26 +192,29 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 
 		bookings, err := uc.bookingRepo.GetByCompanyWithFilter(txCtx, filter)
 		if err != nil {
-			uc.logger.Error("CreateBooking: failed to get bookings: %v", err)
-			return fmt.Errorf("%w: failed to get bookings: %v", ErrInternal, err)
+			uc.logger.ErrorContext(ctx, "CreateBooking: failed to get bookings: %v", err)
+			return fmt.Errorf("%w: failed to get bookings: %w", ErrInternal, err)
 		}
 
 		// 8.6. Проверяем доступность слота
 		overlappingCount, err := countOverlappingBookings(req.StartTime, config.SlotDurationMinutes, bookings)
 		if err != nil {
-			uc.logger.Error("CreateBooking: failed to count overlapping bookings: %v", err)
-			return fmt.Errorf("%w: failed to count overlapping bookings: %v", ErrInternal, err)
+			uc.logger.ErrorContext(ctx, "CreateBooking: failed to count overlapping bookings: %v", err)
+			return fmt.Errorf("%w: failed to count overlapping bookings: %w", ErrInternal, err)
 		}
 
 		// Если MaxConcurrentBookings = 4, то допустимо overlappingCount = 0, 1, 2, 3
 		// При overlappingCount >= 4 слот недоступен
 		if overlappingCount >= config.MaxConcurrentBookings {
-			uc.logger.Warn("CreateBooking: slot not available, %d/%d spots taken",
+			uc.logger.WarnContext(ctx, "CreateBooking: slot not available, %d/%d spots taken",
 				overlappingCount, config.MaxConcurrentBookings)
+			if uc.metrics != nil {
+				uc.metrics.RecordSlotRejection(ctx, req.CompanyID)
+			}
 			return ErrSlotNotAvailable
 		}
 
-		uc.logger.Info("CreateBooking: slot available, %d/%d spots taken",
+		uc.logger.InfoContext(ctx, "CreateBooking: slot available, %d/%d spots taken",
 			overlappingCount, config.MaxConcurrentBookings)
 
 		// 8.7. Создаем бронирование с денормализацией данных
@@ -188,7 +223,7 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 			CompanyID:       req.CompanyID,
 			AddressID:       req.AddressID,
 			ServiceID:       req.ServiceID,
-			CarID:           car.ID,
+			CarID:           car.CarID,
 			BookingDate:     req.Date,
 			StartTime:       req.StartTime,
 			DurationMinutes: config.SlotDurationMinutes,
@@ -197,9 +232,10 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 			ServiceName:  service.Name,
 			ServicePrice: getServicePrice(service),
 			// Денормализация данных автомобиля
-			CarBrand:        &car.Brand,
-			CarModel:        &car.Model,
-			CarLicensePlate: &car.LicensePlate,
+			CarBrand:          car.Brand,
+			CarModel:          car.Model,
+			CarLicensePlate:   car.LicensePlate,
+			CarDetailsPending: carDetailsPending,
 			// Заметки
 			Notes: req.Notes,
 		}
@@ -207,43 +243,98 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 		// 8.8. Сохраняем бронирование
 		created, err := uc.bookingRepo.Create(txCtx, booking)
 		if err != nil {
-			uc.logger.Error("CreateBooking: failed to create booking: %v", err)
-			return fmt.Errorf("%w: failed to create booking: %v", ErrInternal, err)
+			uc.logger.ErrorContext(ctx, "CreateBooking: failed to create booking: %v", err)
+			return fmt.Errorf("%w: failed to create booking: %w", ErrInternal, err)
 		}
 
 		result = created
 		return nil
 	})
+	tracing.End(txSpan, err)
 
 	if err != nil {
 		return nil, err
 	}
 
-	uc.logger.Info("CreateBooking: successfully created booking id=%d", result.ID)
+	span.SetAttributes(attribute.Int64("booking_id", result.ID))
+	uc.events.PublishBookingEvent(ctx, domain.BookingEvent{Type: domain.BookingEventCreated, Booking: result})
+
+	uc.logger.InfoContext(ctx, "CreateBooking: successfully created booking id=%d", result.ID)
 
 	// Конвертируем в response
 	return &Response{
-		ID:              result.ID,
-		UserID:          result.UserID,
-		CompanyID:       result.CompanyID,
-		AddressID:       result.AddressID,
-		ServiceID:       result.ServiceID,
-		CarID:           result.CarID,
-		BookingDate:     result.BookingDate,
-		StartTime:       result.StartTime,
-		DurationMinutes: result.DurationMinutes,
-		Status:          string(result.Status),
-		ServiceName:     result.ServiceName,
-		ServicePrice:    result.ServicePrice,
-		CarBrand:        result.CarBrand,
-		CarModel:        result.CarModel,
-		CarLicensePlate: result.CarLicensePlate,
-		Notes:           result.Notes,
-		CreatedAt:       result.CreatedAt,
-		UpdatedAt:       result.UpdatedAt,
+		ID:                result.ID,
+		UserID:            result.UserID,
+		CompanyID:         result.CompanyID,
+		AddressID:         result.AddressID,
+		ServiceID:         result.ServiceID,
+		CarID:             result.CarID,
+		BookingDate:       result.BookingDate,
+		StartTime:         result.StartTime,
+		DurationMinutes:   result.DurationMinutes,
+		Status:            string(result.Status),
+		ServiceName:       result.ServiceName,
+		ServicePrice:      result.ServicePrice,
+		CarBrand:          result.CarBrand,
+		CarModel:          result.CarModel,
+		CarLicensePlate:   result.CarLicensePlate,
+		Notes:             result.Notes,
+		CarDetailsPending: result.CarDetailsPending,
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
 	}, nil
 }
 
+// resolveCar определяет данные автомобиля для бронирования
+// Данные автомобиля берутся только из UserService. Автомобиль из запроса принимается, если это
+// выбранный автомобиль пользователя (UserService отдает только его), иначе - ErrCarNotFound.
+// При недоступности UserService сохраняются данные автомобиля из запроса (ID, марка, модель, номер),
+// бронирование помечается для проверки и дозаполнения фоновой задачей (pending = true)
+func (uc *UseCase) resolveCar(ctx context.Context, req *Request) (domain.CarDetails, bool, error) {
+	car, err := uc.userClient.GetSelectedCarWithGracefulDegradation(ctx, req.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, userClient.ErrCarNotFound):
+			uc.logger.WarnContext(ctx, "CreateBooking: user id=%d has no selected car", req.UserID)
+			return domain.CarDetails{}, false, ErrCarNotFound
+		case errors.Is(err, userClient.ErrServiceDegraded):
+			uc.logger.WarnContext(ctx, "CreateBooking: UserService unavailable, creating booking for user id=%d without car details", req.UserID)
+			if req.Car != nil {
+				return domain.CarDetails{
+					CarID:        ptr.Ptr(req.Car.ID),
+					Brand:        nonEmpty(req.Car.Brand),
+					Model:        nonEmpty(req.Car.Model),
+					LicensePlate: nonEmpty(req.Car.LicensePlate),
+				}, true, nil
+			}
+			return domain.CarDetails{}, true, nil
+		default:
+			uc.logger.ErrorContext(ctx, "CreateBooking: failed to get selected car for user id=%d: %v", req.UserID, err)
+			return domain.CarDetails{}, false, fmt.Errorf("%w: failed to get selected car: %v", ErrInternal, err)
+		}
+	}
+
+	if req.Car != nil && req.Car.ID != car.ID {
+		uc.logger.WarnContext(ctx, "CreateBooking: car id=%d from request is not the selected car of user id=%d", req.Car.ID, req.UserID)
+		return domain.CarDetails{}, false, ErrCarNotFound
+	}
+
+	return domain.CarDetails{
+		CarID:        ptr.Ptr(car.ID),
+		Brand:        ptr.Ptr(car.Brand),
+		Model:        ptr.Ptr(car.Model),
+		LicensePlate: ptr.Ptr(car.LicensePlate),
+	}, false, nil
+}
+
+// nonEmpty возвращает nil для пустой строки, чтобы не сохранять пустые данные автомобиля
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 // getServicePrice извлекает цену из услуги
 // Если цена не указана (nil), возвращает 0.0
 func getServicePrice(service *sellerClient.Service) float64 {
@@ -252,3 +343,11 @@ func getServicePrice(service *sellerClient.Service) float64 {
 	}
 	return *service.Price
 }
+
+// traceStep выполняет шаг use case в спане "create_booking.<step>"
+func traceStep(ctx context.Context, step string, fn func(ctx context.Context) error) error {
+	ctx, span := tracing.Start(ctx, tracerName, tracerName+"."+step)
+	err := fn(ctx)
+	tracing.End(span, err)
+	return err
+}
@@ -9,37 +9,49 @@ import (
 	"github.com/m04kA/SMC-BookingService/pkg/types"
 )
 
+// Сообщения клиенту об ошибках полей запроса
+const (
+	msgInvalidID   = "ID должен быть положительным"
+	msgRequired    = "обязательное поле"
+	msgInvalidTime = "некорректный формат времени, ожидается HH:MM"
+)
+
 // validateRequest валидирует входные данные запроса
 func validateRequest(req *Request) error {
 	if req.UserID <= 0 {
-		return fmt.Errorf("%w: userID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "userId", msgInvalidID)
 	}
 
 	if req.CompanyID <= 0 {
-		return fmt.Errorf("%w: companyID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "companyId", msgInvalidID)
 	}
 
 	if req.AddressID <= 0 {
-		return fmt.Errorf("%w: addressID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "addressId", msgInvalidID)
 	}
 
 	if req.ServiceID <= 0 {
-		return fmt.Errorf("%w: serviceID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "serviceId", msgInvalidID)
+	}
+
+	// Проверяем переданный автомобиль (если указан)
+	if req.Car != nil && req.Car.ID <= 0 {
+		return domain.InvalidField(ErrInvalidInput, "car.id", msgInvalidID)
 	}
 
 	// Проверяем, что дата не является нулевой
 	if req.Date.IsZero() {
-		return fmt.Errorf("%w: date is required", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "bookingDate", msgRequired)
 	}
 
 	// Проверяем, что время начала указано
 	if req.StartTime.IsZero() {
-		return fmt.Errorf("%w: startTime is required", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "startTime", msgRequired)
 	}
 
 	// Валидируем формат времени
 	if err := req.StartTime.Validate(); err != nil {
-		return fmt.Errorf("%w: invalid startTime format: %v", ErrInvalidInput, err)
+		return domain.InvalidField(ErrInvalidInput, "startTime", msgInvalidTime)
 	}
 
 	return nil
@@ -152,30 +164,6 @@ func countOverlappingBookings(
 	return count, nil
 }
 
-// getWorkingHoursForDay возвращает расписание работы компании на указанный день недели
-func getWorkingHoursForDay(company *sellerservice.Company, date time.Time) sellerservice.DaySchedule {
-	weekday := date.Weekday()
-
-	switch weekday {
-	case time.Monday:
-		return company.WorkingHours.Monday
-	case time.Tuesday:
-		return company.WorkingHours.Tuesday
-	case time.Wednesday:
-		return company.WorkingHours.Wednesday
-	case time.Thursday:
-		return company.WorkingHours.Thursday
-	case time.Friday:
-		return company.WorkingHours.Friday
-	case time.Saturday:
-		return company.WorkingHours.Saturday
-	case time.Sunday:
-		return company.WorkingHours.Sunday
-	default:
-		return sellerservice.DaySchedule{IsOpen: false}
-	}
-}
-
 // isSameDay проверяет, что две даты относятся к одному и тому же дню
 func isSameDay(date1, date2 time.Time) bool {
 	y1, m1, d1 := date1.Date()
@@ -33,9 +33,9 @@ type TimeProvider interface {
 
 // Logger интерфейс для логирования
 type Logger interface {
-	Info(format string, v ...interface{})
-	Warn(format string, v ...interface{})
-	Error(format string, v ...interface{})
+	InfoContext(ctx context.Context, format string, v ...interface{})
+	WarnContext(ctx context.Context, format string, v ...interface{})
+	ErrorContext(ctx context.Context, format string, v ...interface{})
 }
 
 // RealTimeProvider реальный провайдер времени для production
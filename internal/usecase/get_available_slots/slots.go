@@ -157,30 +157,6 @@ func countOverlappingBookings(slotStart types.TimeString, slotDuration int, book
 	return count
 }
 
-// getWorkingHoursForDay возвращает расписание работы компании на указанный день недели
-func getWorkingHoursForDay(company *sellerservice.Company, date time.Time) sellerservice.DaySchedule {
-	weekday := date.Weekday()
-
-	switch weekday {
-	case time.Monday:
-		return company.WorkingHours.Monday
-	case time.Tuesday:
-		return company.WorkingHours.Tuesday
-	case time.Wednesday:
-		return company.WorkingHours.Wednesday
-	case time.Thursday:
-		return company.WorkingHours.Thursday
-	case time.Friday:
-		return company.WorkingHours.Friday
-	case time.Saturday:
-		return company.WorkingHours.Saturday
-	case time.Sunday:
-		return company.WorkingHours.Sunday
-	default:
-		return sellerservice.DaySchedule{IsOpen: false}
-	}
-}
-
 // isSameDay проверяет, что две даты относятся к одному и тому же дню
 func isSameDay(date1, date2 time.Time) bool {
 	y1, m1, d1 := date1.Date()
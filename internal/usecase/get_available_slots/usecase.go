@@ -38,12 +38,12 @@ func NewUseCase(
 
 // Execute выполняет use case получения доступных слотов
 func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error) {
-	uc.logger.Info("GetAvailableSlots: user=%d, company=%d, address=%d, service=%d, date=%s",
+	uc.logger.InfoContext(ctx, "GetAvailableSlots: user=%d, company=%d, address=%d, service=%d, date=%s",
 		req.UserID, req.CompanyID, req.AddressID, req.ServiceID, req.Date.Format(domain.DateFormat))
 
 	// 1. Валидация входных данных
 	if err := validateRequest(req); err != nil {
-		uc.logger.Warn("GetAvailableSlots: validation failed: %v", err)
+		uc.logger.WarnContext(ctx, "GetAvailableSlots: validation failed: %v", err)
 		return nil, err
 	}
 
@@ -54,16 +54,16 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 	company, err := uc.sellerClient.GetCompany(ctx, req.CompanyID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrCompanyNotFound) {
-			uc.logger.Warn("GetAvailableSlots: company id=%d not found", req.CompanyID)
+			uc.logger.WarnContext(ctx, "GetAvailableSlots: company id=%d not found", req.CompanyID)
 			return nil, ErrCompanyNotFound
 		}
-		uc.logger.Error("GetAvailableSlots: failed to get company id=%d: %v", req.CompanyID, err)
+		uc.logger.ErrorContext(ctx, "GetAvailableSlots: failed to get company id=%d: %v", req.CompanyID, err)
 		return nil, fmt.Errorf("%w: failed to get company: %v", ErrInternal, err)
 	}
 
 	// 4. Проверяем существование адреса
 	if err := validateAddressExists(company, req.AddressID); err != nil {
-		uc.logger.Warn("GetAvailableSlots: address id=%d not found in company id=%d", req.AddressID, req.CompanyID)
+		uc.logger.WarnContext(ctx, "GetAvailableSlots: address id=%d not found in company id=%d", req.AddressID, req.CompanyID)
 		return nil, err
 	}
 
@@ -71,16 +71,16 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 	service, err := uc.sellerClient.GetService(ctx, req.CompanyID, req.ServiceID)
 	if err != nil {
 		if errors.Is(err, sellerClient.ErrServiceNotFound) {
-			uc.logger.Warn("GetAvailableSlots: service id=%d not found", req.ServiceID)
+			uc.logger.WarnContext(ctx, "GetAvailableSlots: service id=%d not found", req.ServiceID)
 			return nil, ErrServiceNotFound
 		}
-		uc.logger.Error("GetAvailableSlots: failed to get service id=%d: %v", req.ServiceID, err)
+		uc.logger.ErrorContext(ctx, "GetAvailableSlots: failed to get service id=%d: %v", req.ServiceID, err)
 		return nil, fmt.Errorf("%w: failed to get service: %v", ErrInternal, err)
 	}
 
 	// 6. Проверяем, что услуга доступна на этом адресе
 	if err := validateServiceAtAddress(service, req.AddressID); err != nil {
-		uc.logger.Warn("GetAvailableSlots: service id=%d not available at address id=%d",
+		uc.logger.WarnContext(ctx, "GetAvailableSlots: service id=%d not available at address id=%d",
 			req.ServiceID, req.AddressID)
 		return nil, err
 	}
@@ -88,8 +88,8 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 	// 7. Получаем конфигурацию слотов с учетом иерархии
 	config, err := uc.configRepo.GetConfigWithHierarchy(ctx, req.CompanyID, ptr.Ptr(req.AddressID), ptr.Ptr(req.ServiceID))
 	if err != nil && !errors.Is(err, configRepo.ErrConfigNotFound) {
-		uc.logger.Error("GetAvailableSlots: failed to get config: %v", err)
-		return nil, fmt.Errorf("%w: failed to get config: %v", ErrInternal, err)
+		uc.logger.ErrorContext(ctx, "GetAvailableSlots: failed to get config: %v", err)
+		return nil, fmt.Errorf("%w: failed to get config: %w", ErrInternal, err)
 	}
 
 	// Если конфигурация не найдена, используем дефолтные значения
@@ -100,22 +100,22 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 			AdvanceBookingDays:      domain.DefaultAdvanceBookingDays,
 			MinBookingNoticeMinutes: domain.DefaultMinBookingNoticeMinutes,
 		}
-		uc.logger.Info("GetAvailableSlots: using default config for company=%d, address=%d, service=%d",
+		uc.logger.InfoContext(ctx, "GetAvailableSlots: using default config for company=%d, address=%d, service=%d",
 			req.CompanyID, req.AddressID, req.ServiceID)
 	} else {
-		uc.logger.Info("GetAvailableSlots: using config id=%d", config.ID)
+		uc.logger.InfoContext(ctx, "GetAvailableSlots: using config id=%d", config.ID)
 	}
 
 	// 8. Валидация даты с учетом конфигурации
 	if err := validateDate(req.Date, now, config.AdvanceBookingDays); err != nil {
-		uc.logger.Warn("GetAvailableSlots: date validation failed: %v", err)
+		uc.logger.WarnContext(ctx, "GetAvailableSlots: date validation failed: %v", err)
 		return nil, err
 	}
 
 	// 9. Получаем рабочие часы на указанную дату
-	workingHours := getWorkingHoursForDay(company, req.Date)
+	workingHours := company.WorkingHours.ForDay(req.Date.Weekday())
 	if !workingHours.IsOpen {
-		uc.logger.Info("GetAvailableSlots: company is closed on %s", req.Date.Format(domain.DateFormat))
+		uc.logger.InfoContext(ctx, "GetAvailableSlots: company is closed on %s", req.Date.Format(domain.DateFormat))
 		return &Response{
 			Date:      req.Date,
 			CompanyID: req.CompanyID,
@@ -134,7 +134,7 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 		config.MinBookingNoticeMinutes,
 	)
 	if err != nil {
-		uc.logger.Error("GetAvailableSlots: failed to generate time slots: %v", err)
+		uc.logger.ErrorContext(ctx, "GetAvailableSlots: failed to generate time slots: %v", err)
 		return nil, fmt.Errorf("%w: failed to generate time slots: %v", ErrInternal, err)
 	}
 
@@ -149,8 +149,8 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 
 	bookings, err := uc.bookingRepo.GetByCompanyWithFilter(ctx, filter)
 	if err != nil {
-		uc.logger.Error("GetAvailableSlots: failed to get bookings: %v", err)
-		return nil, fmt.Errorf("%w: failed to get bookings: %v", ErrInternal, err)
+		uc.logger.ErrorContext(ctx, "GetAvailableSlots: failed to get bookings: %v", err)
+		return nil, fmt.Errorf("%w: failed to get bookings: %w", ErrInternal, err)
 	}
 
 	// 12. Вычисляем доступность для каждого слота
@@ -161,7 +161,7 @@ func (uc *UseCase) Execute(ctx context.Context, req *Request) (*Response, error)
 		config.MaxConcurrentBookings,
 	)
 
-	uc.logger.Info("GetAvailableSlots: generated %d slots for company=%d, address=%d, service=%d, date=%s",
+	uc.logger.InfoContext(ctx, "GetAvailableSlots: generated %d slots for company=%d, address=%d, service=%d, date=%s",
 		len(slots), req.CompanyID, req.AddressID, req.ServiceID, req.Date.Format(domain.DateFormat))
 
 	return &Response{
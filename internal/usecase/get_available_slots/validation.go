@@ -4,26 +4,33 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/m04kA/SMC-BookingService/internal/domain"
 	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
 )
 
+// Сообщения клиенту об ошибках полей запроса
+const (
+	msgInvalidID = "ID должен быть положительным"
+	msgRequired  = "обязательное поле"
+)
+
 // validateRequest валидирует входные данные запроса
 func validateRequest(req *Request) error {
 	if req.CompanyID <= 0 {
-		return fmt.Errorf("%w: companyID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "companyId", msgInvalidID)
 	}
 
 	if req.AddressID <= 0 {
-		return fmt.Errorf("%w: addressID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "addressId", msgInvalidID)
 	}
 
 	if req.ServiceID <= 0 {
-		return fmt.Errorf("%w: serviceID must be positive", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "serviceId", msgInvalidID)
 	}
 
 	// Проверяем, что дата не является нулевой
 	if req.Date.IsZero() {
-		return fmt.Errorf("%w: date is required", ErrInvalidInput)
+		return domain.InvalidField(ErrInvalidInput, "date", msgRequired)
 	}
 
 	return nil
@@ -0,0 +1,26 @@
+package car_enrichment
+
+import (
+	"context"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/integrations/userservice"
+)
+
+// BookingRepository интерфейс репозитория бронирований
+type BookingRepository interface {
+	GetPendingCarDetails(ctx context.Context, limit int) ([]*domain.Booking, error)
+	UpdateCarDetails(ctx context.Context, id int64, details domain.CarDetails) error
+}
+
+// UserServiceClient интерфейс клиента для UserService
+type UserServiceClient interface {
+	GetSelectedCar(ctx context.Context, tgUserID int64) (*userservice.Car, error)
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
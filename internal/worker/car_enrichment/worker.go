@@ -0,0 +1,138 @@
+package car_enrichment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	bookingRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/booking"
+	userClient "github.com/m04kA/SMC-BookingService/internal/integrations/userservice"
+	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+)
+
+// Worker фоновая задача дозаполнения данных автомобиля
+// Периодически выбирает бронирования, созданные при недоступном UserService (car_details_pending),
+// и записывает в них выбранный автомобиль пользователя. Автомобиль, указанный клиентом при создании,
+// не заменяется: его данные обновляются, только если он всё ещё выбран у пользователя
+type Worker struct {
+	bookingRepo BookingRepository
+	userClient  UserServiceClient
+	interval    time.Duration
+	batchSize   int
+	logger      Logger
+}
+
+// NewWorker создает новый экземпляр фоновой задачи
+func NewWorker(
+	bookingRepo BookingRepository,
+	userClient UserServiceClient,
+	interval time.Duration,
+	batchSize int,
+	logger Logger,
+) *Worker {
+	return &Worker{
+		bookingRepo: bookingRepo,
+		userClient:  userClient,
+		interval:    interval,
+		batchSize:   batchSize,
+		logger:      logger,
+	}
+}
+
+// Run запускает задачу и блокируется до отмены контекста
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("CarEnrichment: worker started (interval=%s, batch_size=%d)", w.interval, w.batchSize)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("CarEnrichment: worker stopped")
+			return
+		case <-ticker.C:
+			w.processBatch(ctx)
+		}
+	}
+}
+
+// processBatch обрабатывает одну пачку бронирований
+// Если UserService всё ещё недоступен, обработка пачки прекращается до следующего запуска
+func (w *Worker) processBatch(ctx context.Context) {
+	bookings, err := w.bookingRepo.GetPendingCarDetails(ctx, w.batchSize)
+	if err != nil {
+		w.logger.Error("CarEnrichment: failed to get pending bookings: %v", err)
+		return
+	}
+	if len(bookings) == 0 {
+		return
+	}
+
+	enriched := 0
+	for _, booking := range bookings {
+		if ctx.Err() != nil {
+			return
+		}
+
+		car, err := w.userClient.GetSelectedCar(ctx, booking.UserID)
+		if err != nil {
+			if errors.Is(err, userClient.ErrCarNotFound) {
+				// У пользователя больше нет выбранного автомобиля - дозаполнять нечем, снимаем флаг,
+				// сохраняя автомобиль из запроса
+				w.logger.Warn("CarEnrichment: user id=%d has no selected car, booking id=%d keeps stored car details",
+					booking.UserID, booking.ID)
+				w.updateCarDetails(ctx, booking.ID, storedCarDetails(booking))
+				continue
+			}
+
+			w.logger.Warn("CarEnrichment: UserService still unavailable, postponing enrichment: %v", err)
+			return
+		}
+
+		if booking.CarID != nil && *booking.CarID != car.ID {
+			// Выбранный автомобиль сменился: UserService отдает только его, данные автомобиля
+			// из запроса проверить нечем, оставляем их как есть
+			w.logger.Warn("CarEnrichment: car id=%d of booking id=%d is no longer selected, keeping stored car details",
+				*booking.CarID, booking.ID)
+			w.updateCarDetails(ctx, booking.ID, storedCarDetails(booking))
+			continue
+		}
+
+		if w.updateCarDetails(ctx, booking.ID, domain.CarDetails{
+			CarID:        ptr.Ptr(car.ID),
+			Brand:        ptr.Ptr(car.Brand),
+			Model:        ptr.Ptr(car.Model),
+			LicensePlate: ptr.Ptr(car.LicensePlate),
+		}) {
+			enriched++
+		}
+	}
+
+	w.logger.Info("CarEnrichment: enriched %d of %d pending bookings", enriched, len(bookings))
+}
+
+// storedCarDetails возвращает данные автомобиля, уже сохраненные в бронировании
+func storedCarDetails(booking *domain.Booking) domain.CarDetails {
+	return domain.CarDetails{
+		CarID:        booking.CarID,
+		Brand:        booking.CarBrand,
+		Model:        booking.CarModel,
+		LicensePlate: booking.CarLicensePlate,
+	}
+}
+
+// updateCarDetails сохраняет данные автомобиля, возвращает true при успехе
+func (w *Worker) updateCarDetails(ctx context.Context, bookingID int64, details domain.CarDetails) bool {
+	err := w.bookingRepo.UpdateCarDetails(ctx, bookingID, details)
+	if err != nil {
+		if errors.Is(err, bookingRepo.ErrBookingNotFound) {
+			// Бронирование уже дозаполнено другим экземпляром сервиса
+			return false
+		}
+		w.logger.Error("CarEnrichment: failed to update booking id=%d: %v", bookingID, err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package occupancy
+
+import (
+	"context"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/infra/bookingmetrics"
+	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+)
+
+// BookingRepository интерфейс репозитория бронирований
+type BookingRepository interface {
+	GetAddressLoad(ctx context.Context, startDate, endDate time.Time) ([]domain.AddressLoad, error)
+}
+
+// ConfigRepository интерфейс репозитория конфигурации слотов
+type ConfigRepository interface {
+	GetConfigWithHierarchy(ctx context.Context, companyID int64, addressID *int64, serviceID *int64) (*domain.CompanySlotsConfig, error)
+}
+
+// SellerServiceClient интерфейс клиента для SellerService
+type SellerServiceClient interface {
+	GetCompany(ctx context.Context, companyID int64) (*sellerservice.Company, error)
+}
+
+// Metrics запись загрузки слотов
+type Metrics interface {
+	RecordOccupancy(samples []bookingmetrics.OccupancySample)
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
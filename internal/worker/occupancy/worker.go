@@ -0,0 +1,173 @@
+package occupancy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/m04kA/SMC-BookingService/internal/domain"
+	"github.com/m04kA/SMC-BookingService/internal/infra/bookingmetrics"
+	configRepo "github.com/m04kA/SMC-BookingService/internal/infra/storage/config"
+	"github.com/m04kA/SMC-BookingService/internal/integrations/sellerservice"
+	"github.com/m04kA/SMC-BookingService/pkg/ptr"
+	"github.com/m04kA/SMC-BookingService/pkg/types"
+)
+
+// Worker фоновая задача расчета загрузки слотов на сегодня и завтра
+// Загрузка адреса - доля времени работы (с учетом одновременных бронирований), занятая активными бронированиями.
+// Считается для всех адресов компаний, у которых есть активные бронирования на эти дни
+type Worker struct {
+	bookingRepo  BookingRepository
+	configRepo   ConfigRepository
+	sellerClient SellerServiceClient
+	metrics      Metrics
+	interval     time.Duration
+	logger       Logger
+}
+
+// NewWorker создает новый экземпляр фоновой задачи
+func NewWorker(
+	bookingRepo BookingRepository,
+	configRepo ConfigRepository,
+	sellerClient SellerServiceClient,
+	metrics Metrics,
+	interval time.Duration,
+	logger Logger,
+) *Worker {
+	return &Worker{
+		bookingRepo:  bookingRepo,
+		configRepo:   configRepo,
+		sellerClient: sellerClient,
+		metrics:      metrics,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// Run запускает задачу и блокируется до отмены контекста
+// Первый расчет выполняется сразу после запуска
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("Occupancy: worker started (interval=%s)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.update(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Occupancy: worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// addressDay ключ загрузки адреса за день
+type addressDay struct {
+	addressID int64
+	date      string
+}
+
+// update пересчитывает загрузку и заменяет значения метрик
+// При ошибке чтения бронирований значения метрик сохраняются до следующего расчета
+func (w *Worker) update(ctx context.Context) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	days := []struct {
+		label string
+		date  time.Time
+	}{
+		{bookingmetrics.DayToday, today},
+		{bookingmetrics.DayTomorrow, today.AddDate(0, 0, 1)},
+	}
+
+	loads, err := w.bookingRepo.GetAddressLoad(ctx, today, today.AddDate(0, 0, 1))
+	if err != nil {
+		w.logger.Error("Occupancy: failed to get address load: %v", err)
+		return
+	}
+
+	// Занятые минуты по компаниям, адресам и дням
+	booked := make(map[int64]map[addressDay]int)
+	for _, load := range loads {
+		if booked[load.CompanyID] == nil {
+			booked[load.CompanyID] = make(map[addressDay]int)
+		}
+		booked[load.CompanyID][addressDay{load.AddressID, load.Date.Format(domain.DateFormat)}] += load.BookedMinutes
+	}
+
+	samples := make([]bookingmetrics.OccupancySample, 0, len(loads))
+	for companyID, companyBooked := range booked {
+		if ctx.Err() != nil {
+			return
+		}
+
+		company, err := w.sellerClient.GetCompany(ctx, companyID)
+		if err != nil {
+			w.logger.Warn("Occupancy: failed to get company id=%d, skipping: %v", companyID, err)
+			continue
+		}
+
+		for _, address := range company.Addresses {
+			maxConcurrent, err := w.maxConcurrentBookings(ctx, companyID, address.ID)
+			if err != nil {
+				w.logger.Warn("Occupancy: failed to get config for company id=%d, address id=%d, skipping: %v",
+					companyID, address.ID, err)
+				continue
+			}
+
+			for _, day := range days {
+				openMinutes := workingMinutes(company, day.date)
+				if openMinutes <= 0 {
+					continue
+				}
+				samples = append(samples, bookingmetrics.OccupancySample{
+					CompanyID:       companyID,
+					AddressID:       address.ID,
+					Day:             day.label,
+					BookedMinutes:   companyBooked[addressDay{address.ID, day.date.Format(domain.DateFormat)}],
+					CapacityMinutes: openMinutes * maxConcurrent,
+				})
+			}
+		}
+	}
+
+	w.metrics.RecordOccupancy(samples)
+}
+
+// maxConcurrentBookings число одновременных бронирований адреса (конфигурация адреса или компании)
+func (w *Worker) maxConcurrentBookings(ctx context.Context, companyID, addressID int64) (int, error) {
+	config, err := w.configRepo.GetConfigWithHierarchy(ctx, companyID, ptr.Ptr(addressID), nil)
+	if err != nil {
+		if errors.Is(err, configRepo.ErrConfigNotFound) {
+			return domain.DefaultMaxConcurrentBookings, nil
+		}
+		return 0, err
+	}
+	return config.MaxConcurrentBookings, nil
+}
+
+// workingMinutes время работы компании в указанный день (0, если закрыта)
+func workingMinutes(company *sellerservice.Company, date time.Time) int {
+	schedule := company.WorkingHours.ForDay(date.Weekday())
+	if !schedule.IsOpen || schedule.OpenTime == nil || schedule.CloseTime == nil {
+		return 0
+	}
+
+	openTime, err := types.NewTimeStringFromString(*schedule.OpenTime)
+	if err != nil {
+		return 0
+	}
+	closeTime, err := types.NewTimeStringFromString(*schedule.CloseTime)
+	if err != nil {
+		return 0
+	}
+
+	minutes, err := openTime.MinutesBetween(closeTime)
+	if err != nil {
+		return 0
+	}
+	return minutes
+}
@@ -0,0 +1,8 @@
+-- Откат миграции: данные автомобиля снова обязательны
+DROP INDEX IF EXISTS idx_bookings_car_details_pending;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS car_details_pending;
+
+-- Бронирования без автомобиля получают car_id = 0 (неизвестный автомобиль)
+UPDATE bookings SET car_id = 0 WHERE car_id IS NULL;
+ALTER TABLE bookings ALTER COLUMN car_id SET NOT NULL;
@@ -0,0 +1,14 @@
+-- Поддержка создания бронирования без данных автомобиля (graceful degradation при недоступности UserService)
+
+-- car_id становится необязательным: NULL, пока данные автомобиля не дозаполнены
+ALTER TABLE bookings ALTER COLUMN car_id DROP NOT NULL;
+
+-- Флаг бронирований, ожидающих дозаполнения данных автомобиля фоновой задачей
+ALTER TABLE bookings ADD COLUMN car_details_pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Частичный индекс для выборки бронирований, ожидающих дозаполнения
+CREATE INDEX idx_bookings_car_details_pending ON bookings(created_at)
+WHERE car_details_pending;
+
+COMMENT ON COLUMN bookings.car_id IS 'ID автомобиля из UserService (NULL, если UserService был недоступен при создании)';
+COMMENT ON COLUMN bookings.car_details_pending IS 'Данные автомобиля не получены при создании и ожидают дозаполнения';
@@ -0,0 +1,6 @@
+-- Откат миграции: удаление истории изменений бронирований
+DROP TRIGGER IF EXISTS tr_bookings_history ON bookings;
+
+DROP FUNCTION IF EXISTS log_booking_change();
+
+DROP TABLE IF EXISTS booking_history;
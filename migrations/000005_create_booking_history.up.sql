@@ -0,0 +1,81 @@
+-- История изменений бронирований (заполняется триггером при любом изменении строки bookings)
+
+CREATE TABLE IF NOT EXISTS booking_history (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- Без FK: история сохраняется и после удаления бронирования
+    booking_id BIGINT NOT NULL,
+
+    -- Операция над строкой: INSERT, UPDATE, DELETE
+    operation VARCHAR(10) NOT NULL,
+
+    -- Контекст изменения (задается приложением через set_config в транзакции, иначе NULL)
+    action VARCHAR(50),
+    actor_id BIGINT,
+    actor_role VARCHAR(30),
+    reason TEXT,
+
+    -- INSERT/DELETE: полная строка, UPDATE: {"столбец": {"old": ..., "new": ...}}
+    changes JSONB NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_booking_history_booking ON booking_history(booking_id, id);
+CREATE INDEX idx_booking_history_actor ON booking_history(actor_id, created_at DESC)
+WHERE actor_id IS NOT NULL;
+
+-- Функция записи изменения бронирования в историю
+-- Контекст читается из настроек транзакции bookingservice.action, bookingservice.actor_id,
+-- bookingservice.actor_role, bookingservice.reason (изменения через psql записываются без контекста)
+CREATE OR REPLACE FUNCTION log_booking_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    diff JSONB := '{}'::JSONB;
+    col TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        diff := to_jsonb(NEW);
+    ELSIF TG_OP = 'UPDATE' THEN
+        old_row := to_jsonb(OLD);
+        new_row := to_jsonb(NEW);
+        FOR col IN SELECT jsonb_object_keys(new_row) LOOP
+            IF col <> 'updated_at' AND old_row -> col IS DISTINCT FROM new_row -> col THEN
+                diff := diff || jsonb_build_object(col, jsonb_build_object('old', old_row -> col, 'new', new_row -> col));
+            END IF;
+        END LOOP;
+        -- Изменилось только updated_at - не записываем
+        IF diff = '{}'::JSONB THEN
+            RETURN NULL;
+        END IF;
+    ELSE
+        diff := to_jsonb(OLD);
+    END IF;
+
+    INSERT INTO booking_history (booking_id, operation, action, actor_id, actor_role, reason, changes)
+    VALUES (
+        CASE WHEN TG_OP = 'DELETE' THEN OLD.id ELSE NEW.id END,
+        TG_OP,
+        NULLIF(current_setting('bookingservice.action', true), ''),
+        NULLIF(current_setting('bookingservice.actor_id', true), '')::BIGINT,
+        NULLIF(current_setting('bookingservice.actor_role', true), ''),
+        NULLIF(current_setting('bookingservice.reason', true), ''),
+        diff
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tr_bookings_history
+    AFTER INSERT OR UPDATE OR DELETE ON bookings
+    FOR EACH ROW
+    EXECUTE FUNCTION log_booking_change();
+
+COMMENT ON TABLE booking_history IS 'История изменений бронирований (аудит действий поддержки и ручных правок)';
+COMMENT ON COLUMN booking_history.action IS 'Действие приложения, например admin_force_status (NULL для изменений вне приложения)';
+COMMENT ON COLUMN booking_history.actor_id IS 'Telegram ID пользователя, выполнившего изменение';
+COMMENT ON COLUMN booking_history.reason IS 'Причина изменения (обязательна для действий администратора)';
+COMMENT ON FUNCTION log_booking_change() IS 'Записывает изменения строки bookings в booking_history';
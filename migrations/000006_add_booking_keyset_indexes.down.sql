@@ -0,0 +1,3 @@
+-- Откат индексов keyset-пагинации
+DROP INDEX IF EXISTS idx_bookings_company_keyset;
+DROP INDEX IF EXISTS idx_bookings_user_keyset;
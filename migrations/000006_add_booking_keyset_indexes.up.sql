@@ -0,0 +1,7 @@
+-- Индексы для keyset-пагинации списков бронирований по (booking_date, start_time, id)
+
+-- История пользователя: GET /users/{userId}/bookings
+CREATE INDEX IF NOT EXISTS idx_bookings_user_keyset ON bookings(user_id, booking_date, start_time, id);
+
+-- Бронирования компании: GET /companies/{companyId}/bookings
+CREATE INDEX IF NOT EXISTS idx_bookings_company_keyset ON bookings(company_id, booking_date, start_time, id);
@@ -0,0 +1,5 @@
+-- Откат индексов поиска бронирований компании
+-- Расширение pg_trgm не удаляется: им могут пользоваться другие объекты БД
+DROP INDEX IF EXISTS idx_bookings_company_user_date;
+DROP INDEX IF EXISTS idx_bookings_company_service_date;
+DROP INDEX IF EXISTS idx_bookings_license_plate_trgm;
@@ -0,0 +1,13 @@
+-- Индексы для поиска бронирований компании по услуге, клиенту и госномеру
+
+-- Триграммы для поиска по части госномера без учета регистра (ILIKE '%...%')
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_license_plate_trgm ON bookings
+USING GIN (car_license_plate gin_trgm_ops);
+
+-- Бронирования компании на услугу за период
+CREATE INDEX IF NOT EXISTS idx_bookings_company_service_date ON bookings(company_id, service_id, booking_date);
+
+-- Бронирования клиента в компании
+CREATE INDEX IF NOT EXISTS idx_bookings_company_user_date ON bookings(company_id, user_id, booking_date);
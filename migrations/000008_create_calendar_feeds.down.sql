@@ -0,0 +1,2 @@
+-- Откат подписок на календарь
+DROP TABLE IF EXISTS calendar_feeds;
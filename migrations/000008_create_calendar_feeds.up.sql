@@ -0,0 +1,35 @@
+-- Токены подписки на календарь (.ics) бронирований пользователя или адреса компании
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- SHA-256 токена в hex: сам токен хранится только у владельца подписки
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+
+    -- Область подписки: user - бронирования пользователя, address - расписание адреса компании
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('user', 'address')),
+    user_id BIGINT,
+    company_id BIGINT,
+    address_id BIGINT,
+
+    -- Telegram ID пользователя, выпустившего токен
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP,
+
+    CONSTRAINT chk_calendar_feeds_scope_target CHECK (
+        (scope = 'user' AND user_id IS NOT NULL AND company_id IS NULL AND address_id IS NULL) OR
+        (scope = 'address' AND user_id IS NULL AND company_id IS NOT NULL AND address_id IS NOT NULL)
+    )
+);
+
+-- Не больше одного действующего токена на пользователя и на адрес (перевыпуск отзывает предыдущий)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_active_user ON calendar_feeds(user_id)
+WHERE scope = 'user' AND revoked_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_active_address ON calendar_feeds(company_id, address_id)
+WHERE scope = 'address' AND revoked_at IS NULL;
+
+COMMENT ON TABLE calendar_feeds IS 'Токены подписки на календарь бронирований (iCalendar, только чтение)';
+COMMENT ON COLUMN calendar_feeds.token_hash IS 'SHA-256 токена в hex';
+COMMENT ON COLUMN calendar_feeds.scope IS 'user - бронирования пользователя, address - расписание адреса компании';
+COMMENT ON COLUMN calendar_feeds.revoked_at IS 'Время отзыва токена (NULL - токен действует)';
@@ -0,0 +1,2 @@
+-- Откат роли пользователя, выпустившего токен подписки
+ALTER TABLE calendar_feeds DROP COLUMN IF EXISTS created_by_role;
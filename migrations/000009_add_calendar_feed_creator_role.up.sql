@@ -0,0 +1,6 @@
+-- Роль пользователя, выпустившего токен подписки: права на расписание адреса проверяются при каждом запросе календаря
+
+ALTER TABLE calendar_feeds
+    ADD COLUMN IF NOT EXISTS created_by_role VARCHAR(20) NOT NULL DEFAULT 'customer';
+
+COMMENT ON COLUMN calendar_feeds.created_by_role IS 'Роль пользователя при выпуске токена (проверка доступа к адресу вместе с членством в компании)';
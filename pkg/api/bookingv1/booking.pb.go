@@ -0,0 +1,1743 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: booking/v1/booking.proto
+
+// gRPC API SMC-BookingService для внутренних сервисов (backend Telegram бота, SellerService)
+//
+// Повторяет HTTP API (schemas/schema.yaml) и использует те же сервисы и use case:
+// даты передаются строками YYYY-MM-DD, время начала - HH:MM, статусы бронирований -
+// теми же строковыми значениями (pending, confirmed, in_progress, completed,
+// cancelled_by_user, cancelled_by_company, no_show).
+//
+// Аутентификация - metadata запроса с теми же учетными данными, что и в HTTP
+// (authorization: Bearer <token>; x-user-id/x-user-role только при dev_header_auth).
+// GetAvailableSlots и GetCompanyConfig доступны без аутентификации.
+//
+// Генерация Go кода: make proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Booking бронирование
+type Booking struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CompanyId       int64                  `protobuf:"varint,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId       int64                  `protobuf:"varint,4,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ServiceId       int64                  `protobuf:"varint,5,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	CarId           *int64                 `protobuf:"varint,6,opt,name=car_id,json=carId,proto3,oneof" json:"car_id,omitempty"`
+	BookingDate     string                 `protobuf:"bytes,7,opt,name=booking_date,json=bookingDate,proto3" json:"booking_date,omitempty"` // YYYY-MM-DD
+	StartTime       string                 `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`       // HH:MM
+	DurationMinutes int32                  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Status          string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Денормализованные данные
+	ServiceName        string                 `protobuf:"bytes,11,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServicePrice       float64                `protobuf:"fixed64,12,opt,name=service_price,json=servicePrice,proto3" json:"service_price,omitempty"`
+	CarBrand           *string                `protobuf:"bytes,13,opt,name=car_brand,json=carBrand,proto3,oneof" json:"car_brand,omitempty"`
+	CarModel           *string                `protobuf:"bytes,14,opt,name=car_model,json=carModel,proto3,oneof" json:"car_model,omitempty"`
+	CarLicensePlate    *string                `protobuf:"bytes,15,opt,name=car_license_plate,json=carLicensePlate,proto3,oneof" json:"car_license_plate,omitempty"`
+	Notes              *string                `protobuf:"bytes,16,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CarDetailsPending  bool                   `protobuf:"varint,17,opt,name=car_details_pending,json=carDetailsPending,proto3" json:"car_details_pending,omitempty"` // Данные автомобиля будут дозаполнены из UserService
+	CancellationReason *string                `protobuf:"bytes,18,opt,name=cancellation_reason,json=cancellationReason,proto3,oneof" json:"cancellation_reason,omitempty"`
+	CancelledAt        *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_booking_v1_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Booking) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Booking) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Booking) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *Booking) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *Booking) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *Booking) GetCarId() int64 {
+	if x != nil && x.CarId != nil {
+		return *x.CarId
+	}
+	return 0
+}
+
+func (x *Booking) GetBookingDate() string {
+	if x != nil {
+		return x.BookingDate
+	}
+	return ""
+}
+
+func (x *Booking) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Booking) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Booking) GetServicePrice() float64 {
+	if x != nil {
+		return x.ServicePrice
+	}
+	return 0
+}
+
+func (x *Booking) GetCarBrand() string {
+	if x != nil && x.CarBrand != nil {
+		return *x.CarBrand
+	}
+	return ""
+}
+
+func (x *Booking) GetCarModel() string {
+	if x != nil && x.CarModel != nil {
+		return *x.CarModel
+	}
+	return ""
+}
+
+func (x *Booking) GetCarLicensePlate() string {
+	if x != nil && x.CarLicensePlate != nil {
+		return *x.CarLicensePlate
+	}
+	return ""
+}
+
+func (x *Booking) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *Booking) GetCarDetailsPending() bool {
+	if x != nil {
+		return x.CarDetailsPending
+	}
+	return false
+}
+
+func (x *Booking) GetCancellationReason() string {
+	if x != nil && x.CancellationReason != nil {
+		return *x.CancellationReason
+	}
+	return ""
+}
+
+func (x *Booking) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Booking) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Booking) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Car данные автомобиля в запросе на создание бронирования
+type Car struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Brand         string                 `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	LicensePlate  string                 `protobuf:"bytes,4,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Car) Reset() {
+	*x = Car{}
+	mi := &file_booking_v1_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Car) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Car) ProtoMessage() {}
+
+func (x *Car) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Car.ProtoReflect.Descriptor instead.
+func (*Car) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Car) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Car) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Car) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Car) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+type CreateBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     int64                  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ServiceId     int64                  `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	BookingDate   string                 `protobuf:"bytes,4,opt,name=booking_date,json=bookingDate,proto3" json:"booking_date,omitempty"` // YYYY-MM-DD
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`       // HH:MM
+	Notes         *string                `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	Car           *Car                   `protobuf:"bytes,7,opt,name=car,proto3" json:"car,omitempty"` // Если не передан, берется выбранный автомобиль из UserService
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBookingRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetBookingDate() string {
+	if x != nil {
+		return x.BookingDate
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBookingRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type CancelBookingRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BookingId          int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CancellationReason string                 `protobuf:"bytes,2,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelBookingRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CancelBookingRequest) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{5}
+}
+
+type UpdateBookingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingStatusRequest) Reset() {
+	*x = UpdateBookingStatusRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateBookingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateBookingStatusRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *UpdateBookingStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateBookingStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingStatusResponse) Reset() {
+	*x = UpdateBookingStatusResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusResponse) ProtoMessage() {}
+
+func (x *UpdateBookingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{7}
+}
+
+// PageRequest параметры пагинации (как limit, cursor, sort в HTTP API)
+type PageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`        // 0 - размер страницы по умолчанию
+	Cursor        *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // next_cursor предыдущей страницы
+	Sort          *string                `protobuf:"bytes,3,opt,name=sort,proto3,oneof" json:"sort,omitempty"`     // asc или desc
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PageRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *PageRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+type ListUserBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	From          *string                `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"` // YYYY-MM-DD, включительно
+	To            *string                `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`     // YYYY-MM-DD, включительно
+	Page          *PageRequest           `protobuf:"bytes,5,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserBookingsRequest) Reset() {
+	*x = ListUserBookingsRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserBookingsRequest) ProtoMessage() {}
+
+func (x *ListUserBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListUserBookingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserBookingsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListUserBookingsRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *ListUserBookingsRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *ListUserBookingsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListCompanyBookingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId       int64                  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId       *int64                 `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3,oneof" json:"address_id,omitempty"`
+	ServiceId       *int64                 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3,oneof" json:"service_id,omitempty"`
+	CustomerId      *int64                 `protobuf:"varint,4,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	From            *string                `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`                         // YYYY-MM-DD, включительно
+	To              *string                `protobuf:"bytes,6,opt,name=to,proto3,oneof" json:"to,omitempty"`                             // YYYY-MM-DD, включительно
+	TimeFrom        *string                `protobuf:"bytes,7,opt,name=time_from,json=timeFrom,proto3,oneof" json:"time_from,omitempty"` // HH:MM, время начала не раньше
+	TimeTo          *string                `protobuf:"bytes,8,opt,name=time_to,json=timeTo,proto3,oneof" json:"time_to,omitempty"`       // HH:MM, время начала раньше
+	Statuses        []string               `protobuf:"bytes,9,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	LicensePlate    *string                `protobuf:"bytes,10,opt,name=license_plate,json=licensePlate,proto3,oneof" json:"license_plate,omitempty"`     // Часть госномера, без учета регистра
+	IncludeInactive bool                   `protobuf:"varint,11,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Включить отмененные бронирования
+	Page            *PageRequest           `protobuf:"bytes,12,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCompanyBookingsRequest) Reset() {
+	*x = ListCompanyBookingsRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyBookingsRequest) ProtoMessage() {}
+
+func (x *ListCompanyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListCompanyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCompanyBookingsRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *ListCompanyBookingsRequest) GetAddressId() int64 {
+	if x != nil && x.AddressId != nil {
+		return *x.AddressId
+	}
+	return 0
+}
+
+func (x *ListCompanyBookingsRequest) GetServiceId() int64 {
+	if x != nil && x.ServiceId != nil {
+		return *x.ServiceId
+	}
+	return 0
+}
+
+func (x *ListCompanyBookingsRequest) GetCustomerId() int64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+func (x *ListCompanyBookingsRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *ListCompanyBookingsRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *ListCompanyBookingsRequest) GetTimeFrom() string {
+	if x != nil && x.TimeFrom != nil {
+		return *x.TimeFrom
+	}
+	return ""
+}
+
+func (x *ListCompanyBookingsRequest) GetTimeTo() string {
+	if x != nil && x.TimeTo != nil {
+		return *x.TimeTo
+	}
+	return ""
+}
+
+func (x *ListCompanyBookingsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListCompanyBookingsRequest) GetLicensePlate() string {
+	if x != nil && x.LicensePlate != nil {
+		return *x.LicensePlate
+	}
+	return ""
+}
+
+func (x *ListCompanyBookingsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListCompanyBookingsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// BookingList страница бронирований
+type BookingList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Не задан - страница последняя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingList) Reset() {
+	*x = BookingList{}
+	mi := &file_booking_v1_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingList) ProtoMessage() {}
+
+func (x *BookingList) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingList.ProtoReflect.Descriptor instead.
+func (*BookingList) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookingList) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *BookingList) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type GetAvailableSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     int64                  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ServiceId     int64                  `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSlotsRequest) Reset() {
+	*x = GetAvailableSlotsRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSlotsRequest) ProtoMessage() {}
+
+func (x *GetAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAvailableSlotsRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *GetAvailableSlotsRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *GetAvailableSlotsRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *GetAvailableSlotsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type AvailableSlots struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	CompanyId     int64                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ServiceId     int64                  `protobuf:"varint,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Slots         []*Slot                `protobuf:"bytes,5,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailableSlots) Reset() {
+	*x = AvailableSlots{}
+	mi := &file_booking_v1_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableSlots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableSlots) ProtoMessage() {}
+
+func (x *AvailableSlots) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableSlots.ProtoReflect.Descriptor instead.
+func (*AvailableSlots) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AvailableSlots) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AvailableSlots) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *AvailableSlots) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *AvailableSlots) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *AvailableSlots) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type Slot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartTime       string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // HH:MM
+	DurationMinutes int32                  `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	AvailableSpots  int32                  `protobuf:"varint,3,opt,name=available_spots,json=availableSpots,proto3" json:"available_spots,omitempty"`
+	TotalSpots      int32                  `protobuf:"varint,4,opt,name=total_spots,json=totalSpots,proto3" json:"total_spots,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	mi := &file_booking_v1_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Slot) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Slot) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Slot) GetAvailableSpots() int32 {
+	if x != nil {
+		return x.AvailableSpots
+	}
+	return 0
+}
+
+func (x *Slot) GetTotalSpots() int32 {
+	if x != nil {
+		return x.TotalSpots
+	}
+	return 0
+}
+
+// SlotsConfig конфигурация слотов (id = 0 - значения по умолчанию, не сохранены)
+type SlotsConfig struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId               int64                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId               *int64                 `protobuf:"varint,3,opt,name=address_id,json=addressId,proto3,oneof" json:"address_id,omitempty"`
+	ServiceId               *int64                 `protobuf:"varint,4,opt,name=service_id,json=serviceId,proto3,oneof" json:"service_id,omitempty"`
+	SlotDurationMinutes     int32                  `protobuf:"varint,5,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	MaxConcurrentBookings   int32                  `protobuf:"varint,6,opt,name=max_concurrent_bookings,json=maxConcurrentBookings,proto3" json:"max_concurrent_bookings,omitempty"`
+	AdvanceBookingDays      int32                  `protobuf:"varint,7,opt,name=advance_booking_days,json=advanceBookingDays,proto3" json:"advance_booking_days,omitempty"`
+	MinBookingNoticeMinutes int32                  `protobuf:"varint,8,opt,name=min_booking_notice_minutes,json=minBookingNoticeMinutes,proto3" json:"min_booking_notice_minutes,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SlotsConfig) Reset() {
+	*x = SlotsConfig{}
+	mi := &file_booking_v1_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotsConfig) ProtoMessage() {}
+
+func (x *SlotsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotsConfig.ProtoReflect.Descriptor instead.
+func (*SlotsConfig) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SlotsConfig) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetAddressId() int64 {
+	if x != nil && x.AddressId != nil {
+		return *x.AddressId
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetServiceId() int64 {
+	if x != nil && x.ServiceId != nil {
+		return *x.ServiceId
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetMaxConcurrentBookings() int32 {
+	if x != nil {
+		return x.MaxConcurrentBookings
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetAdvanceBookingDays() int32 {
+	if x != nil {
+		return x.AdvanceBookingDays
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetMinBookingNoticeMinutes() int32 {
+	if x != nil {
+		return x.MinBookingNoticeMinutes
+	}
+	return 0
+}
+
+func (x *SlotsConfig) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SlotsConfig) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetCompanyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     int64                  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId     *int64                 `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3,oneof" json:"address_id,omitempty"`
+	ServiceId     *int64                 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3,oneof" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyConfigRequest) Reset() {
+	*x = GetCompanyConfigRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyConfigRequest) ProtoMessage() {}
+
+func (x *GetCompanyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCompanyConfigRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *GetCompanyConfigRequest) GetAddressId() int64 {
+	if x != nil && x.AddressId != nil {
+		return *x.AddressId
+	}
+	return 0
+}
+
+func (x *GetCompanyConfigRequest) GetServiceId() int64 {
+	if x != nil && x.ServiceId != nil {
+		return *x.ServiceId
+	}
+	return 0
+}
+
+// UpdateCompanyConfigRequest обновляются только переданные значения
+type UpdateCompanyConfigRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId               int64                  `protobuf:"varint,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId               *int64                 `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3,oneof" json:"address_id,omitempty"`
+	ServiceId               *int64                 `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3,oneof" json:"service_id,omitempty"`
+	SlotDurationMinutes     *int32                 `protobuf:"varint,4,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3,oneof" json:"slot_duration_minutes,omitempty"`
+	MaxConcurrentBookings   *int32                 `protobuf:"varint,5,opt,name=max_concurrent_bookings,json=maxConcurrentBookings,proto3,oneof" json:"max_concurrent_bookings,omitempty"`
+	AdvanceBookingDays      *int32                 `protobuf:"varint,6,opt,name=advance_booking_days,json=advanceBookingDays,proto3,oneof" json:"advance_booking_days,omitempty"`
+	MinBookingNoticeMinutes *int32                 `protobuf:"varint,7,opt,name=min_booking_notice_minutes,json=minBookingNoticeMinutes,proto3,oneof" json:"min_booking_notice_minutes,omitempty"`
+	Force                   bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"` // Применить несмотря на конфликты с существующими бронированиями
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyConfigRequest) Reset() {
+	*x = UpdateCompanyConfigRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyConfigRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateCompanyConfigRequest) GetCompanyId() int64 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetAddressId() int64 {
+	if x != nil && x.AddressId != nil {
+		return *x.AddressId
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetServiceId() int64 {
+	if x != nil && x.ServiceId != nil {
+		return *x.ServiceId
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetSlotDurationMinutes() int32 {
+	if x != nil && x.SlotDurationMinutes != nil {
+		return *x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetMaxConcurrentBookings() int32 {
+	if x != nil && x.MaxConcurrentBookings != nil {
+		return *x.MaxConcurrentBookings
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetAdvanceBookingDays() int32 {
+	if x != nil && x.AdvanceBookingDays != nil {
+		return *x.AdvanceBookingDays
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetMinBookingNoticeMinutes() int32 {
+	if x != nil && x.MinBookingNoticeMinutes != nil {
+		return *x.MinBookingNoticeMinutes
+	}
+	return 0
+}
+
+func (x *UpdateCompanyConfigRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+var File_booking_v1_booking_proto protoreflect.FileDescriptor
+
+const file_booking_v1_booking_proto_rawDesc = "" +
+	"\n" +
+	"\x18booking/v1/booking.proto\x12\x0esmc.booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\a\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\x03R\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x04 \x01(\x03R\taddressId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x05 \x01(\x03R\tserviceId\x12\x1a\n" +
+	"\x06car_id\x18\x06 \x01(\x03H\x00R\x05carId\x88\x01\x01\x12!\n" +
+	"\fbooking_date\x18\a \x01(\tR\vbookingDate\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\b \x01(\tR\tstartTime\x12)\n" +
+	"\x10duration_minutes\x18\t \x01(\x05R\x0fdurationMinutes\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12!\n" +
+	"\fservice_name\x18\v \x01(\tR\vserviceName\x12#\n" +
+	"\rservice_price\x18\f \x01(\x01R\fservicePrice\x12 \n" +
+	"\tcar_brand\x18\r \x01(\tH\x01R\bcarBrand\x88\x01\x01\x12 \n" +
+	"\tcar_model\x18\x0e \x01(\tH\x02R\bcarModel\x88\x01\x01\x12/\n" +
+	"\x11car_license_plate\x18\x0f \x01(\tH\x03R\x0fcarLicensePlate\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x10 \x01(\tH\x04R\x05notes\x88\x01\x01\x12.\n" +
+	"\x13car_details_pending\x18\x11 \x01(\bR\x11carDetailsPending\x124\n" +
+	"\x13cancellation_reason\x18\x12 \x01(\tH\x05R\x12cancellationReason\x88\x01\x01\x12=\n" +
+	"\fcancelled_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\t\n" +
+	"\a_car_idB\f\n" +
+	"\n" +
+	"_car_brandB\f\n" +
+	"\n" +
+	"_car_modelB\x14\n" +
+	"\x12_car_license_plateB\b\n" +
+	"\x06_notesB\x16\n" +
+	"\x14_cancellation_reason\"f\n" +
+	"\x03Car\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12#\n" +
+	"\rlicense_plate\x18\x04 \x01(\tR\flicensePlate\"\x81\x02\n" +
+	"\x14CreateBookingRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\x03R\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x03R\tserviceId\x12!\n" +
+	"\fbooking_date\x18\x04 \x01(\tR\vbookingDate\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x19\n" +
+	"\x05notes\x18\x06 \x01(\tH\x00R\x05notes\x88\x01\x01\x12%\n" +
+	"\x03car\x18\a \x01(\v2\x13.smc.booking.v1.CarR\x03carB\b\n" +
+	"\x06_notes\"2\n" +
+	"\x11GetBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\"f\n" +
+	"\x14CancelBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12/\n" +
+	"\x13cancellation_reason\x18\x02 \x01(\tR\x12cancellationReason\"\x17\n" +
+	"\x15CancelBookingResponse\"S\n" +
+	"\x1aUpdateBookingStatusRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x1d\n" +
+	"\x1bUpdateBookingStatusResponse\"m\n" +
+	"\vPageRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x00R\x06cursor\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\x03 \x01(\tH\x01R\x04sort\x88\x01\x01B\t\n" +
+	"\a_cursorB\a\n" +
+	"\x05_sort\"\xc9\x01\n" +
+	"\x17ListUserBookingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x03 \x01(\tH\x01R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x04 \x01(\tH\x02R\x02to\x88\x01\x01\x12/\n" +
+	"\x04page\x18\x05 \x01(\v2\x1b.smc.booking.v1.PageRequestR\x04pageB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xa3\x04\n" +
+	"\x1aListCompanyBookingsRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\x03R\tcompanyId\x12\"\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03H\x00R\taddressId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x03H\x01R\tserviceId\x88\x01\x01\x12$\n" +
+	"\vcustomer_id\x18\x04 \x01(\x03H\x02R\n" +
+	"customerId\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x05 \x01(\tH\x03R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x06 \x01(\tH\x04R\x02to\x88\x01\x01\x12 \n" +
+	"\ttime_from\x18\a \x01(\tH\x05R\btimeFrom\x88\x01\x01\x12\x1c\n" +
+	"\atime_to\x18\b \x01(\tH\x06R\x06timeTo\x88\x01\x01\x12\x1a\n" +
+	"\bstatuses\x18\t \x03(\tR\bstatuses\x12(\n" +
+	"\rlicense_plate\x18\n" +
+	" \x01(\tH\aR\flicensePlate\x88\x01\x01\x12)\n" +
+	"\x10include_inactive\x18\v \x01(\bR\x0fincludeInactive\x12/\n" +
+	"\x04page\x18\f \x01(\v2\x1b.smc.booking.v1.PageRequestR\x04pageB\r\n" +
+	"\v_address_idB\r\n" +
+	"\v_service_idB\x0e\n" +
+	"\f_customer_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\f\n" +
+	"\n" +
+	"_time_fromB\n" +
+	"\n" +
+	"\b_time_toB\x10\n" +
+	"\x0e_license_plate\"x\n" +
+	"\vBookingList\x123\n" +
+	"\bbookings\x18\x01 \x03(\v2\x17.smc.booking.v1.BookingR\bbookings\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\x8b\x01\n" +
+	"\x18GetAvailableSlotsRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\x03R\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x03R\tserviceId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\"\xad\x01\n" +
+	"\x0eAvailableSlots\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\x03R\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x03 \x01(\x03R\taddressId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\x03R\tserviceId\x12*\n" +
+	"\x05slots\x18\x05 \x03(\v2\x14.smc.booking.v1.SlotR\x05slots\"\x9a\x01\n" +
+	"\x04Slot\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12)\n" +
+	"\x10duration_minutes\x18\x02 \x01(\x05R\x0fdurationMinutes\x12'\n" +
+	"\x0favailable_spots\x18\x03 \x01(\x05R\x0eavailableSpots\x12\x1f\n" +
+	"\vtotal_spots\x18\x04 \x01(\x05R\n" +
+	"totalSpots\"\xf3\x03\n" +
+	"\vSlotsConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\x03R\tcompanyId\x12\"\n" +
+	"\n" +
+	"address_id\x18\x03 \x01(\x03H\x00R\taddressId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\x03H\x01R\tserviceId\x88\x01\x01\x122\n" +
+	"\x15slot_duration_minutes\x18\x05 \x01(\x05R\x13slotDurationMinutes\x126\n" +
+	"\x17max_concurrent_bookings\x18\x06 \x01(\x05R\x15maxConcurrentBookings\x120\n" +
+	"\x14advance_booking_days\x18\a \x01(\x05R\x12advanceBookingDays\x12;\n" +
+	"\x1amin_booking_notice_minutes\x18\b \x01(\x05R\x17minBookingNoticeMinutes\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_address_idB\r\n" +
+	"\v_service_id\"\x9e\x01\n" +
+	"\x17GetCompanyConfigRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\x03R\tcompanyId\x12\"\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03H\x00R\taddressId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x03H\x01R\tserviceId\x88\x01\x01B\r\n" +
+	"\v_address_idB\r\n" +
+	"\v_service_id\"\x94\x04\n" +
+	"\x1aUpdateCompanyConfigRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\x03R\tcompanyId\x12\"\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03H\x00R\taddressId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x03H\x01R\tserviceId\x88\x01\x01\x127\n" +
+	"\x15slot_duration_minutes\x18\x04 \x01(\x05H\x02R\x13slotDurationMinutes\x88\x01\x01\x12;\n" +
+	"\x17max_concurrent_bookings\x18\x05 \x01(\x05H\x03R\x15maxConcurrentBookings\x88\x01\x01\x125\n" +
+	"\x14advance_booking_days\x18\x06 \x01(\x05H\x04R\x12advanceBookingDays\x88\x01\x01\x12@\n" +
+	"\x1amin_booking_notice_minutes\x18\a \x01(\x05H\x05R\x17minBookingNoticeMinutes\x88\x01\x01\x12\x14\n" +
+	"\x05force\x18\b \x01(\bR\x05forceB\r\n" +
+	"\v_address_idB\r\n" +
+	"\v_service_idB\x18\n" +
+	"\x16_slot_duration_minutesB\x1a\n" +
+	"\x18_max_concurrent_bookingsB\x17\n" +
+	"\x15_advance_booking_daysB\x1d\n" +
+	"\x1b_min_booking_notice_minutes2\xb2\x04\n" +
+	"\x0eBookingService\x12N\n" +
+	"\rCreateBooking\x12$.smc.booking.v1.CreateBookingRequest\x1a\x17.smc.booking.v1.Booking\x12H\n" +
+	"\n" +
+	"GetBooking\x12!.smc.booking.v1.GetBookingRequest\x1a\x17.smc.booking.v1.Booking\x12\\\n" +
+	"\rCancelBooking\x12$.smc.booking.v1.CancelBookingRequest\x1a%.smc.booking.v1.CancelBookingResponse\x12n\n" +
+	"\x13UpdateBookingStatus\x12*.smc.booking.v1.UpdateBookingStatusRequest\x1a+.smc.booking.v1.UpdateBookingStatusResponse\x12X\n" +
+	"\x10ListUserBookings\x12'.smc.booking.v1.ListUserBookingsRequest\x1a\x1b.smc.booking.v1.BookingList\x12^\n" +
+	"\x13ListCompanyBookings\x12*.smc.booking.v1.ListCompanyBookingsRequest\x1a\x1b.smc.booking.v1.BookingList2l\n" +
+	"\vSlotService\x12]\n" +
+	"\x11GetAvailableSlots\x12(.smc.booking.v1.GetAvailableSlotsRequest\x1a\x1e.smc.booking.v1.AvailableSlots2\xc9\x01\n" +
+	"\rConfigService\x12X\n" +
+	"\x10GetCompanyConfig\x12'.smc.booking.v1.GetCompanyConfigRequest\x1a\x1b.smc.booking.v1.SlotsConfig\x12^\n" +
+	"\x13UpdateCompanyConfig\x12*.smc.booking.v1.UpdateCompanyConfigRequest\x1a\x1b.smc.booking.v1.SlotsConfigBAZ?github.com/m04kA/SMC-BookingService/pkg/api/bookingv1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_booking_proto_rawDescOnce sync.Once
+	file_booking_v1_booking_proto_rawDescData []byte
+)
+
+func file_booking_v1_booking_proto_rawDescGZIP() []byte {
+	file_booking_v1_booking_proto_rawDescOnce.Do(func() {
+		file_booking_v1_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_booking_proto_rawDesc), len(file_booking_v1_booking_proto_rawDesc)))
+	})
+	return file_booking_v1_booking_proto_rawDescData
+}
+
+var file_booking_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_booking_v1_booking_proto_goTypes = []any{
+	(*Booking)(nil),                     // 0: smc.booking.v1.Booking
+	(*Car)(nil),                         // 1: smc.booking.v1.Car
+	(*CreateBookingRequest)(nil),        // 2: smc.booking.v1.CreateBookingRequest
+	(*GetBookingRequest)(nil),           // 3: smc.booking.v1.GetBookingRequest
+	(*CancelBookingRequest)(nil),        // 4: smc.booking.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),       // 5: smc.booking.v1.CancelBookingResponse
+	(*UpdateBookingStatusRequest)(nil),  // 6: smc.booking.v1.UpdateBookingStatusRequest
+	(*UpdateBookingStatusResponse)(nil), // 7: smc.booking.v1.UpdateBookingStatusResponse
+	(*PageRequest)(nil),                 // 8: smc.booking.v1.PageRequest
+	(*ListUserBookingsRequest)(nil),     // 9: smc.booking.v1.ListUserBookingsRequest
+	(*ListCompanyBookingsRequest)(nil),  // 10: smc.booking.v1.ListCompanyBookingsRequest
+	(*BookingList)(nil),                 // 11: smc.booking.v1.BookingList
+	(*GetAvailableSlotsRequest)(nil),    // 12: smc.booking.v1.GetAvailableSlotsRequest
+	(*AvailableSlots)(nil),              // 13: smc.booking.v1.AvailableSlots
+	(*Slot)(nil),                        // 14: smc.booking.v1.Slot
+	(*SlotsConfig)(nil),                 // 15: smc.booking.v1.SlotsConfig
+	(*GetCompanyConfigRequest)(nil),     // 16: smc.booking.v1.GetCompanyConfigRequest
+	(*UpdateCompanyConfigRequest)(nil),  // 17: smc.booking.v1.UpdateCompanyConfigRequest
+	(*timestamppb.Timestamp)(nil),       // 18: google.protobuf.Timestamp
+}
+var file_booking_v1_booking_proto_depIdxs = []int32{
+	18, // 0: smc.booking.v1.Booking.cancelled_at:type_name -> google.protobuf.Timestamp
+	18, // 1: smc.booking.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	18, // 2: smc.booking.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: smc.booking.v1.CreateBookingRequest.car:type_name -> smc.booking.v1.Car
+	8,  // 4: smc.booking.v1.ListUserBookingsRequest.page:type_name -> smc.booking.v1.PageRequest
+	8,  // 5: smc.booking.v1.ListCompanyBookingsRequest.page:type_name -> smc.booking.v1.PageRequest
+	0,  // 6: smc.booking.v1.BookingList.bookings:type_name -> smc.booking.v1.Booking
+	14, // 7: smc.booking.v1.AvailableSlots.slots:type_name -> smc.booking.v1.Slot
+	18, // 8: smc.booking.v1.SlotsConfig.created_at:type_name -> google.protobuf.Timestamp
+	18, // 9: smc.booking.v1.SlotsConfig.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 10: smc.booking.v1.BookingService.CreateBooking:input_type -> smc.booking.v1.CreateBookingRequest
+	3,  // 11: smc.booking.v1.BookingService.GetBooking:input_type -> smc.booking.v1.GetBookingRequest
+	4,  // 12: smc.booking.v1.BookingService.CancelBooking:input_type -> smc.booking.v1.CancelBookingRequest
+	6,  // 13: smc.booking.v1.BookingService.UpdateBookingStatus:input_type -> smc.booking.v1.UpdateBookingStatusRequest
+	9,  // 14: smc.booking.v1.BookingService.ListUserBookings:input_type -> smc.booking.v1.ListUserBookingsRequest
+	10, // 15: smc.booking.v1.BookingService.ListCompanyBookings:input_type -> smc.booking.v1.ListCompanyBookingsRequest
+	12, // 16: smc.booking.v1.SlotService.GetAvailableSlots:input_type -> smc.booking.v1.GetAvailableSlotsRequest
+	16, // 17: smc.booking.v1.ConfigService.GetCompanyConfig:input_type -> smc.booking.v1.GetCompanyConfigRequest
+	17, // 18: smc.booking.v1.ConfigService.UpdateCompanyConfig:input_type -> smc.booking.v1.UpdateCompanyConfigRequest
+	0,  // 19: smc.booking.v1.BookingService.CreateBooking:output_type -> smc.booking.v1.Booking
+	0,  // 20: smc.booking.v1.BookingService.GetBooking:output_type -> smc.booking.v1.Booking
+	5,  // 21: smc.booking.v1.BookingService.CancelBooking:output_type -> smc.booking.v1.CancelBookingResponse
+	7,  // 22: smc.booking.v1.BookingService.UpdateBookingStatus:output_type -> smc.booking.v1.UpdateBookingStatusResponse
+	11, // 23: smc.booking.v1.BookingService.ListUserBookings:output_type -> smc.booking.v1.BookingList
+	11, // 24: smc.booking.v1.BookingService.ListCompanyBookings:output_type -> smc.booking.v1.BookingList
+	13, // 25: smc.booking.v1.SlotService.GetAvailableSlots:output_type -> smc.booking.v1.AvailableSlots
+	15, // 26: smc.booking.v1.ConfigService.GetCompanyConfig:output_type -> smc.booking.v1.SlotsConfig
+	15, // 27: smc.booking.v1.ConfigService.UpdateCompanyConfig:output_type -> smc.booking.v1.SlotsConfig
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_booking_proto_init() }
+func file_booking_v1_booking_proto_init() {
+	if File_booking_v1_booking_proto != nil {
+		return
+	}
+	file_booking_v1_booking_proto_msgTypes[0].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[2].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[8].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[9].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[10].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[11].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[15].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[16].OneofWrappers = []any{}
+	file_booking_v1_booking_proto_msgTypes[17].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_booking_proto_rawDesc), len(file_booking_v1_booking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_booking_v1_booking_proto_goTypes,
+		DependencyIndexes: file_booking_v1_booking_proto_depIdxs,
+		MessageInfos:      file_booking_v1_booking_proto_msgTypes,
+	}.Build()
+	File_booking_v1_booking_proto = out.File
+	file_booking_v1_booking_proto_goTypes = nil
+	file_booking_v1_booking_proto_depIdxs = nil
+}
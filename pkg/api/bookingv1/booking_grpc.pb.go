@@ -0,0 +1,596 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: booking/v1/booking.proto
+
+// gRPC API SMC-BookingService для внутренних сервисов (backend Telegram бота, SellerService)
+//
+// Повторяет HTTP API (schemas/schema.yaml) и использует те же сервисы и use case:
+// даты передаются строками YYYY-MM-DD, время начала - HH:MM, статусы бронирований -
+// теми же строковыми значениями (pending, confirmed, in_progress, completed,
+// cancelled_by_user, cancelled_by_company, no_show).
+//
+// Аутентификация - metadata запроса с теми же учетными данными, что и в HTTP
+// (authorization: Bearer <token>; x-user-id/x-user-role только при dev_header_auth).
+// GetAvailableSlots и GetCompanyConfig доступны без аутентификации.
+//
+// Генерация Go кода: make proto
+
+package bookingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BookingService_CreateBooking_FullMethodName       = "/smc.booking.v1.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName          = "/smc.booking.v1.BookingService/GetBooking"
+	BookingService_CancelBooking_FullMethodName       = "/smc.booking.v1.BookingService/CancelBooking"
+	BookingService_UpdateBookingStatus_FullMethodName = "/smc.booking.v1.BookingService/UpdateBookingStatus"
+	BookingService_ListUserBookings_FullMethodName    = "/smc.booking.v1.BookingService/ListUserBookings"
+	BookingService_ListCompanyBookings_FullMethodName = "/smc.booking.v1.BookingService/ListCompanyBookings"
+)
+
+// BookingServiceClient is the client API for BookingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BookingService бронирования
+type BookingServiceClient interface {
+	// Создание бронирования от имени авторизованного пользователя
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Получение бронирования (владелец, менеджер/оператор компании, администратор)
+	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Отмена бронирования
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// Изменение статуса бронирования (менеджеры и операторы компании)
+	UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error)
+	// История бронирований пользователя (сам пользователь или администратор)
+	ListUserBookings(ctx context.Context, in *ListUserBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
+	// Бронирования компании с фильтрами (менеджеры и операторы компании)
+	ListCompanyBookings(ctx context.Context, in *ListCompanyBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
+}
+
+type bookingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingServiceClient(cc grpc.ClientConnInterface) BookingServiceClient {
+	return &bookingServiceClient{cc}
+}
+
+func (c *bookingServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBookingStatusResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateBookingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListUserBookings(ctx context.Context, in *ListUserBookingsRequest, opts ...grpc.CallOption) (*BookingList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingList)
+	err := c.cc.Invoke(ctx, BookingService_ListUserBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListCompanyBookings(ctx context.Context, in *ListCompanyBookingsRequest, opts ...grpc.CallOption) (*BookingList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingList)
+	err := c.cc.Invoke(ctx, BookingService_ListCompanyBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServiceServer is the server API for BookingService service.
+// All implementations must embed UnimplementedBookingServiceServer
+// for forward compatibility.
+//
+// BookingService бронирования
+type BookingServiceServer interface {
+	// Создание бронирования от имени авторизованного пользователя
+	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
+	// Получение бронирования (владелец, менеджер/оператор компании, администратор)
+	GetBooking(context.Context, *GetBookingRequest) (*Booking, error)
+	// Отмена бронирования
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// Изменение статуса бронирования (менеджеры и операторы компании)
+	UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error)
+	// История бронирований пользователя (сам пользователь или администратор)
+	ListUserBookings(context.Context, *ListUserBookingsRequest) (*BookingList, error)
+	// Бронирования компании с фильтрами (менеджеры и операторы компании)
+	ListCompanyBookings(context.Context, *ListCompanyBookingsRequest) (*BookingList, error)
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+// UnimplementedBookingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingServiceServer struct{}
+
+func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookingStatus not implemented")
+}
+func (UnimplementedBookingServiceServer) ListUserBookings(context.Context, *ListUserBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) ListCompanyBookings(context.Context, *ListCompanyBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompanyBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
+func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingServiceServer will
+// result in compilation errors.
+type UnsafeBookingServiceServer interface {
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingService_ServiceDesc, srv)
+}
+
+func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBookingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, req.(*UpdateBookingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListUserBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListUserBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListUserBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListUserBookings(ctx, req.(*ListUserBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListCompanyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompanyBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListCompanyBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListCompanyBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListCompanyBookings(ctx, req.(*ListCompanyBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smc.booking.v1.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBooking",
+			Handler:    _BookingService_CreateBooking_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _BookingService_GetBooking_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _BookingService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "UpdateBookingStatus",
+			Handler:    _BookingService_UpdateBookingStatus_Handler,
+		},
+		{
+			MethodName: "ListUserBookings",
+			Handler:    _BookingService_ListUserBookings_Handler,
+		},
+		{
+			MethodName: "ListCompanyBookings",
+			Handler:    _BookingService_ListCompanyBookings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking/v1/booking.proto",
+}
+
+const (
+	SlotService_GetAvailableSlots_FullMethodName = "/smc.booking.v1.SlotService/GetAvailableSlots"
+)
+
+// SlotServiceClient is the client API for SlotService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SlotService доступные слоты
+type SlotServiceClient interface {
+	// Доступные слоты адреса компании для услуги на дату
+	GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*AvailableSlots, error)
+}
+
+type slotServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlotServiceClient(cc grpc.ClientConnInterface) SlotServiceClient {
+	return &slotServiceClient{cc}
+}
+
+func (c *slotServiceClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*AvailableSlots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailableSlots)
+	err := c.cc.Invoke(ctx, SlotService_GetAvailableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlotServiceServer is the server API for SlotService service.
+// All implementations must embed UnimplementedSlotServiceServer
+// for forward compatibility.
+//
+// SlotService доступные слоты
+type SlotServiceServer interface {
+	// Доступные слоты адреса компании для услуги на дату
+	GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*AvailableSlots, error)
+	mustEmbedUnimplementedSlotServiceServer()
+}
+
+// UnimplementedSlotServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSlotServiceServer struct{}
+
+func (UnimplementedSlotServiceServer) GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*AvailableSlots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableSlots not implemented")
+}
+func (UnimplementedSlotServiceServer) mustEmbedUnimplementedSlotServiceServer() {}
+func (UnimplementedSlotServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSlotServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SlotServiceServer will
+// result in compilation errors.
+type UnsafeSlotServiceServer interface {
+	mustEmbedUnimplementedSlotServiceServer()
+}
+
+func RegisterSlotServiceServer(s grpc.ServiceRegistrar, srv SlotServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSlotServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SlotService_ServiceDesc, srv)
+}
+
+func _SlotService_GetAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlotServiceServer).GetAvailableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SlotService_GetAvailableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlotServiceServer).GetAvailableSlots(ctx, req.(*GetAvailableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SlotService_ServiceDesc is the grpc.ServiceDesc for SlotService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SlotService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smc.booking.v1.SlotService",
+	HandlerType: (*SlotServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAvailableSlots",
+			Handler:    _SlotService_GetAvailableSlots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking/v1/booking.proto",
+}
+
+const (
+	ConfigService_GetCompanyConfig_FullMethodName    = "/smc.booking.v1.ConfigService/GetCompanyConfig"
+	ConfigService_UpdateCompanyConfig_FullMethodName = "/smc.booking.v1.ConfigService/UpdateCompanyConfig"
+)
+
+// ConfigServiceClient is the client API for ConfigService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConfigService конфигурация слотов компании
+type ConfigServiceClient interface {
+	// Конфигурация с иерархическим поиском (значения по умолчанию, если не настроена)
+	GetCompanyConfig(ctx context.Context, in *GetCompanyConfigRequest, opts ...grpc.CallOption) (*SlotsConfig, error)
+	// Обновление конфигурации (менеджеры компании)
+	UpdateCompanyConfig(ctx context.Context, in *UpdateCompanyConfigRequest, opts ...grpc.CallOption) (*SlotsConfig, error)
+}
+
+type configServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigServiceClient(cc grpc.ClientConnInterface) ConfigServiceClient {
+	return &configServiceClient{cc}
+}
+
+func (c *configServiceClient) GetCompanyConfig(ctx context.Context, in *GetCompanyConfigRequest, opts ...grpc.CallOption) (*SlotsConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotsConfig)
+	err := c.cc.Invoke(ctx, ConfigService_GetCompanyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) UpdateCompanyConfig(ctx context.Context, in *UpdateCompanyConfigRequest, opts ...grpc.CallOption) (*SlotsConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotsConfig)
+	err := c.cc.Invoke(ctx, ConfigService_UpdateCompanyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigServiceServer is the server API for ConfigService service.
+// All implementations must embed UnimplementedConfigServiceServer
+// for forward compatibility.
+//
+// ConfigService конфигурация слотов компании
+type ConfigServiceServer interface {
+	// Конфигурация с иерархическим поиском (значения по умолчанию, если не настроена)
+	GetCompanyConfig(context.Context, *GetCompanyConfigRequest) (*SlotsConfig, error)
+	// Обновление конфигурации (менеджеры компании)
+	UpdateCompanyConfig(context.Context, *UpdateCompanyConfigRequest) (*SlotsConfig, error)
+	mustEmbedUnimplementedConfigServiceServer()
+}
+
+// UnimplementedConfigServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConfigServiceServer struct{}
+
+func (UnimplementedConfigServiceServer) GetCompanyConfig(context.Context, *GetCompanyConfigRequest) (*SlotsConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) UpdateCompanyConfig(context.Context, *UpdateCompanyConfigRequest) (*SlotsConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompanyConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) mustEmbedUnimplementedConfigServiceServer() {}
+func (UnimplementedConfigServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeConfigServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigServiceServer will
+// result in compilation errors.
+type UnsafeConfigServiceServer interface {
+	mustEmbedUnimplementedConfigServiceServer()
+}
+
+func RegisterConfigServiceServer(s grpc.ServiceRegistrar, srv ConfigServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConfigServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConfigService_ServiceDesc, srv)
+}
+
+func _ConfigService_GetCompanyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).GetCompanyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_GetCompanyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).GetCompanyConfig(ctx, req.(*GetCompanyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_UpdateCompanyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).UpdateCompanyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_UpdateCompanyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).UpdateCompanyConfig(ctx, req.(*UpdateCompanyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConfigService_ServiceDesc is the grpc.ServiceDesc for ConfigService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smc.booking.v1.ConfigService",
+	HandlerType: (*ConfigServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCompanyConfig",
+			Handler:    _ConfigService_GetCompanyConfig_Handler,
+		},
+		{
+			MethodName: "UpdateCompanyConfig",
+			Handler:    _ConfigService_UpdateCompanyConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking/v1/booking.proto",
+}
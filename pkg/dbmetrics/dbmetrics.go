@@ -30,18 +30,21 @@ func Wrap(db *sql.DB, metrics *metrics.Metrics, serviceName string) *DB {
 func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	start := time.Now()
 	operation, table := parseQuery(query)
+	ctx, span := startSpan(ctx, operation, table)
+	defer span.End()
 
 	rows, err := db.DB.QueryContext(ctx, query, args...)
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		db.metrics.RecordDBQuery(db.serviceName, operation, table, "error", duration)
-		db.metrics.RecordDBError(db.serviceName, operation, table, categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(db.metrics, db.serviceName, operation, table, "error", duration)
+		recordError(db.metrics, db.serviceName, operation, table, categorizeDBError(err))
 		return nil, err
 	}
 
-	db.metrics.RecordDBQuery(db.serviceName, operation, table, "success", duration)
+	recordQuery(db.metrics, db.serviceName, operation, table, "success", duration)
 	return rows, nil
 }
 
@@ -49,13 +52,15 @@ func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{
 func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
 	start := time.Now()
 	operation, table := parseQuery(query)
+	ctx, span := startSpan(ctx, operation, table)
+	defer span.End()
 
 	row := db.DB.QueryRowContext(ctx, query, args...)
 
 	duration := time.Since(start).Seconds()
 
 	// Для QueryRow успех определяется при Scan(), поэтому записываем только время
-	db.metrics.RecordDBQuery(db.serviceName, operation, table, "success", duration)
+	recordQuery(db.metrics, db.serviceName, operation, table, "success", duration)
 
 	return row
 }
@@ -64,39 +69,46 @@ func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interfa
 func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
 	start := time.Now()
 	operation, table := parseQuery(query)
+	ctx, span := startSpan(ctx, operation, table)
+	defer span.End()
 
 	result, err := db.DB.ExecContext(ctx, query, args...)
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		db.metrics.RecordDBQuery(db.serviceName, operation, table, "error", duration)
-		db.metrics.RecordDBError(db.serviceName, operation, table, categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(db.metrics, db.serviceName, operation, table, "error", duration)
+		recordError(db.metrics, db.serviceName, operation, table, categorizeDBError(err))
 		return nil, err
 	}
 
-	db.metrics.RecordDBQuery(db.serviceName, operation, table, "success", duration)
+	recordQuery(db.metrics, db.serviceName, operation, table, "success", duration)
 	return result, nil
 }
 
 // BeginTx начинает транзакцию с контекстом и сбором метрик
 func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (TxExecutor, error) {
 	start := time.Now()
+	spanCtx, span := startSpan(ctx, "begin_tx", "transaction")
+	defer span.End()
 
-	tx, err := db.DB.BeginTx(ctx, opts)
+	tx, err := db.DB.BeginTx(spanCtx, opts)
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		db.metrics.RecordDBQuery(db.serviceName, "begin_tx", "transaction", "error", duration)
-		db.metrics.RecordDBError(db.serviceName, "begin_tx", "transaction", categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(db.metrics, db.serviceName, "begin_tx", "transaction", "error", duration)
+		recordError(db.metrics, db.serviceName, "begin_tx", "transaction", categorizeDBError(err))
 		return nil, err
 	}
 
-	db.metrics.RecordDBQuery(db.serviceName, "begin_tx", "transaction", "success", duration)
+	recordQuery(db.metrics, db.serviceName, "begin_tx", "transaction", "success", duration)
 
 	return &Tx{
 		Tx:          tx,
+		ctx:         ctx,
 		metrics:     db.metrics,
 		serviceName: db.serviceName,
 	}, nil
@@ -115,6 +127,8 @@ func (db *DB) UpdateConnectionStats() {
 // Tx обёртка над *sql.Tx с поддержкой метрик
 type Tx struct {
 	*sql.Tx
+	// ctx контекст BeginTx: Commit и Rollback не принимают контекст, их спаны продолжают трассу запроса
+	ctx         context.Context
 	metrics     *metrics.Metrics
 	serviceName string
 }
@@ -123,18 +137,21 @@ type Tx struct {
 func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
 	start := time.Now()
 	operation, table := parseQuery(query)
+	ctx, span := startSpan(ctx, operation, table)
+	defer span.End()
 
 	rows, err := tx.Tx.QueryContext(ctx, query, args...)
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		tx.metrics.RecordDBQuery(tx.serviceName, operation, table, "error", duration)
-		tx.metrics.RecordDBError(tx.serviceName, operation, table, categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(tx.metrics, tx.serviceName, operation, table, "error", duration)
+		recordError(tx.metrics, tx.serviceName, operation, table, categorizeDBError(err))
 		return nil, err
 	}
 
-	tx.metrics.RecordDBQuery(tx.serviceName, operation, table, "success", duration)
+	recordQuery(tx.metrics, tx.serviceName, operation, table, "success", duration)
 	return rows, nil
 }
 
@@ -142,12 +159,14 @@ func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{
 func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
 	start := time.Now()
 	operation, table := parseQuery(query)
+	ctx, span := startSpan(ctx, operation, table)
+	defer span.End()
 
 	row := tx.Tx.QueryRowContext(ctx, query, args...)
 
 	duration := time.Since(start).Seconds()
 
-	tx.metrics.RecordDBQuery(tx.serviceName, operation, table, "success", duration)
+	recordQuery(tx.metrics, tx.serviceName, operation, table, "success", duration)
 
 	return row
 }
@@ -156,54 +175,63 @@ func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...interfa
 func (tx *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
 	start := time.Now()
 	operation, table := parseQuery(query)
+	ctx, span := startSpan(ctx, operation, table)
+	defer span.End()
 
 	result, err := tx.Tx.ExecContext(ctx, query, args...)
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		tx.metrics.RecordDBQuery(tx.serviceName, operation, table, "error", duration)
-		tx.metrics.RecordDBError(tx.serviceName, operation, table, categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(tx.metrics, tx.serviceName, operation, table, "error", duration)
+		recordError(tx.metrics, tx.serviceName, operation, table, categorizeDBError(err))
 		return nil, err
 	}
 
-	tx.metrics.RecordDBQuery(tx.serviceName, operation, table, "success", duration)
+	recordQuery(tx.metrics, tx.serviceName, operation, table, "success", duration)
 	return result, nil
 }
 
 // Commit фиксирует транзакцию с метриками
 func (tx *Tx) Commit() error {
 	start := time.Now()
+	_, span := startSpan(tx.ctx, "commit", "transaction")
+	defer span.End()
 
 	err := tx.Tx.Commit()
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		tx.metrics.RecordDBQuery(tx.serviceName, "commit", "transaction", "error", duration)
-		tx.metrics.RecordDBError(tx.serviceName, "commit", "transaction", categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(tx.metrics, tx.serviceName, "commit", "transaction", "error", duration)
+		recordError(tx.metrics, tx.serviceName, "commit", "transaction", categorizeDBError(err))
 		return err
 	}
 
-	tx.metrics.RecordDBQuery(tx.serviceName, "commit", "transaction", "success", duration)
+	recordQuery(tx.metrics, tx.serviceName, "commit", "transaction", "success", duration)
 	return nil
 }
 
 // Rollback откатывает транзакцию с метриками
 func (tx *Tx) Rollback() error {
 	start := time.Now()
+	_, span := startSpan(tx.ctx, "rollback", "transaction")
+	defer span.End()
 
 	err := tx.Tx.Rollback()
 
 	duration := time.Since(start).Seconds()
 
 	if err != nil {
-		tx.metrics.RecordDBQuery(tx.serviceName, "rollback", "transaction", "error", duration)
-		tx.metrics.RecordDBError(tx.serviceName, "rollback", "transaction", categorizeDBError(err))
+		spanError(span, err)
+		recordQuery(tx.metrics, tx.serviceName, "rollback", "transaction", "error", duration)
+		recordError(tx.metrics, tx.serviceName, "rollback", "transaction", categorizeDBError(err))
 		return err
 	}
 
-	tx.metrics.RecordDBQuery(tx.serviceName, "rollback", "transaction", "success", duration)
+	recordQuery(tx.metrics, tx.serviceName, "rollback", "transaction", "success", duration)
 	return nil
 }
 
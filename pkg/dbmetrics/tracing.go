@@ -0,0 +1,44 @@
+package dbmetrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/m04kA/SMC-BookingService/pkg/metrics"
+	"github.com/m04kA/SMC-BookingService/pkg/tracing"
+)
+
+// tracerName имя трассировщика спанов запросов к БД
+const tracerName = "dbmetrics"
+
+// startSpan начинает спан запроса: "db.select bookings", "db.commit transaction"
+// Спан QueryRowContext завершается до Scan, поэтому ошибки Scan (в т.ч. sql.ErrNoRows) в нем не отмечаются
+func startSpan(ctx context.Context, operation, table string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, tracerName, "db."+operation+" "+table,
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", operation),
+		attribute.String("db.sql.table", table),
+	)
+}
+
+// spanError отмечает ошибку запроса в спане с категорией, как в метриках
+func spanError(span trace.Span, err error) {
+	span.SetAttributes(attribute.String("db.error_type", categorizeDBError(err)))
+	tracing.RecordError(span, err)
+}
+
+// recordQuery записывает метрику запроса (обёртка без метрик используется только для трассировки)
+func recordQuery(m *metrics.Metrics, service, operation, table, status string, duration float64) {
+	if m != nil {
+		m.RecordDBQuery(service, operation, table, status, duration)
+	}
+}
+
+// recordError записывает метрику ошибки запроса
+func recordError(m *metrics.Metrics, service, operation, table, errorType string) {
+	if m != nil {
+		m.RecordDBError(service, operation, table, errorType)
+	}
+}
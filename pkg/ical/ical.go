@@ -0,0 +1,164 @@
+// Package ical формирование календарей в формате iCalendar (RFC 5545)
+//
+// Поддерживается только то, что нужно для подписки на бронирования: один VCALENDAR
+// с событиями VEVENT в локальном ("плавающем") времени без VTIMEZONE.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType MIME тип календаря
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineOctets максимальная длина строки содержимого без CRLF (RFC 5545, 3.1)
+const maxLineOctets = 75
+
+// Форматы даты и времени
+const (
+	localLayout = "20060102T150405"  // Локальное время без часового пояса
+	utcLayout   = "20060102T150405Z" // Время UTC
+)
+
+// Статусы события (RFC 5545, 3.8.1.11)
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar календарь
+type Calendar struct {
+	ProdID   string // Идентификатор продукта, например "-//SMC//BookingService//RU"
+	Name     string // Название календаря (X-WR-CALNAME)
+	TimeZone string // Часовой пояс для локального времени событий (X-WR-TIMEZONE), пусто - не указывается
+	Events   []Event
+}
+
+// Event событие календаря
+// Start и End записываются как локальное время (без часового пояса)
+type Event struct {
+	UID          string
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	Status       string
+	Created      time.Time
+	LastModified time.Time
+}
+
+// WriteTo записывает календарь в w
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	cw := &contentWriter{w: bufio.NewWriter(w)}
+
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", c.ProdID)
+	cw.line("CALSCALE", "GREGORIAN")
+	cw.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		cw.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	if c.TimeZone != "" {
+		cw.line("X-WR-TIMEZONE", c.TimeZone)
+	}
+
+	for _, e := range c.Events {
+		cw.line("BEGIN", "VEVENT")
+		cw.line("UID", escapeText(e.UID))
+		cw.line("DTSTAMP", stamp(e.LastModified))
+		cw.line("DTSTART", e.Start.Format(localLayout))
+		cw.line("DTEND", e.End.Format(localLayout))
+		cw.line("SUMMARY", escapeText(e.Summary))
+		if e.Description != "" {
+			cw.line("DESCRIPTION", escapeText(e.Description))
+		}
+		if e.Location != "" {
+			cw.line("LOCATION", escapeText(e.Location))
+		}
+		if e.Status != "" {
+			cw.line("STATUS", e.Status)
+		}
+		if !e.Created.IsZero() {
+			cw.line("CREATED", stamp(e.Created))
+		}
+		if !e.LastModified.IsZero() {
+			cw.line("LAST-MODIFIED", stamp(e.LastModified))
+		}
+		cw.line("END", "VEVENT")
+	}
+
+	cw.line("END", "VCALENDAR")
+
+	if cw.err == nil {
+		cw.err = cw.w.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// contentWriter записывает строки содержимого с переносом длинных строк
+type contentWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+// line записывает строку "NAME:value" с переносом по 75 октетов (RFC 5545, 3.1)
+// Перенос не разрывает многобайтовые символы UTF-8
+func (cw *contentWriter) line(name, value string) {
+	if cw.err != nil {
+		return
+	}
+
+	s := name + ":" + value
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		cw.write(s[:cut] + "\r\n ")
+		s = s[cut:]
+		// Строка продолжения начинается с пробела, который входит в лимит
+		limit = maxLineOctets - 1
+	}
+	cw.write(s + "\r\n")
+}
+
+func (cw *contentWriter) write(s string) {
+	if cw.err != nil {
+		return
+	}
+	n, err := cw.w.WriteString(s)
+	cw.n += int64(n)
+	cw.err = err
+}
+
+// stamp форматирует время в UTC (для DTSTAMP, CREATED, LAST-MODIFIED)
+// Нулевое время заменяется текущим: DTSTAMP обязателен
+func stamp(t time.Time) string {
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return t.UTC().Format(utcLayout)
+}
+
+// textEscaper экранирует значение типа TEXT (RFC 5545, 3.3.11)
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/m04kA/SMC-BookingService/pkg/requestid"
+	"github.com/m04kA/SMC-BookingService/pkg/tracing"
+)
+
+// Поля контекста запроса
+const (
+	FieldRequestID = "request_id"
+	FieldTraceID   = "trace_id"
+	FieldUserID    = "user_id"
+	FieldCompanyID = "company_id"
+	FieldBookingID = "booking_id"
+)
+
+type fieldsKey struct{}
+
+// WithUserID сохраняет ID пользователя в поля логов контекста
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return WithFields(ctx, slog.Int64(FieldUserID, userID))
+}
+
+// WithCompanyID сохраняет ID компании в поля логов контекста
+func WithCompanyID(ctx context.Context, companyID int64) context.Context {
+	return WithFields(ctx, slog.Int64(FieldCompanyID, companyID))
+}
+
+// WithBookingID сохраняет ID бронирования в поля логов контекста
+func WithBookingID(ctx context.Context, bookingID int64) context.Context {
+	return WithFields(ctx, slog.Int64(FieldBookingID, bookingID))
+}
+
+// WithFields добавляет поля, которые методы *Context пишут в каждую запись
+// Поле с тем же ключом заменяет ранее сохраненное
+func WithFields(ctx context.Context, attrs ...slog.Attr) context.Context {
+	current, _ := ctx.Value(fieldsKey{}).([]slog.Attr)
+
+	fields := make([]slog.Attr, 0, len(current)+len(attrs))
+	for _, field := range current {
+		if !hasKey(attrs, field.Key) {
+			fields = append(fields, field)
+		}
+	}
+	fields = append(fields, attrs...)
+
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// contextAttrs поля записи из контекста: идентификаторы запроса и трассы, сохраненные поля
+func contextAttrs(ctx context.Context) []slog.Attr {
+	fields, _ := ctx.Value(fieldsKey{}).([]slog.Attr)
+
+	var ids []slog.Attr
+	if id := requestid.FromContext(ctx); id != "" {
+		ids = append(ids, slog.String(FieldRequestID, id))
+	}
+	if id := tracing.TraceID(ctx); id != "" {
+		ids = append(ids, slog.String(FieldTraceID, id))
+	}
+	if len(ids) == 0 {
+		return fields
+	}
+	return append(ids, fields...)
+}
+
+func hasKey(attrs []slog.Attr, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -1,107 +1,209 @@
 package logger
 
 import (
+	"context"
+	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"os"
+	"runtime"
 	"strings"
-)
+	"time"
 
-// LogLevel представляет уровень логирования
-type LogLevel int
+	"gopkg.in/natefinch/lumberjack.v2"
+)
 
+// Форматы вывода
 const (
-	LevelDebug LogLevel = iota
-	LevelInfo
-	LevelWarn
-	LevelError
+	FormatJSON   = "json"   // JSON объект на строку
+	FormatLogfmt = "logfmt" // key=value на строку
 )
 
+// Config настройки логгера
+type Config struct {
+	Level  string // debug, info, warn, error
+	Format string // json, logfmt
+	File   string // Путь к файлу логов (пустой - только stdout)
+
+	// Ротация файла логов
+	MaxSizeMB  int  // Размер файла, после которого он ротируется
+	MaxBackups int  // Сколько ротированных файлов хранить (0 - все)
+	MaxAgeDays int  // Сколько дней хранить ротированные файлы (0 - бессрочно)
+	Compress   bool // Сжимать ротированные файлы (gzip)
+}
+
+// Logger структурированный логгер: записи всех уровней пишутся в stdout и в файл с ротацией
+//
+// Сообщения форматируются как в fmt.Printf, методы *Context добавляют поля контекста запроса
+// (request_id, trace_id, user_id, company_id, booking_id). Уровень меняется во время работы (SetLevel).
 type Logger struct {
-	info    *log.Logger
-	warn    *log.Logger
-	err     *log.Logger
-	debug   *log.Logger
-	logFile *os.File
-	level   LogLevel
+	handler slog.Handler
+	level   *slog.LevelVar
+	file    io.Closer
 }
 
-// parseLogLevel преобразует строку в LogLevel
-func parseLogLevel(level string) LogLevel {
+// New создает логгер с записью в консоль и файл
+func New(cfg Config) (*Logger, error) {
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	levelVar := &slog.LevelVar{}
+	levelVar.Set(level)
+
+	var out io.Writer = os.Stdout
+	var file io.Closer
+	if cfg.File != "" {
+		rotating := &lumberjack.Logger{
+			Filename:   cfg.File,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   cfg.Compress,
+			LocalTime:  true,
+		}
+		out = io.MultiWriter(os.Stdout, rotating)
+		file = rotating
+	}
+
+	opts := &slog.HandlerOptions{
+		AddSource:   true,
+		Level:       levelVar,
+		ReplaceAttr: shortSource,
+	}
+
+	var handler slog.Handler
+	switch cfg.Format {
+	case FormatJSON, "":
+		handler = slog.NewJSONHandler(out, opts)
+	case FormatLogfmt:
+		handler = slog.NewTextHandler(out, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected json or logfmt", cfg.Format)
+	}
+
+	return &Logger{
+		handler: handler,
+		level:   levelVar,
+		file:    file,
+	}, nil
+}
+
+// ParseLevel преобразует строку в уровень логирования
+func ParseLevel(level string) (slog.Level, error) {
 	switch strings.ToLower(level) {
 	case "debug":
-		return LevelDebug
+		return slog.LevelDebug, nil
 	case "info":
-		return LevelInfo
+		return slog.LevelInfo, nil
 	case "warn", "warning":
-		return LevelWarn
+		return slog.LevelWarn, nil
 	case "error":
-		return LevelError
+		return slog.LevelError, nil
 	default:
-		return LevelInfo // default
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", level)
 	}
 }
 
-// New создает новый экземпляр логгера с записью в консоль и файл
-func New(logFilePath string, level string) (*Logger, error) {
-	// Открываем файл для логов
-	logFile, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+// Level возвращает текущий уровень логирования: debug, info, warn, error
+func (l *Logger) Level() string {
+	return strings.ToLower(l.level.Level().String())
+}
+
+// SetLevel меняет уровень логирования во время работы
+func (l *Logger) SetLevel(level string) error {
+	parsed, err := ParseLevel(level)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	// Настраиваем мультиплексор для warning и error
-	warnErrorWriter := io.MultiWriter(os.Stdout, logFile)
-
-	return &Logger{
-		debug:   log.New(os.Stdout, "[DEBUG] ", log.LstdFlags|log.Lshortfile),
-		info:    log.New(os.Stdout, "[INFO] ", log.LstdFlags|log.Lshortfile),
-		warn:    log.New(warnErrorWriter, "[WARN] ", log.LstdFlags|log.Lshortfile),
-		err:     log.New(warnErrorWriter, "[ERROR] ", log.LstdFlags|log.Lshortfile),
-		logFile: logFile,
-		level:   parseLogLevel(level),
-	}, nil
+	l.level.Set(parsed)
+	return nil
 }
 
 // Close закрывает файл логов
 func (l *Logger) Close() error {
-	if l != nil && l.logFile != nil {
-		return l.logFile.Close()
+	if l != nil && l.file != nil {
+		return l.file.Close()
 	}
 	return nil
 }
 
-// Debug логирует отладочные сообщения (только консоль)
+// Debug логирует отладочные сообщения
 func (l *Logger) Debug(format string, v ...interface{}) {
-	if l != nil && l.level <= LevelDebug {
-		l.debug.Printf(format, v...)
-	}
+	l.log(context.Background(), slog.LevelDebug, format, v)
 }
 
-// Info логирует информационные сообщения (только консоль)
+// Info логирует информационные сообщения
 func (l *Logger) Info(format string, v ...interface{}) {
-	if l != nil && l.level <= LevelInfo {
-		l.info.Printf(format, v...)
-	}
+	l.log(context.Background(), slog.LevelInfo, format, v)
 }
 
-// Warn логирует предупреждения (консоль + файл)
+// Warn логирует предупреждения
 func (l *Logger) Warn(format string, v ...interface{}) {
-	if l != nil && l.level <= LevelWarn {
-		l.warn.Printf(format, v...)
-	}
+	l.log(context.Background(), slog.LevelWarn, format, v)
 }
 
-// Error логирует ошибки (консоль + файл)
+// Error логирует ошибки
 func (l *Logger) Error(format string, v ...interface{}) {
-	if l != nil && l.level <= LevelError {
-		l.err.Printf(format, v...)
-	}
+	l.log(context.Background(), slog.LevelError, format, v)
+}
+
+// DebugContext логирует отладочное сообщение с полями контекста запроса
+func (l *Logger) DebugContext(ctx context.Context, format string, v ...interface{}) {
+	l.log(ctx, slog.LevelDebug, format, v)
+}
+
+// InfoContext логирует информационное сообщение с полями контекста запроса
+func (l *Logger) InfoContext(ctx context.Context, format string, v ...interface{}) {
+	l.log(ctx, slog.LevelInfo, format, v)
+}
+
+// WarnContext логирует предупреждение с полями контекста запроса
+func (l *Logger) WarnContext(ctx context.Context, format string, v ...interface{}) {
+	l.log(ctx, slog.LevelWarn, format, v)
+}
+
+// ErrorContext логирует ошибку с полями контекста запроса
+func (l *Logger) ErrorContext(ctx context.Context, format string, v ...interface{}) {
+	l.log(ctx, slog.LevelError, format, v)
 }
 
 // Fatal логирует критическую ошибку и завершает программу
 func (l *Logger) Fatal(format string, v ...interface{}) {
 	if l != nil {
-		l.err.Fatalf(format, v...)
+		l.log(context.Background(), slog.LevelError, format, v)
+		l.Close()
+	}
+	os.Exit(1)
+}
+
+// log записывает запись с местом вызова публичного метода логгера
+func (l *Logger) log(ctx context.Context, level slog.Level, format string, v []interface{}) {
+	if l == nil || !l.handler.Enabled(ctx, level) {
+		return
+	}
+
+	// runtime.Callers, log и публичный метод логгера
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+
+	record := slog.NewRecord(time.Now(), level, fmt.Sprintf(format, v...), pcs[0])
+	record.AddAttrs(contextAttrs(ctx)...)
+	_ = l.handler.Handle(ctx, record)
+}
+
+// shortSource заменяет место вызова на file.go:line (как log.Lshortfile)
+func shortSource(_ []string, attr slog.Attr) slog.Attr {
+	if attr.Key != slog.SourceKey {
+		return attr
+	}
+	source, ok := attr.Value.Any().(*slog.Source)
+	if !ok {
+		return attr
+	}
+	file := source.File
+	if i := strings.LastIndexByte(file, '/'); i >= 0 {
+		file = file[i+1:]
 	}
+	return slog.String(slog.SourceKey, fmt.Sprintf("%s:%d", file, source.Line))
 }
@@ -19,6 +19,34 @@ type Metrics struct {
 	DBConnectionsActive prometheus.Gauge
 	DBConnectionsIdle   prometheus.Gauge
 	DBConnectionsMax    prometheus.Gauge
+
+	// Transaction метрики (повторы при конфликтах сериализации и deadlock)
+	TxRetriesTotal          *prometheus.CounterVec
+	TxRetriesExhaustedTotal *prometheus.CounterVec
+
+	// Cache метрики
+	CacheRequestsTotal      *prometheus.CounterVec
+	CacheInvalidationsTotal *prometheus.CounterVec
+
+	// Outbound метрики (вызовы внешних сервисов)
+	OutboundRetriesTotal           *prometheus.CounterVec
+	CircuitBreakerState            *prometheus.GaugeVec
+	CircuitBreakerTransitionsTotal *prometheus.CounterVec
+
+	// Бизнес метрики (бронирования и загрузка слотов)
+	BookingsCreatedTotal        *prometheus.CounterVec
+	BookingsCancelledTotal      *prometheus.CounterVec
+	BookingsNoShowTotal         *prometheus.CounterVec
+	SlotRejectionsTotal         *prometheus.CounterVec
+	BookingLeadTimeSeconds      *prometheus.HistogramVec
+	CancellationLeadTimeSeconds *prometheus.HistogramVec
+	SlotOccupancyRatio          *prometheus.GaugeVec
+}
+
+// leadTimeBuckets границы гистограмм времени до начала бронирования: от 15 минут до 30 дней
+var leadTimeBuckets = []float64{
+	15 * 60, 3600, 3 * 3600, 6 * 3600, 12 * 3600,
+	86400, 2 * 86400, 3 * 86400, 7 * 86400, 14 * 86400, 30 * 86400,
 }
 
 // New создаёт новый экземпляр метрик с автоматической регистрацией в Prometheus
@@ -105,6 +133,123 @@ func New(serviceName string) *Metrics {
 				},
 			},
 		),
+
+		TxRetriesTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "db_tx_retries_total",
+				Help: "Total number of retried database transactions",
+			},
+			[]string{"service", "reason"},
+		),
+
+		TxRetriesExhaustedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "db_tx_retries_exhausted_total",
+				Help: "Total number of database transactions aborted after all retry attempts",
+			},
+			[]string{"service", "reason"},
+		),
+
+		// Cache метрики
+		CacheRequestsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "cache_requests_total",
+				Help: "Total number of cache lookups by result (hit, negative_hit, miss)",
+			},
+			[]string{"service", "cache", "entity", "result"},
+		),
+
+		CacheInvalidationsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "cache_invalidations_total",
+				Help: "Total number of cache invalidations",
+			},
+			[]string{"service", "cache", "entity"},
+		),
+
+		// Outbound метрики
+		OutboundRetriesTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "outbound_retries_total",
+				Help: "Total number of retried outbound HTTP requests",
+			},
+			[]string{"service", "dependency", "reason"},
+		),
+
+		CircuitBreakerState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "circuit_breaker_state",
+				Help: "Circuit breaker state per dependency (0 - closed, 1 - half_open, 2 - open)",
+			},
+			[]string{"service", "dependency"},
+		),
+
+		CircuitBreakerTransitionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "circuit_breaker_transitions_total",
+				Help: "Total number of circuit breaker state changes",
+			},
+			[]string{"service", "dependency", "from", "to"},
+		),
+
+		// Бизнес метрики
+		BookingsCreatedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bookings_created_total",
+				Help: "Total number of created bookings",
+			},
+			[]string{"service", "company", "source"},
+		),
+
+		BookingsCancelledTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bookings_cancelled_total",
+				Help: "Total number of cancelled bookings by who cancelled (user, company)",
+			},
+			[]string{"service", "company", "source", "cancelled_by"},
+		),
+
+		BookingsNoShowTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "bookings_no_show_total",
+				Help: "Total number of bookings marked as no-show",
+			},
+			[]string{"service", "company", "source"},
+		),
+
+		SlotRejectionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "booking_slot_rejections_total",
+				Help: "Total number of booking attempts rejected because the slot is fully booked",
+			},
+			[]string{"service", "company", "source"},
+		),
+
+		BookingLeadTimeSeconds: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "booking_lead_time_seconds",
+				Help:    "Time from booking creation to the start of the booked slot",
+				Buckets: leadTimeBuckets,
+			},
+			[]string{"service", "company"},
+		),
+
+		CancellationLeadTimeSeconds: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "booking_cancellation_lead_time_seconds",
+				Help:    "Time from cancellation to the start of the booked slot (0 if cancelled after start)",
+				Buckets: leadTimeBuckets,
+			},
+			[]string{"service", "company", "cancelled_by"},
+		),
+
+		SlotOccupancyRatio: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "slot_occupancy_ratio",
+				Help: "Share of slot capacity taken by active bookings per address (day: today, tomorrow)",
+			},
+			[]string{"service", "company", "address", "day"},
+		),
 	}
 
 	return m
@@ -138,3 +283,79 @@ func (m *Metrics) UpdateDBConnectionStats(active, idle, max int) {
 	m.DBConnectionsIdle.Set(float64(idle))
 	m.DBConnectionsMax.Set(float64(max))
 }
+
+// RecordTxRetry записывает метрику повтора транзакции
+func (m *Metrics) RecordTxRetry(service, reason string) {
+	m.TxRetriesTotal.WithLabelValues(service, reason).Inc()
+}
+
+// RecordTxRetriesExhausted записывает метрику транзакции, прерванной после всех попыток
+func (m *Metrics) RecordTxRetriesExhausted(service, reason string) {
+	m.TxRetriesExhaustedTotal.WithLabelValues(service, reason).Inc()
+}
+
+// RecordCacheLookup записывает метрику обращения к кешу
+func (m *Metrics) RecordCacheLookup(service, cache, entity, result string) {
+	m.CacheRequestsTotal.WithLabelValues(service, cache, entity, result).Inc()
+}
+
+// RecordCacheInvalidation записывает метрику инвалидации кеша
+func (m *Metrics) RecordCacheInvalidation(service, cache, entity string) {
+	m.CacheInvalidationsTotal.WithLabelValues(service, cache, entity).Inc()
+}
+
+// RecordOutboundRetry записывает метрику повтора запроса к внешнему сервису
+func (m *Metrics) RecordOutboundRetry(service, dependency, reason string) {
+	m.OutboundRetriesTotal.WithLabelValues(service, dependency, reason).Inc()
+}
+
+// RecordCircuitBreakerState записывает переход circuit breaker в новое состояние
+func (m *Metrics) RecordCircuitBreakerState(service, dependency, from, to string) {
+	m.CircuitBreakerTransitionsTotal.WithLabelValues(service, dependency, from, to).Inc()
+	m.SetCircuitBreakerState(service, dependency, to)
+}
+
+// SetCircuitBreakerState выставляет текущее состояние circuit breaker без учета перехода
+// (например, начальное closed при создании, чтобы метрика была видна до первого перехода)
+func (m *Metrics) SetCircuitBreakerState(service, dependency, state string) {
+	var value float64
+	switch state {
+	case "half_open":
+		value = 1
+	case "open":
+		value = 2
+	}
+	m.CircuitBreakerState.WithLabelValues(service, dependency).Set(value)
+}
+
+// RecordBookingCreated записывает метрики созданного бронирования
+func (m *Metrics) RecordBookingCreated(service, company, source string, leadTime float64) {
+	m.BookingsCreatedTotal.WithLabelValues(service, company, source).Inc()
+	m.BookingLeadTimeSeconds.WithLabelValues(service, company).Observe(leadTime)
+}
+
+// RecordBookingCancelled записывает метрики отмененного бронирования
+func (m *Metrics) RecordBookingCancelled(service, company, source, cancelledBy string, leadTime float64) {
+	m.BookingsCancelledTotal.WithLabelValues(service, company, source, cancelledBy).Inc()
+	m.CancellationLeadTimeSeconds.WithLabelValues(service, company, cancelledBy).Observe(leadTime)
+}
+
+// RecordBookingNoShow записывает метрику неявки клиента
+func (m *Metrics) RecordBookingNoShow(service, company, source string) {
+	m.BookingsNoShowTotal.WithLabelValues(service, company, source).Inc()
+}
+
+// RecordSlotRejection записывает метрику отказа в бронировании занятого слота
+func (m *Metrics) RecordSlotRejection(service, company, source string) {
+	m.SlotRejectionsTotal.WithLabelValues(service, company, source).Inc()
+}
+
+// SetSlotOccupancy записывает загрузку слотов адреса
+func (m *Metrics) SetSlotOccupancy(service, company, address, day string, ratio float64) {
+	m.SlotOccupancyRatio.WithLabelValues(service, company, address, day).Set(ratio)
+}
+
+// ResetSlotOccupancy удаляет значения загрузки (перед записью нового расчета)
+func (m *Metrics) ResetSlotOccupancy() {
+	m.SlotOccupancyRatio.Reset()
+}
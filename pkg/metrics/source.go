@@ -0,0 +1,25 @@
+package metrics
+
+import "context"
+
+// Источники изменений бронирований (значения метки source)
+const (
+	SourceHTTP    = "http"    // REST API
+	SourceGRPC    = "grpc"    // gRPC API внутренних сервисов
+	SourceUnknown = "unknown" // Фоновые задачи и вызовы без указанного источника
+)
+
+type sourceKey struct{}
+
+// WithSource сохраняет источник запроса в контекст
+func WithSource(ctx context.Context, source string) context.Context {
+	return context.WithValue(ctx, sourceKey{}, source)
+}
+
+// SourceFromContext возвращает источник запроса из контекста (SourceUnknown, если не указан)
+func SourceFromContext(ctx context.Context) string {
+	if source, ok := ctx.Value(sourceKey{}).(string); ok {
+		return source
+	}
+	return SourceUnknown
+}
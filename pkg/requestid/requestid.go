@@ -0,0 +1,51 @@
+// Package requestid идентификатор запроса (X-Request-ID) для сквозной корреляции
+//
+// Идентификатор принимается от клиента или генерируется на входе в сервис, хранится в контексте,
+// попадает в строки логов (logger.*Context) и передается в вызовы внешних сервисов.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header заголовок HTTP (и ключ метаданных gRPC в нижнем регистре) с идентификатором запроса
+const Header = "X-Request-ID"
+
+// maxLength максимальная длина идентификатора, принимаемого от клиента
+const maxLength = 128
+
+type contextKey struct{}
+
+// New генерирует случайный идентификатор (32 hex символа)
+func New() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Valid проверяет идентификатор, полученный от клиента
+// Допускаются непустые строки до 128 печатных ASCII символов без пробелов (значение попадает в логи)
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithContext сохраняет идентификатор запроса в контекст
+func WithContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext возвращает идентификатор запроса из контекста (пустая строка, если его нет)
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
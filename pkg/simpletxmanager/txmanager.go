@@ -4,20 +4,31 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+
+	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
+	"github.com/m04kA/SMC-BookingService/pkg/txretry"
 )
 
 // TransactionManager простой менеджер транзакций без метрик
 type TransactionManager struct {
-	db *sql.DB
+	db      *sql.DB
+	retrier *txretry.Retrier
 }
 
 // NewTransactionManager создаёт новый менеджер транзакций
-func NewTransactionManager(db *sql.DB) *TransactionManager {
+// retrier повторяет транзакции, прерванные из-за конфликта сериализации или deadlock (nil - без повторов)
+func NewTransactionManager(db *sql.DB, retrier *txretry.Retrier) *TransactionManager {
 	return &TransactionManager{
-		db: db,
+		db:      db,
+		retrier: retrier,
 	}
 }
 
+// Do выполняет функцию внутри транзакции с уровнем изоляции по умолчанию
+func (tm *TransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return tm.DoWithOptions(ctx, nil, fn)
+}
+
 // DoSerializable выполняет функцию внутри транзакции с уровнем изоляции Serializable
 // Если функция завершается без ошибки, транзакция фиксируется (commit)
 // Если функция возвращает ошибку, транзакция откатывается (rollback)
@@ -29,6 +40,22 @@ func (tm *TransactionManager) DoSerializable(ctx context.Context, fn func(ctx co
 
 // DoWithOptions выполняет функцию внутри транзакции с указанными опциями
 func (tm *TransactionManager) DoWithOptions(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error {
+	// Если уже в транзакции, просто выполняем функцию
+	if dbmetrics.IsInTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	// Повторяется только внешняя транзакция: вложенный вызов возвращает ошибку PostgreSQL наверх
+	if tm.retrier == nil {
+		return tm.run(ctx, opts, fn)
+	}
+	return tm.retrier.Do(ctx, func(ctx context.Context) error {
+		return tm.run(ctx, opts, fn)
+	})
+}
+
+// run выполняет одну попытку транзакции: BeginTx, fn, Commit или Rollback
+func (tm *TransactionManager) run(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error {
 	// Начинаем новую транзакцию
 	tx, err := tm.db.BeginTx(ctx, opts)
 	if err != nil {
@@ -45,8 +72,9 @@ func (tm *TransactionManager) DoWithOptions(ctx context.Context, opts *sql.TxOpt
 	}()
 
 	// Выполняем функцию внутри транзакции
-	// Передаём контекст, который использует транзакцию
-	fnErr := fn(ctx)
+	// Передаём контекст с транзакцией, чтобы репозитории использовали её через dbmetrics.GetExecutor
+	txCtx := dbmetrics.WithTx(ctx, &dbmetrics.SqlTxWrapper{Tx: tx})
+	fnErr := fn(txCtx)
 
 	if fnErr != nil {
 		// При ошибке откатываем транзакцию
@@ -0,0 +1,142 @@
+// Package tracing распределенная трассировка (OpenTelemetry)
+//
+// Setup настраивает глобальный TracerProvider и распространение контекста W3C (traceparent, baggage).
+// Пока трассировка выключена, глобальный провайдер no-op и спаны ничего не стоят,
+// поэтому пакеты создают спаны без проверки настроек.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Экспортеры спанов
+const (
+	ExporterOTLP = "otlp" // OTLP/HTTP коллектор (Jaeger, Tempo, otel-collector)
+	ExporterFile = "file" // JSON спаны в локальный файл (по спану на строку)
+)
+
+// Config настройки трассировки
+type Config struct {
+	ServiceName string
+	Exporter    string  // otlp, file
+	Endpoint    string  // host:port OTLP/HTTP коллектора
+	Insecure    bool    // OTLP без TLS
+	FilePath    string  // Файл для экспортера file
+	SampleRatio float64 // Доля трассируемых запросов без родительского спана (0..1)
+}
+
+// ShutdownFunc отправляет накопленные спаны и останавливает экспорт
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup настраивает экспорт спанов и распространение контекста трассировки
+func Setup(ctx context.Context, cfg Config) (ShutdownFunc, error) {
+	exporter, closeFile, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := sdkresource.Merge(
+		sdkresource.Default(),
+		sdkresource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource),
+		// Решение родителя (из traceparent) соблюдается, новые трассы сэмплируются по доле
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeFile != nil {
+			if closeErr := closeFile(); err == nil {
+				err = closeErr
+			}
+		}
+		return err
+	}, nil
+}
+
+// newExporter создает экспортер спанов по настройкам
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, func() error, error) {
+	switch cfg.Exporter {
+	case ExporterOTLP, "":
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		return exporter, nil, nil
+
+	case ExporterFile:
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			return nil, nil, fmt.Errorf("failed to create file exporter: %w", err)
+		}
+		return exporter, file.Close, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %q, expected otlp or file", cfg.Exporter)
+	}
+}
+
+// Start начинает спан с именем name от спана из контекста
+// tracer - имя инструментируемого пакета (create_booking, dbmetrics)
+func Start(ctx context.Context, tracer, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracer).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End завершает спан, отмечая ошибку (если есть)
+func End(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// RecordError отмечает ошибку в спане (nil игнорируется)
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// TraceID возвращает идентификатор трассы из контекста (пустая строка, если спана нет)
+func TraceID(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
@@ -6,18 +6,22 @@ import (
 	"fmt"
 
 	"github.com/m04kA/SMC-BookingService/pkg/dbmetrics"
+	"github.com/m04kA/SMC-BookingService/pkg/txretry"
 )
 
 // TransactionManager управляет транзакциями с поддержкой метрик через dbmetrics.DB
 // Совместим с существующим механизмом передачи транзакций через контекст
 type TransactionManager struct {
-	db *dbmetrics.DB
+	db      *dbmetrics.DB
+	retrier *txretry.Retrier
 }
 
 // NewTransactionManager создаёт новый менеджер транзакций
-func NewTransactionManager(db *dbmetrics.DB) *TransactionManager {
+// retrier повторяет транзакции, прерванные из-за конфликта сериализации или deadlock (nil - без повторов)
+func NewTransactionManager(db *dbmetrics.DB, retrier *txretry.Retrier) *TransactionManager {
 	return &TransactionManager{
-		db: db,
+		db:      db,
+		retrier: retrier,
 	}
 }
 
@@ -56,6 +60,17 @@ func (tm *TransactionManager) DoWithOptions(ctx context.Context, opts *sql.TxOpt
 		return fn(ctx)
 	}
 
+	// Повторяется только внешняя транзакция: вложенный вызов возвращает ошибку PostgreSQL наверх
+	if tm.retrier == nil {
+		return tm.run(ctx, opts, fn)
+	}
+	return tm.retrier.Do(ctx, func(ctx context.Context) error {
+		return tm.run(ctx, opts, fn)
+	})
+}
+
+// run выполняет одну попытку транзакции: BeginTx, fn, Commit или Rollback
+func (tm *TransactionManager) run(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error {
 	// Начинаем новую транзакцию через dbmetrics.DB (с метриками)
 	tx, err := tm.db.BeginTx(ctx, opts)
 	if err != nil {
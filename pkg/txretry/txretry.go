@@ -0,0 +1,177 @@
+// Package txretry повтор транзакций, прерванных PostgreSQL из-за конфликта сериализации или взаимной блокировки
+//
+// Транзакция с SQLSTATE 40001 (serialization_failure) или 40P01 (deadlock_detected) откатывается целиком,
+// и PostgreSQL рекомендует выполнить ее заново. Повторяется вся функция транзакции, поэтому она не должна
+// иметь побочных эффектов вне БД (события и вызовы внешних сервисов выполняются после фиксации).
+package txretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Причины повтора (значение метки reason в метриках)
+const (
+	ReasonSerializationFailure = "serialization_failure" // SQLSTATE 40001
+	ReasonDeadlock             = "deadlock_detected"     // SQLSTATE 40P01
+)
+
+// Коды ошибок PostgreSQL, при которых транзакция повторяется
+const (
+	pgSerializationFailure pq.ErrorCode = "40001"
+	pgDeadlockDetected     pq.ErrorCode = "40P01"
+)
+
+var (
+	// ErrSerializationFailure конфликт сериализации не устранен повторами (проверяется через errors.Is)
+	ErrSerializationFailure = errors.New("txretry: serialization failure")
+	// ErrDeadlock взаимная блокировка не устранена повторами (проверяется через errors.Is)
+	ErrDeadlock = errors.New("txretry: deadlock detected")
+)
+
+// Config настройки повторов транзакции
+type Config struct {
+	MaxAttempts    int           // Общее количество попыток, включая первую (1 = без повторов)
+	InitialBackoff time.Duration // Базовая задержка перед повтором
+	MaxBackoff     time.Duration // Максимальная задержка перед повтором
+}
+
+// Metrics интерфейс для метрик повторов
+type Metrics interface {
+	RecordTxRetry(service, reason string)
+	RecordTxRetriesExhausted(service, reason string)
+}
+
+// ExhaustedError транзакция прервана PostgreSQL во всех попытках
+// errors.Is(err, ErrSerializationFailure) и errors.Is(err, ErrDeadlock) определяют причину,
+// errors.As(err, &pqErr) возвращает ошибку PostgreSQL последней попытки
+type ExhaustedError struct {
+	Attempts int
+	Reason   string
+	Err      error
+}
+
+func (e *ExhaustedError) Error() string {
+	return fmt.Sprintf("transaction aborted (%s) after %d attempt(s): %v", e.Reason, e.Attempts, e.Err)
+}
+
+func (e *ExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ExhaustedError) Is(target error) bool {
+	switch target {
+	case ErrSerializationFailure:
+		return e.Reason == ReasonSerializationFailure
+	case ErrDeadlock:
+		return e.Reason == ReasonDeadlock
+	}
+	return false
+}
+
+// Retrier выполняет транзакцию заново при конфликте сериализации или deadlock
+type Retrier struct {
+	cfg         Config
+	metrics     Metrics
+	serviceName string
+}
+
+// New создает Retrier
+// metrics может быть nil, если метрики отключены
+func New(cfg Config, metrics Metrics, serviceName string) *Retrier {
+	return &Retrier{
+		cfg:         cfg,
+		metrics:     metrics,
+		serviceName: serviceName,
+	}
+}
+
+// Do выполняет attempt (одну транзакцию от BeginTx до Commit) с повторами
+// Ошибки, не требующие повтора, возвращаются без изменений; если конфликт не устранен за MaxAttempts
+// попыток (или до дедлайна контекста), возвращается *ExhaustedError.
+func (r *Retrier) Do(ctx context.Context, attempt func(ctx context.Context) error) error {
+	maxAttempts := max(r.cfg.MaxAttempts, 1)
+
+	for n := 1; ; n++ {
+		err := attempt(ctx)
+
+		reason := Reason(err)
+		if reason == "" {
+			return err
+		}
+
+		exhausted := &ExhaustedError{Attempts: n, Reason: reason, Err: err}
+		if n >= maxAttempts {
+			return r.exhausted(exhausted)
+		}
+
+		// Не повторяем, если до дедлайна запроса не успеем дождаться следующей попытки
+		delay := r.backoff(n)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			return r.exhausted(exhausted)
+		}
+
+		if r.metrics != nil {
+			r.metrics.RecordTxRetry(r.serviceName, reason)
+		}
+		trace.SpanFromContext(ctx).AddEvent("tx.retry", trace.WithAttributes(
+			attribute.String("reason", reason),
+			attribute.Int("attempt", n),
+			attribute.String("delay", delay.String()),
+		))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return r.exhausted(exhausted)
+		case <-timer.C:
+		}
+	}
+}
+
+// Reason возвращает причину повтора для ошибки транзакции (пустая строка - повтор не нужен)
+// Ошибка PostgreSQL ищется по всей цепочке (%w)
+func Reason(err error) string {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return ""
+	}
+
+	switch pqErr.Code {
+	case pgSerializationFailure:
+		return ReasonSerializationFailure
+	case pgDeadlockDetected:
+		return ReasonDeadlock
+	}
+	return ""
+}
+
+// exhausted записывает метрику и возвращает ошибку исчерпанных попыток
+func (r *Retrier) exhausted(err *ExhaustedError) error {
+	if r.metrics != nil {
+		r.metrics.RecordTxRetriesExhausted(r.serviceName, err.Reason)
+	}
+	return err
+}
+
+// backoff вычисляет задержку перед повтором: экспоненциальный рост с equal jitter
+func (r *Retrier) backoff(attempt int) time.Duration {
+	delay := r.cfg.InitialBackoff << (attempt - 1)
+	if delay <= 0 || delay > r.cfg.MaxBackoff {
+		delay = r.cfg.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
@@ -0,0 +1,207 @@
+package txretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMetrics struct {
+	retries   map[string]int
+	exhausted map[string]int
+}
+
+func newFakeMetrics() *fakeMetrics {
+	return &fakeMetrics{retries: map[string]int{}, exhausted: map[string]int{}}
+}
+
+func (m *fakeMetrics) RecordTxRetry(_, reason string) {
+	m.retries[reason]++
+}
+
+func (m *fakeMetrics) RecordTxRetriesExhausted(_, reason string) {
+	m.exhausted[reason]++
+}
+
+var errRepo = errors.New("repository error")
+
+func TestReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"plain error", errors.New("boom"), ""},
+		{"serialization failure", &pq.Error{Code: "40001"}, ReasonSerializationFailure},
+		{"deadlock", &pq.Error{Code: "40P01"}, ReasonDeadlock},
+		{"unique violation", &pq.Error{Code: "23505"}, ""},
+		{"wrapped with %w", fmt.Errorf("%w: exec: %w", errRepo, &pq.Error{Code: "40001"}), ReasonSerializationFailure},
+		{"wrapped with %v", fmt.Errorf("%w: exec: %v", errRepo, &pq.Error{Code: "40001"}), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Reason(tt.err))
+		})
+	}
+}
+
+func TestExhaustedError_Is(t *testing.T) {
+	cause := fmt.Errorf("%w: exec: %w", errRepo, &pq.Error{Code: "40001"})
+
+	tests := []struct {
+		name   string
+		reason string
+		target error
+		want   bool
+	}{
+		{"serialization matches serialization", ReasonSerializationFailure, ErrSerializationFailure, true},
+		{"serialization does not match deadlock", ReasonSerializationFailure, ErrDeadlock, false},
+		{"deadlock matches deadlock", ReasonDeadlock, ErrDeadlock, true},
+		{"deadlock does not match serialization", ReasonDeadlock, ErrSerializationFailure, false},
+		{"cause in chain", ReasonSerializationFailure, errRepo, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := error(&ExhaustedError{Attempts: 3, Reason: tt.reason, Err: cause})
+			assert.Equal(t, tt.want, errors.Is(err, tt.target))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"first retry", Config{InitialBackoff: 20 * time.Millisecond, MaxBackoff: time.Second}, 1, 10 * time.Millisecond, 20 * time.Millisecond},
+		{"exponential growth", Config{InitialBackoff: 20 * time.Millisecond, MaxBackoff: time.Second}, 3, 40 * time.Millisecond, 80 * time.Millisecond},
+		{"capped by max", Config{InitialBackoff: 20 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}, 5, 25 * time.Millisecond, 50 * time.Millisecond},
+		{"overflow capped by max", Config{InitialBackoff: time.Second, MaxBackoff: 2 * time.Second}, 64, time.Second, 2 * time.Second},
+		{"no backoff", Config{}, 1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(tt.cfg, nil, "test")
+			for range 100 {
+				delay := r.backoff(tt.attempt)
+				assert.GreaterOrEqual(t, delay, tt.min)
+				assert.LessOrEqual(t, delay, tt.max)
+			}
+		})
+	}
+}
+
+func TestRetrier_Do(t *testing.T) {
+	serialization := &pq.Error{Code: "40001"}
+	deadlock := &pq.Error{Code: "40P01"}
+
+	tests := []struct {
+		name          string
+		maxAttempts   int
+		errs          []error // Ошибка попытки по номеру, после конца списка - успех
+		wantAttempts  int
+		wantErr       error
+		wantExhausted bool
+		wantRetries   map[string]int
+	}{
+		{
+			name:         "success on first attempt",
+			maxAttempts:  3,
+			wantAttempts: 1,
+			wantRetries:  map[string]int{},
+		},
+		{
+			name:         "retry then succeed",
+			maxAttempts:  3,
+			errs:         []error{serialization, deadlock},
+			wantAttempts: 3,
+			wantRetries:  map[string]int{ReasonSerializationFailure: 1, ReasonDeadlock: 1},
+		},
+		{
+			name:          "exhausted",
+			maxAttempts:   3,
+			errs:          []error{serialization, serialization, serialization, serialization},
+			wantAttempts:  3,
+			wantErr:       ErrSerializationFailure,
+			wantExhausted: true,
+			wantRetries:   map[string]int{ReasonSerializationFailure: 2},
+		},
+		{
+			name:          "no retries configured",
+			maxAttempts:   1,
+			errs:          []error{deadlock},
+			wantAttempts:  1,
+			wantErr:       ErrDeadlock,
+			wantExhausted: true,
+			wantRetries:   map[string]int{},
+		},
+		{
+			name:         "non retryable error returned as is",
+			maxAttempts:  3,
+			errs:         []error{errRepo},
+			wantAttempts: 1,
+			wantErr:      errRepo,
+			wantRetries:  map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := newFakeMetrics()
+			r := New(Config{MaxAttempts: tt.maxAttempts, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}, metrics, "test")
+
+			attempts := 0
+			err := r.Do(context.Background(), func(context.Context) error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+
+			assert.Equal(t, tt.wantAttempts, attempts)
+			assert.Equal(t, tt.wantRetries, metrics.retries)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var exhausted *ExhaustedError
+			assert.Equal(t, tt.wantExhausted, errors.As(err, &exhausted))
+			if tt.wantExhausted {
+				assert.Equal(t, tt.wantAttempts, exhausted.Attempts)
+				assert.Equal(t, 1, metrics.exhausted[exhausted.Reason])
+			}
+		})
+	}
+}
+
+func TestRetrier_DoStopsAtDeadline(t *testing.T) {
+	r := New(Config{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: time.Second}, nil, "test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	attempts := 0
+	err := r.Do(ctx, func(context.Context) error {
+		attempts++
+		return &pq.Error{Code: "40001"}
+	})
+
+	assert.Equal(t, 1, attempts)
+	assert.ErrorIs(t, err, ErrSerializationFailure)
+}
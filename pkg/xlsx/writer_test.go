@@ -0,0 +1,135 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sheetXML разбор листа для проверки содержимого
+type sheetXML struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R    string `xml:"r,attr"`
+			T    string `xml:"t,attr"`
+			S    string `xml:"s,attr"`
+			V    string `xml:"v"`
+			Text string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// workbookXMLDoc разбор книги для проверки имени листа
+type workbookXMLDoc struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+func readPart(t *testing.T, zr *zip.Reader, name string) []byte {
+	t.Helper()
+	f, err := zr.Open(name)
+	require.NoError(t, err, "part %s", name)
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return data
+}
+
+func TestWriter_ProducesValidZip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Бронирования: [2025]")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteRow(Text("ID").Bold(), Text("Услуга").Bold(), Text("Цена").Bold()))
+	require.NoError(t, w.WriteRow(Int(1), Text("Мойка <&> \"люкс\"\x01"), Number(1500.5)))
+	require.NoError(t, w.WriteRow())
+	require.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels",
+		"xl/styles.xml",
+		"xl/worksheets/sheet1.xml",
+	}, names)
+
+	// Все части - корректный XML
+	for _, name := range names {
+		decoder := xml.NewDecoder(bytes.NewReader(readPart(t, zr, name)))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, "part %s", name)
+		}
+	}
+
+	var workbook workbookXMLDoc
+	require.NoError(t, xml.Unmarshal(readPart(t, zr, "xl/workbook.xml"), &workbook))
+	require.Len(t, workbook.Sheets, 1)
+	assert.Equal(t, "Бронирования_ _2025_", workbook.Sheets[0].Name)
+
+	var sheet sheetXML
+	require.NoError(t, xml.Unmarshal(readPart(t, zr, "xl/worksheets/sheet1.xml"), &sheet))
+	require.Len(t, sheet.Rows, 3)
+
+	header := sheet.Rows[0]
+	assert.Equal(t, 1, header.R)
+	require.Len(t, header.Cells, 3)
+	assert.Equal(t, "A1", header.Cells[0].R)
+	assert.Equal(t, "inlineStr", header.Cells[1].T)
+	assert.Equal(t, "1", header.Cells[1].S)
+	assert.Equal(t, "Услуга", header.Cells[1].Text)
+
+	row := sheet.Rows[1]
+	require.Len(t, row.Cells, 3)
+	assert.Equal(t, "", row.Cells[0].T)
+	assert.Equal(t, "1", row.Cells[0].V)
+	assert.Equal(t, "Мойка <&> \"люкс\"", row.Cells[1].Text, "недопустимые в XML символы удаляются")
+	assert.Equal(t, "", row.Cells[1].S)
+	assert.Equal(t, "C2", row.Cells[2].R)
+	assert.Equal(t, "1500.5", row.Cells[2].V)
+
+	assert.Equal(t, 3, sheet.Rows[2].R)
+	assert.Empty(t, sheet.Rows[2].Cells)
+}
+
+func TestWriter_WriteAfterClose(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Sheet")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, w.Close(), "повторный Close не возвращает ошибку")
+
+	assert.ErrorIs(t, w.WriteRow(Text("late")), ErrClosed)
+}
+
+func TestColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"}
+	for index, want := range tests {
+		assert.Equal(t, want, columnName(index), "index %d", index)
+	}
+}
+
+func TestSheetTitle(t *testing.T) {
+	assert.Equal(t, "Sheet1", sheetTitle(""))
+	assert.Equal(t, "a_b_c_d_e_f_g_", sheetTitle(`a[b]c:d*e?f/g\`))
+	assert.Equal(t, strings.Repeat("я", MaxSheetNameLength), sheetTitle(strings.Repeat("я", MaxSheetNameLength+5)))
+}
@@ -432,7 +432,7 @@ tc_6_4() {
     START_DATE=$(date -v-7d +%Y-%m-%d 2>/dev/null || date -d "7 days ago" +%Y-%m-%d)
     END_DATE=$(date -v+7d +%Y-%m-%d 2>/dev/null || date -d "+7 days" +%Y-%m-%d)
     print_test "TC-6.4: Бронирования за период ${START_DATE} - ${END_DATE}"
-    curl -s -X GET "${BASE_URL}/api/v1/companies/${COMPANY_ID}/bookings?startDate=${START_DATE}&endDate=${END_DATE}" \
+    curl -s -X GET "${BASE_URL}/api/v1/companies/${COMPANY_ID}/bookings?from=${START_DATE}&to=${END_DATE}" \
       -H "X-User-ID: 777777777" | jq .
 }
 